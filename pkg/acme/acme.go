@@ -0,0 +1,50 @@
+package acme
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gopkg.in/square/go-jose.v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ChallengePath is the path prefix the ACME server requests HTTP-01 challenges on
+const ChallengePath = "/.well-known/acme-challenge/"
+
+// Thumbprint returns the JWK thumbprint of the ACME account key stored in the account secret. The key authorization
+// of every HTTP-01 challenge of the account is <token>.<thumbprint>, so a solver only needs the thumbprint.
+func Thumbprint(accountSecret *corev1.Secret) (string, error) {
+	block, _ := pem.Decode(accountSecret.Data["privateKey"])
+	if block == nil {
+		return "", fmt.Errorf("failed to decode the private key of ACME account secret %s/%s", accountSecret.Namespace, accountSecret.Name)
+	}
+
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+
+	thumbprint, err := (&jose.JSONWebKey{Key: &key.PublicKey}).Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// SolverHandler answers HTTP-01 challenges of the account with the thumbprint without knowing the challenges upfront
+func SolverHandler(thumbprint string) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		token := strings.TrimPrefix(req.URL.Path, ChallengePath)
+		if req.Method != http.MethodGet || token == req.URL.Path || token == "" || strings.Contains(token, "/") {
+			http.NotFound(rw, req)
+			return
+		}
+		rw.Header().Set("Content-Type", "text/plain")
+		_, _ = rw.Write([]byte(token + "." + thumbprint))
+	})
+}
@@ -34,9 +34,14 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ImageList{},
 		&ImageDetails{},
 		&ImageTag{},
+		&ImageSignature{},
+		&ImageVerification{},
+		&ImagePrune{},
 		&ImagePush{},
 		&ImagePull{},
 		&ImageCopy{},
+		&ImageSave{},
+		&ImageLoad{},
 		&Info{},
 		&InfoList{},
 		&LogOptions{},
@@ -52,18 +57,30 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ContainerReplicaPortForwardOptions{},
 		&Secret{},
 		&SecretList{},
+		&SecretHistory{},
+		&SecretRollback{},
 		&Service{},
 		&ServiceList{},
 		&Project{},
 		&ProjectList{},
+		&ProjectKeys{},
+		&ProjectKeyRotation{},
+		&ProjectKeyRetirement{},
 		&AcornImageBuild{},
 		&AcornImageBuildList{},
+		&AcornImageBuildLogs{},
 		&ComputeClass{},
 		&ComputeClassList{},
 		&Region{},
 		&RegionList{},
 		&ImageAllowRule{},
 		&ImageAllowRuleList{},
+		&SecretShare{},
+		&SecretShareList{},
+		&SecretImport{},
+		&SecretImportList{},
+		&ServiceExport{},
+		&ServiceExportList{},
 		&Event{},
 		&EventList{},
 		&DevSession{},
@@ -158,6 +158,24 @@ type ImageCopy struct {
 	Force           bool          `json:"force,omitempty"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageSave struct {
+	metav1.TypeMeta `json:",inline"`
+	Auth            *RegistryAuth `json:"auth,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageLoad struct {
+	metav1.TypeMeta `json:",inline"`
+	// Tag is the tag to give the loaded image, by default the name the image was saved with
+	Tag string `json:"tag,omitempty"`
+	// Target is the tag in a registry to push the image to, instead of loading it into the internal registry
+	Target string        `json:"target,omitempty"`
+	Auth   *RegistryAuth `json:"auth,omitempty"`
+}
+
 type LogMessage struct {
 	Line          string      `json:"line,omitempty"`
 	AppName       string      `json:"appName,omitempty"`
@@ -221,12 +239,15 @@ type ImageDetails struct {
 	Auth         *RegistryAuth `json:"auth,omitempty"`
 	// NoDefaultRegistry - if true, do not assume a default registry on the image if none is specified
 	NoDefaultRegistry bool `json:"noDefaultRegistry,omitempty"`
+	// IncludeAttestations - if true, return the SBOMs and provenance attached to the image
+	IncludeAttestations bool `json:"includeAttestations,omitempty"`
 
 	// Output Params
-	AppImage   v1.AppImage   `json:"appImage,omitempty"`
-	AppSpec    *v1.AppSpec   `json:"appSpec,omitempty"`
-	Params     *v1.ParamSpec `json:"params,omitempty"`
-	ParseError string        `json:"parseError,omitempty"`
+	AppImage     v1.AppImage           `json:"appImage,omitempty"`
+	AppSpec      *v1.AppSpec           `json:"appSpec,omitempty"`
+	Params       *v1.ParamSpec         `json:"params,omitempty"`
+	ParseError   string                `json:"parseError,omitempty"`
+	Attestations []v1.ImageAttestation `json:"attestations,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -240,6 +261,72 @@ type ImageTag struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type ImageSignature struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Auth *RegistryAuth `json:"auth,omitempty"`
+	// Annotations are added to the payloads of the signatures
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Signatures of the image and of the images nested in it. If no signatures are given, the payloads to sign are
+	// returned, otherwise the signatures are attached to the images.
+	Signatures []ImageDigestSignature `json:"signatures,omitempty"`
+}
+
+type ImageDigestSignature struct {
+	Digest  string `json:"digest,omitempty"`
+	Payload []byte `json:"payload,omitempty"`
+	// Signature is the base64 encoded signature of the payload
+	Signature string `json:"signature,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageVerification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Input Params
+	// Key is the public key the image has to be signed with. If empty, the image is checked against the
+	// ImageAllowRules of the project, like the controller does.
+	Key         string                  `json:"key,omitempty"`
+	Annotations v1.SignatureAnnotations `json:"annotations,omitempty"`
+
+	// Output Params
+	Digest   string `json:"digest,omitempty"`
+	Verified bool   `json:"verified,omitempty"`
+	// Reason is why the image failed verification
+	Reason string `json:"reason,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImagePrune struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Input Params
+	// All prunes tagged images too, not only untagged images
+	All bool `json:"all,omitempty"`
+	// OlderThan only prunes the images created longer ago than this duration, e.g. 24h
+	OlderThan string `json:"olderThan,omitempty"`
+	// DryRun only reports the images that would be pruned
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Output Params
+	Images []PrunedImage `json:"images,omitempty"`
+	// ReclaimedBytes is the size of the manifests and layers only the pruned images used
+	ReclaimedBytes int64 `json:"reclaimedBytes,omitempty"`
+}
+
+type PrunedImage struct {
+	Name   string   `json:"name,omitempty"`
+	Digest string   `json:"digest,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type ImageList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
@@ -334,6 +421,8 @@ type ContainerReplicaPortForwardOptions struct {
 const (
 	SecretTypeCredential = "acorn.io/credential"
 	SecretTypeContext    = "acorn.io/context"
+	SecretTypeHistory    = "acorn.io/secret-history"
+	SecretTypeBuildLogs  = "acorn.io/build-logs"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -385,6 +474,38 @@ type SecretList struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type SecretHistory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Versions are the previous versions of the secret, newest first.
+	Versions []SecretVersion `json:"versions,omitempty"`
+}
+
+type SecretVersion struct {
+	// Version is the number of this version, it increases with every change to the secret.
+	Version int `json:"version"`
+	// Created is the time the secret was changed and this version was recorded.
+	Created metav1.Time `json:"created,omitempty"`
+	// Actor is the user that made the change that caused this version to be recorded.
+	Actor string `json:"actor,omitempty"`
+	// Reason is the operation that replaced this version, either update or rollback.
+	Reason string `json:"reason,omitempty"`
+	// Keys are the data keys of the secret in this version.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecretRollback struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Version int `json:"version,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type Info struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -433,6 +554,7 @@ type Config struct {
 	AcornDNSEndpoint               *string         `json:"acornDNSEndpoint" name:"acorn-dns-endpoint" usage:"The URL to access the Acorn DNS service"`
 	AutoUpgradeInterval            *string         `json:"autoUpgradeInterval" name:"auto-upgrade-interval" usage:"For apps configured with automatic upgrades enabled, the interval at which to check for new versions. Upgrade intervals configured at the application level cannot be smaller than this. (default '5m' - 5 minutes)"`
 	RecordBuilds                   *bool           `json:"recordBuilds" name:"record-builds" usage:"Keep a record of each acorn build that happens"`
+	SecretHistoryLimit             *int            `json:"secretHistoryLimit" name:"secret-history-limit" usage:"The number of previous versions of a secret to keep for rollback, 0 disables secret history (default 10)"`
 	PublishBuilders                *bool           `json:"publishBuilders" name:"publish-builders" usage:"Publish the builders through ingress to so build traffic does not traverse the api-server"`
 	BuilderPerProject              *bool           `json:"builderPerProject" name:"builder-per-project" usage:"Create a dedicated builder per project"`
 	InternalRegistryPrefix         *string         `json:"internalRegistryPrefix" name:"internal-registry-prefix" usage:"The image prefix to use when pushing internal images (example ghcr.io/my-org/)"`
@@ -442,6 +564,7 @@ type Config struct {
 	AllowUserMetadataNamespaces    []string        `json:"allowUserMetadataNamespaces" name:"allow-user-metadata-namespace" usage:"Allow these namespaces to propagate labels and annotations to dependent objects, no effect if --ignore-user-labels-and-annotations not true"`
 	WorkloadMemoryDefault          *int64          `json:"workloadMemoryDefault" name:"workload-memory-default" quantity:"true" usage:"Set the default memory for acorn workloads. Accepts binary suffixes (Ki, Mi, Gi, etc) and \".\" and \"_\" seperators (default 0)" short:"m"`
 	WorkloadMemoryMaximum          *int64          `json:"workloadMemoryMaximum" name:"workload-memory-maximum" quantity:"true" usage:"Set the maximum memory for acorn workloads. Accepts binary suffixes (Ki, Mi, Gi, etc) and \".\" and \"_\" seperators (default 0)"`
+	ImageLoadMaximumSize           *int64          `json:"imageLoadMaximumSize" name:"image-load-maximum-size" quantity:"true" usage:"Set the maximum size of image archives loaded with acorn image load, 0 disables the limit. Accepts binary suffixes (Ki, Mi, Gi, etc) and \".\" and \"_\" seperators (default 10Gi)"`
 	UseCustomCABundle              *bool           `json:"useCustomCABundle" name:"use-custom-ca-bundle" usage:"Use CA bundle for admin supplied secret for all acorn control plane components. Defaults to false."`
 	PropagateProjectAnnotations    []string        `json:"propagateProjectAnnotations" name:"propagate-project-annotation" usage:"The list of keys of annotations to propagate from acorn project to app namespaces"`
 	PropagateProjectLabels         []string        `json:"propagateProjectLabels" name:"propagate-project-label" usage:"The list of keys of labels to propagate from acorn project to app namespaces"`
@@ -449,11 +572,18 @@ type Config struct {
 	NetworkPolicies                *bool           `json:"networkPolicies" name:"network-policies" usage:"Create Kubernetes NetworkPolicies which block cross-project network traffic (default false)"`
 	IngressControllerNamespace     *string         `json:"ingressControllerNamespace" name:"ingress-controller-namespace" usage:"The namespace where the ingress controller runs - used to secure published HTTP ports with NetworkPolicies."`
 	AllowTrafficFromNamespace      []string        `json:"allowTrafficFromNamespace" name:"allow-traffic-from-namespace" usage:"Namespaces that are allowed to send network traffic to all Acorn apps"`
+	ACMEDirectoryURL               *string         `json:"acmeDirectoryURL" name:"acme-directory-url" usage:"The directory URL of the ACME server to request certificates from instead of Let's Encrypt, for example a local Pebble server for testing"`
+	DenyEgressByDefault            *bool           `json:"denyEgressByDefault" name:"deny-egress-by-default" usage:"Block outgoing network traffic, except DNS, from containers without egress rules. Requires --network-policies (default false)"`
 	ServiceLBAnnotations           []string        `json:"serviceLBAnnotations" name:"service-lb-annotation" usage:"Annotation to add to the service of type LoadBalancer. Defaults to empty. (example key=value)"`
+	ServiceLBMode                  *string         `json:"serviceLBMode" name:"service-lb-mode" usage:"dedicated|shared. If shared, published TCP and UDP ports are allocated from --shared-lb-port-range and exposed as NodePorts behind a single load balancer instead of one LoadBalancer service per app (default dedicated)"`
+	SharedLBPortRange              *string         `json:"sharedLBPortRange" name:"shared-lb-port-range" usage:"The range of ports, within the cluster NodePort range, to allocate published TCP and UDP ports from if --service-lb-mode=shared (default 30000-32767)"`
+	SharedLBAddress                *string         `json:"sharedLBAddress" name:"shared-lb-address" usage:"The hostname or IP address of the load balancer forwarding --shared-lb-port-range to the cluster nodes, used in the endpoints of apps if --service-lb-mode=shared"`
 	AWSIdentityProviderARN         *string         `json:"awsIdentityProviderArn" name:"aws-identity-provider-arn" usage:"ARN of cluster's OpenID Connect provider registered in AWS"`
 	EventTTL                       *string         `json:"eventTTL" name:"event-ttl" usage:"Amount of time an Acorn event will be stored before being deleted (default '168h' - 7 days)"`
+	AuditEventTTL                  *string         `json:"auditEventTTL" name:"audit-event-ttl" usage:"Amount of time an Acorn audit event, like a secret reveal, exec or port-forward, will be stored before being deleted (default is the event TTL)"`
 	Features                       map[string]bool `json:"features" name:"features" boolmap:"true" usage:"Enable or disable features. (example foo=true,bar=false)"`
 	CertManagerIssuer              *string         `json:"certManagerIssuer" name:"cert-manager-issuer" usage:"The name of the cert-manager cluster issuer to use for TLS certificates on custom domains" default:""`
+	Gateway                        *string         `json:"gateway" name:"gateway" usage:"The Gateway API Gateway, in the form of namespace/name, to publish endpoints through instead of Ingress and LoadBalancer services (default '')"`
 	Profile                        *string         `json:"profile" name:"profile" usage:"The name of the profile to use for the installation. Profiles options are production (prod) and default. (default profile is default)"`
 
 	// Flags for setting resource request and limits on sytem components
@@ -498,6 +628,76 @@ type ProjectList struct {
 	Items           []Project `json:"items"`
 }
 
+const (
+	ProjectKeyReferenceKindSecret = "secret"
+	ProjectKeyReferenceKindApp    = "app"
+	ProjectKeyReferenceKindImage  = "image"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectKeys struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Keys are the encryption keys of the project, the primary key first.
+	Keys []ProjectKey `json:"keys,omitempty"`
+}
+
+func (p *ProjectKeys) NamespaceScoped() bool {
+	return false
+}
+
+type ProjectKey struct {
+	// KeyID is the public key used to encrypt data for the project.
+	KeyID string `json:"keyID"`
+
+	// Primary is true for the key that is used to encrypt new data.
+	Primary bool `json:"primary,omitempty"`
+
+	// References are the objects in the project that hold data encrypted with the key.
+	References []ProjectKeyReference `json:"references,omitempty"`
+}
+
+type ProjectKeyReference struct {
+	// Kind is the kind of the object holding the encrypted data: secret, app or image.
+	Kind string `json:"kind"`
+
+	// Name is the name of the object holding the encrypted data.
+	Name string `json:"name"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectKeyRotation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// PrimaryKey is the ID of the new primary key of the project.
+	PrimaryKey string `json:"primaryKey,omitempty"`
+
+	// ReEncrypted are the objects whose data was re-encrypted with the new primary key.
+	ReEncrypted []ProjectKeyReference `json:"reEncrypted,omitempty"`
+}
+
+func (p *ProjectKeyRotation) NamespaceScoped() bool {
+	return false
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectKeyRetirement struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// KeyID is the ID of the key to retire.
+	KeyID string `json:"keyID"`
+}
+
+func (p *ProjectKeyRetirement) NamespaceScoped() bool {
+	return false
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type Builder v1.BuilderInstance
@@ -524,6 +724,16 @@ type AcornImageBuildList struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type AcornImageBuildLogs struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Logs is the output of the build, the end of it if the output was too long to keep
+	Logs []byte `json:"logs,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type VolumeClass adminv1.ProjectVolumeClassInstance
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -560,6 +770,42 @@ type ImageAllowRuleList struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type SecretShare v1.SecretShareInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecretShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretShare `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecretImport v1.SecretImportInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecretImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretImport `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceExport v1.ServiceExportInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceExport `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type Event v1.EventInstance
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -69,6 +69,36 @@ func (in *AcornImageBuildList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcornImageBuildLogs) DeepCopyInto(out *AcornImageBuildLogs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcornImageBuildLogs.
+func (in *AcornImageBuildLogs) DeepCopy() *AcornImageBuildLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(AcornImageBuildLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AcornImageBuildLogs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Acornfile) DeepCopyInto(out *Acornfile) {
 	*out = *in
@@ -436,6 +466,11 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SecretHistoryLimit != nil {
+		in, out := &in.SecretHistoryLimit, &out.SecretHistoryLimit
+		*out = new(int)
+		**out = **in
+	}
 	if in.PublishBuilders != nil {
 		in, out := &in.PublishBuilders, &out.PublishBuilders
 		*out = new(bool)
@@ -481,6 +516,11 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ImageLoadMaximumSize != nil {
+		in, out := &in.ImageLoadMaximumSize, &out.ImageLoadMaximumSize
+		*out = new(int64)
+		**out = **in
+	}
 	if in.UseCustomCABundle != nil {
 		in, out := &in.UseCustomCABundle, &out.UseCustomCABundle
 		*out = new(bool)
@@ -516,11 +556,36 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ACMEDirectoryURL != nil {
+		in, out := &in.ACMEDirectoryURL, &out.ACMEDirectoryURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.DenyEgressByDefault != nil {
+		in, out := &in.DenyEgressByDefault, &out.DenyEgressByDefault
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ServiceLBAnnotations != nil {
 		in, out := &in.ServiceLBAnnotations, &out.ServiceLBAnnotations
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceLBMode != nil {
+		in, out := &in.ServiceLBMode, &out.ServiceLBMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedLBPortRange != nil {
+		in, out := &in.SharedLBPortRange, &out.SharedLBPortRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedLBAddress != nil {
+		in, out := &in.SharedLBAddress, &out.SharedLBAddress
+		*out = new(string)
+		**out = **in
+	}
 	if in.AWSIdentityProviderARN != nil {
 		in, out := &in.AWSIdentityProviderARN, &out.AWSIdentityProviderARN
 		*out = new(string)
@@ -531,6 +596,11 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AuditEventTTL != nil {
+		in, out := &in.AuditEventTTL, &out.AuditEventTTL
+		*out = new(string)
+		**out = **in
+	}
 	if in.Features != nil {
 		in, out := &in.Features, &out.Features
 		*out = make(map[string]bool, len(*in))
@@ -543,6 +613,11 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(string)
+		**out = **in
+	}
 	if in.Profile != nil {
 		in, out := &in.Profile, &out.Profile
 		*out = new(string)
@@ -976,7 +1051,9 @@ func (in *EmbeddedContainer) DeepCopyInto(out *EmbeddedContainer) {
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make(internal_acorn_iov1.Ports, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Probes != nil {
 		in, out := &in.Probes, &out.Probes
@@ -1005,6 +1082,13 @@ func (in *EmbeddedContainer) DeepCopyInto(out *EmbeddedContainer) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make(internal_acorn_iov1.EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Metrics = in.Metrics
 	if in.Scale != nil {
 		in, out := &in.Scale, &out.Scale
@@ -1187,6 +1271,7 @@ func (in *ImageAllowRule) DeepCopyInto(out *ImageAllowRule) {
 		copy(*out, *in)
 	}
 	in.Signatures.DeepCopyInto(&out.Signatures)
+	in.Attestations.DeepCopyInto(&out.Attestations)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAllowRule.
@@ -1300,6 +1385,13 @@ func (in *ImageDetails) DeepCopyInto(out *ImageDetails) {
 		*out = new(internal_acorn_iov1.ParamSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]internal_acorn_iov1.ImageAttestation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDetails.
@@ -1320,6 +1412,26 @@ func (in *ImageDetails) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageDigestSignature) DeepCopyInto(out *ImageDigestSignature) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDigestSignature.
+func (in *ImageDigestSignature) DeepCopy() *ImageDigestSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageDigestSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageList) DeepCopyInto(out *ImageList) {
 	*out = *in
@@ -1352,6 +1464,67 @@ func (in *ImageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageLoad) DeepCopyInto(out *ImageLoad) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(RegistryAuth)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageLoad.
+func (in *ImageLoad) DeepCopy() *ImageLoad {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageLoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageLoad) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrune) DeepCopyInto(out *ImagePrune) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]PrunedImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrune.
+func (in *ImagePrune) DeepCopy() *ImagePrune {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePrune) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagePull) DeepCopyInto(out *ImagePull) {
 	*out = *in
@@ -1410,6 +1583,79 @@ func (in *ImagePush) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSave) DeepCopyInto(out *ImageSave) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(RegistryAuth)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSave.
+func (in *ImageSave) DeepCopy() *ImageSave {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageSave) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSignature) DeepCopyInto(out *ImageSignature) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(RegistryAuth)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Signatures != nil {
+		in, out := &in.Signatures, &out.Signatures
+		*out = make([]ImageDigestSignature, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSignature.
+func (in *ImageSignature) DeepCopy() *ImageSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageSignature) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageTag) DeepCopyInto(out *ImageTag) {
 	*out = *in
@@ -1435,6 +1681,32 @@ func (in *ImageTag) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerification) DeepCopyInto(out *ImageVerification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Annotations.DeepCopyInto(&out.Annotations)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerification.
+func (in *ImageVerification) DeepCopy() *ImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageVerification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Info) DeepCopyInto(out *Info) {
 	*out = *in
@@ -1624,58 +1896,59 @@ func (in *Project) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *ProjectKey) DeepCopyInto(out *ProjectKey) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]ProjectKeyReference, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectKey.
+func (in *ProjectKey) DeepCopy() *ProjectKey {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(ProjectKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectKeyReference) DeepCopyInto(out *ProjectKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectKeyReference.
+func (in *ProjectKeyReference) DeepCopy() *ProjectKeyReference {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ProjectKeyReference)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Region) DeepCopyInto(out *Region) {
+func (in *ProjectKeyRetirement) DeepCopyInto(out *ProjectKeyRetirement) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
-func (in *Region) DeepCopy() *Region {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectKeyRetirement.
+func (in *ProjectKeyRetirement) DeepCopy() *ProjectKeyRetirement {
 	if in == nil {
 		return nil
 	}
-	out := new(Region)
+	out := new(ProjectKeyRetirement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Region) DeepCopyObject() runtime.Object {
+func (in *ProjectKeyRetirement) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1683,31 +1956,29 @@ func (in *Region) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegionList) DeepCopyInto(out *RegionList) {
+func (in *ProjectKeyRotation) DeepCopyInto(out *ProjectKeyRotation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Region, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.ReEncrypted != nil {
+		in, out := &in.ReEncrypted, &out.ReEncrypted
+		*out = make([]ProjectKeyReference, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionList.
-func (in *RegionList) DeepCopy() *RegionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectKeyRotation.
+func (in *ProjectKeyRotation) DeepCopy() *ProjectKeyRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(RegionList)
+	out := new(ProjectKeyRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RegionList) DeepCopyObject() runtime.Object {
+func (in *ProjectKeyRotation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1715,13 +1986,156 @@ func (in *RegionList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegionSpec) DeepCopyInto(out *RegionSpec) {
+func (in *ProjectKeys) DeepCopyInto(out *ProjectKeys) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionSpec.
-func (in *RegionSpec) DeepCopy() *RegionSpec {
-	if in == nil {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]ProjectKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectKeys.
+func (in *ProjectKeys) DeepCopy() *ProjectKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectKeys) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrunedImage) DeepCopyInto(out *PrunedImage) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrunedImage.
+func (in *PrunedImage) DeepCopy() *PrunedImage {
+	if in == nil {
+		return nil
+	}
+	out := new(PrunedImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Region) DeepCopyInto(out *Region) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
+func (in *Region) DeepCopy() *Region {
+	if in == nil {
+		return nil
+	}
+	out := new(Region)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Region) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionList) DeepCopyInto(out *RegionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionList.
+func (in *RegionList) DeepCopy() *RegionList {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionSpec) DeepCopyInto(out *RegionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionSpec.
+func (in *RegionSpec) DeepCopy() *RegionSpec {
+	if in == nil {
 		return nil
 	}
 	out := new(RegionSpec)
@@ -1811,6 +2225,96 @@ func (in *Secret) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretHistory) DeepCopyInto(out *SecretHistory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]SecretVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretHistory.
+func (in *SecretHistory) DeepCopy() *SecretHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretHistory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretImport) DeepCopyInto(out *SecretImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretImport.
+func (in *SecretImport) DeepCopy() *SecretImport {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretImportList) DeepCopyInto(out *SecretImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretImportList.
+func (in *SecretImportList) DeepCopy() *SecretImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretList) DeepCopyInto(out *SecretList) {
 	*out = *in
@@ -1843,6 +2347,111 @@ func (in *SecretList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRollback) DeepCopyInto(out *SecretRollback) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRollback.
+func (in *SecretRollback) DeepCopy() *SecretRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretRollback) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretShare) DeepCopyInto(out *SecretShare) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretShare.
+func (in *SecretShare) DeepCopy() *SecretShare {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretShare)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretShare) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretShareList) DeepCopyInto(out *SecretShareList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretShare, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretShareList.
+func (in *SecretShareList) DeepCopy() *SecretShareList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretShareList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretShareList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretVersion) DeepCopyInto(out *SecretVersion) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretVersion.
+func (in *SecretVersion) DeepCopy() *SecretVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Service) DeepCopyInto(out *Service) {
 	*out = *in
@@ -1870,6 +2479,64 @@ func (in *Service) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceExport) DeepCopyInto(out *ServiceExport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceExport.
+func (in *ServiceExport) DeepCopy() *ServiceExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceExportList) DeepCopyInto(out *ServiceExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceExportList.
+func (in *ServiceExportList) DeepCopy() *ServiceExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceList) DeepCopyInto(out *ServiceList) {
 	*out = *in
@@ -24,6 +24,14 @@ type VCS struct {
 	Untracked bool `json:"untracked,omitempty"`
 }
 
+// ImageAttestation is an in-toto statement attached to an image, like an SBOM or the provenance of the image
+type ImageAttestation struct {
+	// Subject is the digest of the image the statement is about
+	Subject       string     `json:"subject,omitempty"`
+	PredicateType string     `json:"predicateType,omitempty"`
+	Predicate     GenericMap `json:"predicate,omitempty"`
+}
+
 type Platform struct {
 	Architecture string   `json:"architecture"`
 	OS           string   `json:"os"`
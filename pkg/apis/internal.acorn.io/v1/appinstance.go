@@ -106,6 +106,10 @@ type AppInstanceSpec struct {
 	AutoUpgradeInterval     string           `json:"autoUpgradeInterval,omitempty"`
 	ComputeClasses          ComputeClassMap  `json:"computeClass,omitempty"`
 	Memory                  MemoryMap        `json:"memory,omitempty"`
+	// Routes are added to the routers of the Acornfile, replacing the routes of the Acornfile with the same path
+	Routes []RouteBinding `json:"routes,omitempty"`
+	// Egress rules are added to the egress rules of the containers and jobs of the Acornfile
+	Egress []EgressBinding `json:"egress,omitempty"`
 }
 
 func (in *AppInstanceSpec) GetPermissions() []Permissions {
@@ -236,6 +240,29 @@ type Endpoint struct {
 	Protocol        Protocol        `json:"protocol,omitempty"`
 	PublishProtocol PublishProtocol `json:"publishProtocol,omitempty"`
 	Pending         bool            `json:"pending,omitempty"`
+	// Message explains why the endpoint isn't fully available yet, for example because its certificate is still being requested
+	Message string `json:"message,omitempty"`
+	// Health is the result of the last periodic check of the endpoint, it is nil until the endpoint is checked
+	Health *EndpointHealth `json:"health,omitempty"`
+}
+
+type EndpointHealth struct {
+	LastChecked metav1.Time `json:"lastChecked,omitempty"`
+	// Skipped is set if the endpoint isn't checked from the cluster, because its address resolves to a loopback,
+	// private or link-local address
+	Skipped     bool `json:"skipped,omitempty"`
+	DNSResolved bool `json:"dnsResolved,omitempty"`
+	Reachable   bool `json:"reachable,omitempty"`
+	// The certificate fields are only set for endpoints published with HTTPS
+	CertificateValid         bool         `json:"certificateValid,omitempty"`
+	CertificateNotAfter      *metav1.Time `json:"certificateNotAfter,omitempty"`
+	CertificateDaysRemaining int32        `json:"certificateDaysRemaining,omitempty"`
+	Error                    string       `json:"error,omitempty"`
+}
+
+// Healthy returns true if the address of the endpoint resolves, the endpoint responds and its certificate is valid
+func (in EndpointHealth) Healthy() bool {
+	return in.DNSResolved && in.Reachable && (in.CertificateNotAfter == nil || in.CertificateValid)
 }
 
 func (in *AppInstanceStatus) Condition(name string) Condition {
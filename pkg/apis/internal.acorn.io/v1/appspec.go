@@ -48,6 +48,11 @@ type Build struct {
 	BaseImage          string            `json:"baseImage,omitempty"`
 	ContextDirs        map[string]string `json:"contextDirs,omitempty"`
 	BuildArgs          map[string]string `json:"buildArgs,omitempty"`
+	// Secrets are the IDs of the secrets of the client that RUN --mount=type=secret instructions can use, and SSH the
+	// IDs of its SSH agents that RUN --mount=type=ssh instructions can use. They aren't part of the Acornfile, they
+	// are set from the secrets and SSH agents that the client provides to this build.
+	Secrets []string `json:"secrets,omitempty"`
+	SSH     []string `json:"ssh,omitempty"`
 }
 
 func (in Build) BaseBuild() Build {
@@ -55,6 +60,8 @@ func (in Build) BaseBuild() Build {
 		Context:    in.Context,
 		Dockerfile: in.Dockerfile,
 		Target:     in.Target,
+		Secrets:    in.Secrets,
+		SSH:        in.SSH,
 	}
 }
 
@@ -91,6 +98,50 @@ type PortBinding struct {
 	// Deprecated All ports are exposed by default
 	TargetPort        int32  `json:"targetPort,omitempty"`
 	TargetServiceName string `json:"targetServiceName,omitempty"`
+	// Auth puts an auth proxy in front of the published http port
+	Auth *PortAuth `json:"auth,omitempty"`
+	// HTTP changes how requests to the published http port are handled by the ingress controller
+	HTTP *PortHTTPOptions `json:"http,omitempty"`
+}
+
+const (
+	PortAuthTypeBasic = "basic"
+	PortAuthTypeOIDC  = "oidc"
+)
+
+type PortAuth struct {
+	// Type is basic or oidc
+	Type string `json:"type,omitempty"`
+	// Secret is the name of the secret with the htpasswd file or username and password for basic auth, or the
+	// issuer, clientId and clientSecret for oidc
+	Secret string `json:"secret,omitempty"`
+}
+
+type PortHTTPOptions struct {
+	// ForceHTTPS redirects http requests to https
+	ForceHTTPS bool `json:"forceHTTPS,omitempty"`
+	// Rewrite publishes the port under a path prefix, which is replaced before requests are sent to the port
+	Rewrite *PortHTTPRewrite `json:"rewrite,omitempty"`
+	CORS    *PortHTTPCORS    `json:"cors,omitempty"`
+	// MaxBodySize is the maximum size of request bodies, as a quantity like 10Mi
+	MaxBodySize string `json:"maxBodySize,omitempty"`
+	// Timeout is how long to wait for the port to receive a request or send a response, as a duration like 2m
+	Timeout string `json:"timeout,omitempty"`
+}
+
+type PortHTTPRewrite struct {
+	Prefix string `json:"prefix,omitempty"`
+	// Replacement is the path the prefix is replaced with, / by default
+	Replacement string `json:"replacement,omitempty"`
+}
+
+type PortHTTPCORS struct {
+	AllowOrigins     []string `json:"allowOrigins,omitempty"`
+	AllowMethods     []string `json:"allowMethods,omitempty"`
+	AllowHeaders     []string `json:"allowHeaders,omitempty"`
+	AllowCredentials bool     `json:"allowCredentials,omitempty"`
+	// MaxAge is how many seconds the result of a preflight request can be cached
+	MaxAge int32 `json:"maxAge,omitempty"`
 }
 
 func (in PortBinding) Complete() PortBinding {
@@ -125,12 +176,15 @@ func (in PortDef) FormatString(serviceName string) string {
 }
 
 type PortDef struct {
-	Hostname   string   `json:"hostname,omitempty"`
-	Protocol   Protocol `json:"protocol,omitempty"`
-	Publish    bool     `json:"publish,omitempty"`
-	Dev        bool     `json:"dev,omitempty"`
-	Port       int32    `json:"port,omitempty"`
-	TargetPort int32    `json:"targetPort,omitempty"`
+	Hostname   string    `json:"hostname,omitempty"`
+	Protocol   Protocol  `json:"protocol,omitempty"`
+	Publish    bool      `json:"publish,omitempty"`
+	Dev        bool      `json:"dev,omitempty"`
+	Port       int32     `json:"port,omitempty"`
+	TargetPort int32     `json:"targetPort,omitempty"`
+	Auth       *PortAuth `json:"auth,omitempty"`
+	// HTTP changes how requests to the published http port are handled by the ingress controller
+	HTTP *PortHTTPOptions `json:"http,omitempty"`
 }
 
 func (in PortDef) Complete() PortDef {
@@ -458,6 +512,7 @@ type Container struct {
 	Permissions  *Permissions           `json:"permissions,omitempty"`
 	ComputeClass *string                `json:"class,omitempty"`
 	Memory       *int64                 `json:"memory,omitempty"`
+	Egress       EgressRules            `json:"egress,omitempty"`
 
 	// Metrics is available on containers and jobs, but not sidecars
 	Metrics MetricsDef `json:"metrics,omitempty"`
@@ -478,6 +533,27 @@ type Container struct {
 	Sidecars map[string]Container `json:"sidecars,omitempty"`
 }
 
+type EgressRules []EgressRule
+
+// EgressRule allows outgoing traffic from a container. Only one of Service, CIDR and DNSName is set.
+type EgressRule struct {
+	// Service is the name of a container or service in the app, or the local name of a linked service
+	Service string `json:"service,omitempty"`
+	CIDR    string `json:"cidr,omitempty"`
+	// DNSName is resolved to the IP addresses that are allowed and resolved again periodically
+	DNSName string `json:"dnsName,omitempty"`
+	// Ports restricts the traffic to these TCP ports, all ports are allowed if empty. For a service these are
+	// the ports of the service.
+	Ports []int32 `json:"ports,omitempty"`
+}
+
+// EgressBinding is an egress rule given when running the app
+type EgressBinding struct {
+	// Container is the name of the container or job the rule is added to, the rule is added to all of them if empty
+	Container  string `json:"container,omitempty"`
+	EgressRule `json:",inline"`
+}
+
 type Image struct {
 	Image      string      `json:"image,omitempty"`
 	Build      *Build      `json:"containerBuild,omitempty"`
@@ -502,10 +578,60 @@ type Route struct {
 	TargetServiceName string   `json:"targetServiceName,omitempty"`
 	TargetPort        int      `json:"targetPort,omitempty"`
 	PathType          PathType `json:"pathType,omitempty"`
+	// Targets split the traffic of the route between services by weight, TargetServiceName and TargetPort are
+	// ignored if set
+	Targets []WeightedRouteTarget `json:"targets,omitempty"`
+	// Headers and QueryParams must all match for a request to use this route. Routes sharing a path are tried
+	// in order and the first match is used.
+	Headers     NameValues `json:"headers,omitempty"`
+	QueryParams NameValues `json:"queryParams,omitempty"`
+}
+
+type WeightedRouteTarget struct {
+	TargetServiceName string `json:"targetServiceName,omitempty"`
+	TargetPort        int    `json:"targetPort,omitempty"`
+	// Weight is the relative share of the traffic of the route sent to this target, defaults to 1
+	Weight int `json:"weight,omitempty"`
+}
+
+// WeightedTargets returns the targets of the route, a route with a single target is returned as one target
+// with a weight of 1. Targets without a service name are dropped.
+func (in Route) WeightedTargets() (result []WeightedRouteTarget) {
+	targets := in.Targets
+	if len(targets) == 0 {
+		targets = []WeightedRouteTarget{
+			{
+				TargetServiceName: in.TargetServiceName,
+				TargetPort:        in.TargetPort,
+			},
+		}
+	}
+	for _, target := range targets {
+		if target.TargetServiceName == "" {
+			continue
+		}
+		if target.Weight == 0 {
+			target.Weight = 1
+		}
+		result = append(result, target)
+	}
+	return
+}
+
+// HasAdvancedRouting returns true if the route splits traffic between targets or has match conditions, which
+// can't be expressed by a plain Ingress rule.
+func (in Route) HasAdvancedRouting() bool {
+	return len(in.Targets) > 1 || len(in.Headers) > 0 || len(in.QueryParams) > 0
 }
 
 type Routes []Route
 
+// RouteBinding is a route of a router of the Acornfile given when running the app
+type RouteBinding struct {
+	Router string `json:"router,omitempty"`
+	Route  `json:",inline"`
+}
+
 type Router struct {
 	Labels      map[string]string `json:"labels,omitempty"`
 	Annotations map[string]string `json:"annotations,omitempty"`
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"golang.org/x/exp/slices"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -101,17 +102,47 @@ type AcornImageBuildInstanceSpec struct {
 	Platforms       []Platform `json:"platforms,omitempty"`
 	Args            GenericMap `json:"args,omitempty"`
 	VCS             VCS        `json:"vcs,omitempty"`
+	// CacheFrom are the registry repositories the build cache is imported from
+	CacheFrom []string `json:"cacheFrom,omitempty"`
+	// CacheTo are the registry repositories the build cache is exported to
+	CacheTo []string `json:"cacheTo,omitempty"`
+	// SBOM generates an SPDX SBOM for each container image
+	SBOM bool `json:"sbom,omitempty"`
+	// Provenance generates an in-toto provenance statement for the Acorn image
+	Provenance bool `json:"provenance,omitempty"`
+	// Secrets are the secrets that RUN --mount=type=secret instructions of the builds can use, and SSH the SSH agents
+	// RUN --mount=type=ssh instructions can use. The values are provided by the client running the build and are
+	// never stored.
+	Secrets []BuildSecretID `json:"secrets,omitempty"`
+	SSH     []BuildSecretID `json:"ssh,omitempty"`
+	// Actor is the user that started the build, set by the API server
+	Actor string `json:"actor,omitempty"`
+}
+
+// BuildSecretID is the ID of a secret or SSH agent of the client and the builds that can use it
+type BuildSecretID struct {
+	ID string `json:"id,omitempty"`
+	// Builds are the keys of the containers, jobs, sidecars (as container.sidecar) and images whose builds can use the
+	// secret or agent, every build can use it if empty
+	Builds []string `json:"builds,omitempty"`
+}
+
+// Allowed returns true if the build of the container, job, sidecar or image with the key can use the secret or agent
+func (in BuildSecretID) Allowed(key string) bool {
+	return len(in.Builds) == 0 || slices.Contains(in.Builds, key)
 }
 
 type AcornImageBuildInstanceStatus struct {
-	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
-	Recorded           bool        `json:"recorded,omitempty"`
-	BuildURL           string      `json:"buildURL,omitempty"`
-	Token              string      `json:"token,omitempty"`
-	AppImage           AppImage    `json:"appImage,omitempty"`
-	Conditions         []Condition `json:"conditions,omitempty"`
-	BuildError         string      `json:"buildError,omitempty"`
-	Region             string      `json:"region,omitempty"`
+	ObservedGeneration int64        `json:"observedGeneration,omitempty"`
+	Recorded           bool         `json:"recorded,omitempty"`
+	BuildURL           string       `json:"buildURL,omitempty"`
+	Token              string       `json:"token,omitempty"`
+	AppImage           AppImage     `json:"appImage,omitempty"`
+	Conditions         []Condition  `json:"conditions,omitempty"`
+	BuildError         string       `json:"buildError,omitempty"`
+	Region             string       `json:"region,omitempty"`
+	StartTime          *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime     *metav1.Time `json:"completionTime,omitempty"`
 }
 
 func (in *AcornImageBuildInstance) Conditions() *[]Condition {
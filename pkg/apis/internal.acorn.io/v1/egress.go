@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ParseEgressBindings parses egress rules in the form [container=]rule, a rule without a container is added to all
+// containers and jobs of the app.
+func ParseEgressBindings(args []string) (result []EgressBinding, _ error) {
+	for _, arg := range args {
+		var container string
+		if before, after, ok := strings.Cut(arg, "="); ok {
+			if !nameRegexp.MatchString(before) {
+				return nil, fmt.Errorf("invalid container name [%s] in egress rule [%s]", before, arg)
+			}
+			container, arg = before, after
+		}
+
+		rule, err := ParseEgressRule(arg)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, EgressBinding{
+			Container:  container,
+			EgressRule: rule,
+		})
+	}
+	return
+}
+
+// BindEgress adds the egress rules of the bindings to the containers and jobs of the app
+func (in *AppSpec) BindEgress(bindings []EgressBinding) error {
+	for _, binding := range bindings {
+		if err := binding.Validate(); err != nil {
+			return err
+		}
+		if binding.Container == "" {
+			for name, container := range in.Containers {
+				container.Egress = append(container.Egress, binding.EgressRule)
+				in.Containers[name] = container
+			}
+			for name, job := range in.Jobs {
+				job.Egress = append(job.Egress, binding.EgressRule)
+				in.Jobs[name] = job
+			}
+		} else if container, ok := in.Containers[binding.Container]; ok {
+			container.Egress = append(container.Egress, binding.EgressRule)
+			in.Containers[binding.Container] = container
+		} else if job, ok := in.Jobs[binding.Container]; ok {
+			job.Egress = append(job.Egress, binding.EgressRule)
+			in.Jobs[binding.Container] = job
+		} else {
+			return fmt.Errorf("egress rule is bound to the container [%s] which is not defined", binding.Container)
+		}
+	}
+	return nil
+}
+
+// ParseEgressRule parses the short form of an egress rule, which is a service name, CIDR, IP address or DNS name
+// optionally followed by :port. IPv6 addresses can't have a port in the short form.
+func ParseEgressRule(s string) (EgressRule, error) {
+	var (
+		rule   EgressRule
+		target = s
+	)
+
+	if strings.Count(s, ":") == 1 {
+		var port string
+		target, port, _ = strings.Cut(s, ":")
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil || p == 0 {
+			return rule, fmt.Errorf("invalid port [%s] in egress rule [%s]", port, s)
+		}
+		rule.Ports = []int32{int32(p)}
+	}
+
+	switch {
+	case strings.Contains(target, "/"):
+		rule.CIDR = target
+	case net.ParseIP(target) != nil:
+		if net.ParseIP(target).To4() != nil {
+			rule.CIDR = target + "/32"
+		} else {
+			rule.CIDR = target + "/128"
+		}
+	case strings.Contains(target, "."):
+		rule.DNSName = target
+	default:
+		rule.Service = target
+	}
+
+	return rule, rule.Validate()
+}
+
+func (in EgressRule) Validate() error {
+	var set int
+	for _, value := range []string{in.Service, in.CIDR, in.DNSName} {
+		if value != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("egress rule must have exactly one of service, cidr or dnsName")
+	}
+
+	if in.CIDR != "" {
+		if _, _, err := net.ParseCIDR(in.CIDR); err != nil {
+			return fmt.Errorf("invalid cidr [%s] in egress rule: %w", in.CIDR, err)
+		}
+	}
+	if in.Service != "" && !nameRegexp.MatchString(in.Service) {
+		return fmt.Errorf("invalid service name [%s] in egress rule", in.Service)
+	}
+	for _, port := range in.Ports {
+		if port <= 0 || port > 65535 {
+			return fmt.Errorf("invalid port [%d] in egress rule", port)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEgressRule(t *testing.T) {
+	tests := []struct {
+		rule       string
+		wantResult EgressRule
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			rule:       "db",
+			wantResult: EgressRule{Service: "db"},
+			wantErr:    assert.NoError,
+		},
+		{
+			rule:       "db:5432",
+			wantResult: EgressRule{Service: "db", Ports: []int32{5432}},
+			wantErr:    assert.NoError,
+		},
+		{
+			rule:       "10.0.0.0/8",
+			wantResult: EgressRule{CIDR: "10.0.0.0/8"},
+			wantErr:    assert.NoError,
+		},
+		{
+			rule:       "10.0.0.1:443",
+			wantResult: EgressRule{CIDR: "10.0.0.1/32", Ports: []int32{443}},
+			wantErr:    assert.NoError,
+		},
+		{
+			rule:       "2001:db8::1",
+			wantResult: EgressRule{CIDR: "2001:db8::1/128"},
+			wantErr:    assert.NoError,
+		},
+		{
+			rule:       "api.example.com:443",
+			wantResult: EgressRule{DNSName: "api.example.com", Ports: []int32{443}},
+			wantErr:    assert.NoError,
+		},
+		{
+			rule:    "10.0.0.0/33",
+			wantErr: assert.Error,
+		},
+		{
+			rule:    "db:http",
+			wantErr: assert.Error,
+		},
+		{
+			rule:    "Db",
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			gotResult, err := ParseEgressRule(tt.rule)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantResult, gotResult)
+		})
+	}
+}
+
+func TestParseEgressBindings(t *testing.T) {
+	bindings, err := ParseEgressBindings([]string{"api=db:5432", "api.example.com:443"})
+	assert.NoError(t, err)
+	assert.Equal(t, []EgressBinding{
+		{Container: "api", EgressRule: EgressRule{Service: "db", Ports: []int32{5432}}},
+		{EgressRule: EgressRule{DNSName: "api.example.com", Ports: []int32{443}}},
+	}, bindings)
+
+	_, err = ParseEgressBindings([]string{"API=db"})
+	assert.Error(t, err)
+
+	_, err = ParseEgressBindings([]string{"api=db:http"})
+	assert.Error(t, err)
+}
+
+func TestBindEgress(t *testing.T) {
+	app := AppSpec{
+		Containers: map[string]Container{
+			"api": {
+				Egress: EgressRules{{Service: "db"}},
+			},
+			"web": {},
+		},
+		Jobs: map[string]Container{
+			"migrate": {},
+		},
+	}
+
+	err := app.BindEgress([]EgressBinding{
+		{Container: "api", EgressRule: EgressRule{CIDR: "10.0.0.0/8"}},
+		{Container: "migrate", EgressRule: EgressRule{Service: "db", Ports: []int32{5432}}},
+		{EgressRule: EgressRule{DNSName: "api.example.com"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, EgressRules{{Service: "db"}, {CIDR: "10.0.0.0/8"}, {DNSName: "api.example.com"}}, app.Containers["api"].Egress)
+	assert.Equal(t, EgressRules{{DNSName: "api.example.com"}}, app.Containers["web"].Egress)
+	assert.Equal(t, EgressRules{{Service: "db", Ports: []int32{5432}}, {DNSName: "api.example.com"}}, app.Jobs["migrate"].Egress)
+
+	err = app.BindEgress([]EgressBinding{{Container: "missing", EgressRule: EgressRule{Service: "db"}}})
+	assert.Error(t, err)
+}
+
+func TestUnmarshalEgressBinding(t *testing.T) {
+	var binding EgressBinding
+	err := json.Unmarshal([]byte(`{"container": "api", "service": "db", "ports": [5432]}`), &binding)
+	assert.NoError(t, err)
+	assert.Equal(t, EgressBinding{Container: "api", EgressRule: EgressRule{Service: "db", Ports: []int32{5432}}}, binding)
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func (in PortHTTPOptions) Validate() error {
+	if in.Rewrite != nil {
+		if !strings.HasPrefix(in.Rewrite.Prefix, "/") || in.Rewrite.Prefix == "/" {
+			return fmt.Errorf("invalid rewrite prefix [%s] must be a path other than /", in.Rewrite.Prefix)
+		}
+		if in.Rewrite.Replacement != "" && !strings.HasPrefix(in.Rewrite.Replacement, "/") {
+			return fmt.Errorf("invalid rewrite replacement [%s] must be a path", in.Rewrite.Replacement)
+		}
+	}
+
+	if in.CORS != nil && len(in.CORS.AllowOrigins) == 0 {
+		return fmt.Errorf("cors requires at least one allowed origin")
+	}
+
+	if in.MaxBodySize != "" {
+		if _, err := in.MaxBodySizeBytes(); err != nil {
+			return err
+		}
+	}
+
+	if in.Timeout != "" {
+		if _, err := in.TimeoutSeconds(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MaxBodySizeBytes returns the maximum request body size in bytes
+func (in PortHTTPOptions) MaxBodySizeBytes() (int64, error) {
+	size, err := resource.ParseQuantity(in.MaxBodySize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max body size [%s]: %w", in.MaxBodySize, err)
+	}
+	if size.Value() <= 0 {
+		return 0, fmt.Errorf("invalid max body size [%s] must be greater than 0", in.MaxBodySize)
+	}
+	return size.Value(), nil
+}
+
+// TimeoutSeconds returns the timeout in whole seconds, since that is what ingress controllers accept
+func (in PortHTTPOptions) TimeoutSeconds() (int64, error) {
+	timeout, err := time.ParseDuration(in.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout [%s]: %w", in.Timeout, err)
+	}
+	if timeout < time.Second {
+		return 0, fmt.Errorf("invalid timeout [%s] must be at least 1s", in.Timeout)
+	}
+	return int64(timeout / time.Second), nil
+}
+
+// ReplacementPrefix returns the path the rewrite prefix is replaced with, without a trailing slash
+func (in PortHTTPRewrite) ReplacementPrefix() string {
+	return strings.TrimSuffix(in.Replacement, "/")
+}
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortHTTPOptions(t *testing.T) {
+	tests := []struct {
+		port       string
+		wantResult PortDef
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			port: `{"port": 80, "protocol": "http", "http": {"forceHTTPS": true, "maxBodySize": "10Mi", "timeout": "2m"}}`,
+			wantResult: PortDef{Port: 80, Protocol: ProtocolHTTP, HTTP: &PortHTTPOptions{
+				ForceHTTPS:  true,
+				MaxBodySize: "10Mi",
+				Timeout:     "2m",
+			}},
+			wantErr: assert.NoError,
+		},
+		{
+			port: `{"port": 80, "http": {"rewrite": {"prefix": "/api"}, "cors": {"allowOrigins": ["https://example.com"]}}}`,
+			wantResult: PortDef{Port: 80, HTTP: &PortHTTPOptions{
+				Rewrite: &PortHTTPRewrite{Prefix: "/api"},
+				CORS:    &PortHTTPCORS{AllowOrigins: []string{"https://example.com"}},
+			}},
+			wantErr: assert.NoError,
+		},
+		{
+			port:    `{"port": 80, "protocol": "tcp", "http": {"forceHTTPS": true}}`,
+			wantErr: assert.Error,
+		},
+		{
+			port:    `{"port": 80, "http": {"rewrite": {"prefix": "/"}}}`,
+			wantErr: assert.Error,
+		},
+		{
+			port:    `{"port": 80, "http": {"rewrite": {"prefix": "/api", "replacement": "v1"}}}`,
+			wantErr: assert.Error,
+		},
+		{
+			port:    `{"port": 80, "http": {"cors": {}}}`,
+			wantErr: assert.Error,
+		},
+		{
+			port:    `{"port": 80, "http": {"maxBodySize": "lots"}}`,
+			wantErr: assert.Error,
+		},
+		{
+			port:    `{"port": 80, "http": {"timeout": "500ms"}}`,
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.port, func(t *testing.T) {
+			var port PortDef
+			err := json.Unmarshal([]byte(tt.port), &port)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantResult, port)
+		})
+	}
+}
+
+func TestPortHTTPOptionsValues(t *testing.T) {
+	opts := PortHTTPOptions{MaxBodySize: "10Mi", Timeout: "90s"}
+
+	size, err := opts.MaxBodySizeBytes()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10*1024*1024), size)
+
+	timeout, err := opts.TimeoutSeconds()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(90), timeout)
+
+	assert.Equal(t, "/v1", PortHTTPRewrite{Prefix: "/api", Replacement: "/v1/"}.ReplacementPrefix())
+	assert.Equal(t, "", PortHTTPRewrite{Prefix: "/api"}.ReplacementPrefix())
+}
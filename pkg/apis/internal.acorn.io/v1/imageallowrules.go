@@ -35,6 +35,30 @@ type ImageAllowRuleSignatures struct {
 	Rules []SignatureRules `json:"rules,omitempty"`
 }
 
+type ImageAllowRuleAttestations struct {
+	Rules []AttestationRules `json:"rules,omitempty"`
+}
+
+// AttestationRules are attestations an image must have. All set fields must be satisfied.
+type AttestationRules struct {
+	// PredicateTypes are the predicate types of in-toto attestations the image must have
+	PredicateTypes []string `json:"predicateTypes,omitempty"`
+	// Provenance requires a SLSA provenance attestation
+	Provenance *ProvenanceRules `json:"provenance,omitempty"`
+	// SBOM requires an SPDX SBOM attestation
+	SBOM *SBOMRules `json:"sbom,omitempty"`
+}
+
+type ProvenanceRules struct {
+	// BuilderIDs are the builders allowed to build the image. If empty, any builder is allowed.
+	BuilderIDs []string `json:"builderIDs,omitempty"`
+}
+
+type SBOMRules struct {
+	// DenyPackages are glob patterns of packages the SBOMs must not contain, in the form NAME or NAME@VERSION
+	DenyPackages []string `json:"denyPackages,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type ImageAllowRuleInstance struct {
@@ -43,6 +67,8 @@ type ImageAllowRuleInstance struct {
 
 	Images     []string                 `json:"images,omitempty"` // list of patterns to match against image names
 	Signatures ImageAllowRuleSignatures `json:"signatures,omitempty"`
+	// Attestations are checked against the SBOMs and provenance attached to the image
+	Attestations ImageAllowRuleAttestations `json:"attestations,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
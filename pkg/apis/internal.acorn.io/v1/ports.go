@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -196,6 +197,110 @@ func parsePortTuple(left, right string) (PortDef, error) {
 	return PortDef{}, fmt.Errorf("invalidate port [%s:%s] must be [hostname:port] or [port:port] format", left, right)
 }
 
+// cutPortOptions splits the comma separated options, like auth=basic:secret-name, off a port or port binding
+type portOptions struct {
+	auth *PortAuth
+	http *PortHTTPOptions
+}
+
+// cutPortOptions cuts the comma separated options from a port. The options that take a list, like corsOrigins,
+// separate its entries with |.
+func cutPortOptions(arg string) (string, portOptions, error) {
+	arg, options, ok := strings.Cut(arg, ",")
+	if !ok {
+		return arg, portOptions{}, nil
+	}
+
+	var (
+		result portOptions
+		http   PortHTTPOptions
+		cors   PortHTTPCORS
+	)
+	for key, value := range KVMap(options, ",") {
+		switch key {
+		case "auth":
+			authType, secretName, _ := strings.Cut(value, ":")
+			if authType != PortAuthTypeBasic && authType != PortAuthTypeOIDC {
+				return "", portOptions{}, fmt.Errorf("invalid auth type [%s] must be %s or %s", authType, PortAuthTypeBasic, PortAuthTypeOIDC)
+			}
+			if secretName == "" {
+				return "", portOptions{}, fmt.Errorf("invalid auth [%s] must be in the form of [type:secret-name]", value)
+			}
+			result.auth = &PortAuth{
+				Type:   authType,
+				Secret: secretName,
+			}
+		case "forceHTTPS":
+			if value != "" && value != "true" && value != "false" {
+				return "", portOptions{}, fmt.Errorf("invalid forceHTTPS [%s] must be true or false", value)
+			}
+			http.ForceHTTPS = value != "false"
+		case "rewrite":
+			prefix, replacement, _ := strings.Cut(value, ":")
+			http.Rewrite = &PortHTTPRewrite{
+				Prefix:      prefix,
+				Replacement: replacement,
+			}
+		case "maxBodySize":
+			http.MaxBodySize = value
+		case "timeout":
+			http.Timeout = value
+		case "corsOrigins":
+			cors.AllowOrigins = splitPortOptionList(value)
+		case "corsMethods":
+			cors.AllowMethods = splitPortOptionList(value)
+		case "corsHeaders":
+			cors.AllowHeaders = splitPortOptionList(value)
+		case "corsCredentials":
+			if value != "" && value != "true" && value != "false" {
+				return "", portOptions{}, fmt.Errorf("invalid corsCredentials [%s] must be true or false", value)
+			}
+			cors.AllowCredentials = value != "false"
+		case "corsMaxAge":
+			maxAge, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || maxAge < 0 {
+				return "", portOptions{}, fmt.Errorf("invalid corsMaxAge [%s] must be a number of seconds", value)
+			}
+			cors.MaxAge = int32(maxAge)
+		default:
+			return "", portOptions{}, fmt.Errorf("invalid port option [%s]", key)
+		}
+	}
+
+	if !reflect.DeepEqual(cors, PortHTTPCORS{}) {
+		http.CORS = &cors
+	}
+	if !reflect.DeepEqual(http, PortHTTPOptions{}) {
+		if err := http.Validate(); err != nil {
+			return "", portOptions{}, err
+		}
+		result.http = &http
+	}
+	return arg, result, nil
+}
+
+func splitPortOptionList(value string) (result []string) {
+	for _, entry := range strings.Split(value, "|") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			result = append(result, entry)
+		}
+	}
+	return
+}
+
+func validatePortOptions(options portOptions, proto Protocol) error {
+	if proto == "" || proto == ProtocolHTTP {
+		return nil
+	}
+	if options.auth != nil {
+		return fmt.Errorf("auth can only be set on http ports, not [%s]", proto)
+	}
+	if options.http != nil {
+		return fmt.Errorf("http options can only be set on http ports, not [%s]", proto)
+	}
+	return nil
+}
+
 func ParsePorts(args []string) (result []PortDef, _ error) {
 	for _, arg := range args {
 		var (
@@ -203,6 +308,10 @@ func ParsePorts(args []string) (result []PortDef, _ error) {
 			err  error
 		)
 
+		arg, options, err := cutPortOptions(arg)
+		if err != nil {
+			return nil, err
+		}
 		arg, proto, _ := strings.Cut(arg, "/")
 		parts := strings.Split(arg, ":")
 
@@ -234,6 +343,12 @@ func ParsePorts(args []string) (result []PortDef, _ error) {
 			port.Protocol = p
 		}
 
+		if err := validatePortOptions(options, port.Protocol); err != nil {
+			return nil, err
+		}
+		port.Auth = options.auth
+		port.HTTP = options.http
+
 		result = append(result, port)
 	}
 	return
@@ -246,6 +361,10 @@ func ParsePortBindings(args []string) (result []PortBinding, _ error) {
 			err     error
 		)
 
+		arg, options, err := cutPortOptions(arg)
+		if err != nil {
+			return nil, err
+		}
 		arg, proto, _ := strings.Cut(arg, "/")
 		parts := strings.Split(arg, ":")
 
@@ -281,6 +400,12 @@ func ParsePortBindings(args []string) (result []PortBinding, _ error) {
 			binding.Protocol = p
 		}
 
+		if err := validatePortOptions(options, binding.Protocol); err != nil {
+			return nil, err
+		}
+		binding.Auth = options.auth
+		binding.HTTP = options.http
+
 		result = append(result, binding)
 	}
 	return
@@ -291,6 +416,10 @@ func ParseLinks(args []string) (result []ServiceBinding, _ error) {
 		existing, secName, ok := strings.Cut(arg, ":")
 		if !ok {
 			secName = existing
+			// Links to services of other projects, project/app.service, are named after the service by default
+			if _, service, ok := strings.Cut(existing, "/"); ok {
+				secName = service[strings.LastIndex(service, ".")+1:]
+			}
 		}
 		secName = strings.TrimSpace(secName)
 		existing = strings.TrimSpace(existing)
@@ -159,6 +159,59 @@ func TestParsePorts(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			port: "80/http,auth=basic:users",
+			wantResult: PortDef{
+				Protocol:   ProtocolHTTP,
+				TargetPort: 80,
+				Auth: &PortAuth{
+					Type:   PortAuthTypeBasic,
+					Secret: "users",
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			port:    "80/tcp,auth=basic:users",
+			wantErr: assert.Error,
+		},
+		{
+			port:    "80/http,auth=ldap:users",
+			wantErr: assert.Error,
+		},
+		{
+			port:    "80/http,auth=oidc",
+			wantErr: assert.Error,
+		},
+		{
+			port:    "80/http,foo=bar",
+			wantErr: assert.Error,
+		},
+		{
+			port: "80/http,forceHTTPS,maxBodySize=10Mi,timeout=2m",
+			wantResult: PortDef{
+				Protocol:   ProtocolHTTP,
+				TargetPort: 80,
+				HTTP: &PortHTTPOptions{
+					ForceHTTPS:  true,
+					MaxBodySize: "10Mi",
+					Timeout:     "2m",
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			port:    "80/tcp,forceHTTPS",
+			wantErr: assert.Error,
+		},
+		{
+			port:    "80/http,timeout=10",
+			wantErr: assert.Error,
+		},
+		{
+			port:    "80/http,corsMethods=GET",
+			wantErr: assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		if tt.name == "" {
@@ -286,6 +339,58 @@ func TestParsePortBindings(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			port: "example.com:bar:82,auth=oidc:sso",
+			wantResult: PortBinding{
+				Protocol:          ProtocolHTTP,
+				TargetPort:        82,
+				Hostname:          "example.com",
+				TargetServiceName: "bar",
+				Auth: &PortAuth{
+					Type:   PortAuthTypeOIDC,
+					Secret: "sso",
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			port:    "app:80/tcp,auth=basic:users",
+			wantErr: assert.Error,
+		},
+		{
+			port: "example.com:bar:82,auth=oidc:sso,rewrite=/api:/v1,corsOrigins=https://example.com|https://www.example.com,corsMethods=GET|POST,corsCredentials,corsMaxAge=600",
+			wantResult: PortBinding{
+				Protocol:          ProtocolHTTP,
+				TargetPort:        82,
+				Hostname:          "example.com",
+				TargetServiceName: "bar",
+				Auth: &PortAuth{
+					Type:   PortAuthTypeOIDC,
+					Secret: "sso",
+				},
+				HTTP: &PortHTTPOptions{
+					Rewrite: &PortHTTPRewrite{
+						Prefix:      "/api",
+						Replacement: "/v1",
+					},
+					CORS: &PortHTTPCORS{
+						AllowOrigins:     []string{"https://example.com", "https://www.example.com"},
+						AllowMethods:     []string{"GET", "POST"},
+						AllowCredentials: true,
+						MaxAge:           600,
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			port:    "app:80/udp,maxBodySize=1Mi",
+			wantErr: assert.Error,
+		},
+		{
+			port:    "example.com:bar:82,rewrite=/",
+			wantErr: assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.port, func(t *testing.T) {
@@ -299,3 +404,15 @@ func TestParsePortBindings(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLinks(t *testing.T) {
+	links, err := ParseLinks([]string{"my-app.db:db", "redis", "shared/my-app.db", "shared/my-app", "shared/my-app.db:shared-db"})
+	assert.NoError(t, err)
+	assert.Equal(t, []ServiceBinding{
+		{Service: "my-app.db", Target: "db"},
+		{Service: "redis", Target: "redis"},
+		{Service: "shared/my-app.db", Target: "db"},
+		{Service: "shared/my-app", Target: "my-app"},
+		{Service: "shared/my-app.db", Target: "shared-db"},
+	}, links)
+}
@@ -17,6 +17,18 @@ type ProjectInstance struct {
 type ProjectInstanceSpec struct {
 	DefaultRegion    string   `json:"defaultRegion,omitempty"`
 	SupportedRegions []string `json:"supportedRegions,omitempty"`
+	// BuildCache is the registry repository builds in the project import their cache from and export it to, unless
+	// the build sets its own
+	BuildCache string `json:"buildCache,omitempty"`
+	// ImageRetention is how long the images in the internal registry of the project that no app uses are kept
+	ImageRetention *ImageRetention `json:"imageRetention,omitempty"`
+}
+
+type ImageRetention struct {
+	// Untagged is how long untagged images are kept, e.g. 24h. Untagged images are kept forever if empty.
+	Untagged string `json:"untagged,omitempty"`
+	// Tagged is how long tagged images are kept, e.g. 720h. Tagged images are kept forever if empty.
+	Tagged string `json:"tagged,omitempty"`
 }
 
 type ProjectInstanceStatus struct {
@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseRouteBindings parses routes in the form
+// router:path,target=service[:port][@weight][,target=...][,header=name=value][,query=name=value][,pathType=prefix|exact]
+// The target, header and query options can be repeated, a route with several targets splits the traffic between them
+// by weight.
+func ParseRouteBindings(args []string) (result []RouteBinding, _ error) {
+	for _, arg := range args {
+		routerPath, options, _ := strings.Cut(arg, ",")
+		router, path, ok := strings.Cut(routerPath, ":")
+		if !ok || router == "" || !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("invalid route [%s] must be in the form of [router:/path,target=service]", arg)
+		}
+
+		binding := RouteBinding{
+			Router: router,
+			Route: Route{
+				Path:     path,
+				PathType: PathTypePrefix,
+			},
+		}
+
+		var (
+			targets  []WeightedRouteTarget
+			weighted bool
+		)
+		for _, option := range strings.Split(options, ",") {
+			if option = strings.TrimSpace(option); option == "" {
+				continue
+			}
+			key, value, _ := strings.Cut(option, "=")
+			switch key {
+			case "target":
+				target, hasWeight, err := parseRouteTarget(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid route [%s]: %w", arg, err)
+				}
+				weighted = weighted || hasWeight
+				targets = append(targets, target)
+			case "header", "query":
+				name, matchValue, ok := strings.Cut(value, "=")
+				if !ok || name == "" {
+					return nil, fmt.Errorf("invalid route [%s], %s must be in the form of [name=value]", arg, key)
+				}
+				if key == "header" {
+					binding.Headers = append(binding.Headers, NameValue{Name: name, Value: matchValue})
+				} else {
+					binding.QueryParams = append(binding.QueryParams, NameValue{Name: name, Value: matchValue})
+				}
+			case "pathType":
+				if value != string(PathTypePrefix) && value != string(PathTypeExact) {
+					return nil, fmt.Errorf("invalid route [%s], pathType must be %s or %s", arg, PathTypePrefix, PathTypeExact)
+				}
+				binding.PathType = PathType(value)
+			default:
+				return nil, fmt.Errorf("invalid route [%s], unknown option [%s]", arg, key)
+			}
+		}
+
+		switch {
+		case len(targets) == 0:
+			return nil, fmt.Errorf("invalid route [%s], at least one target is required", arg)
+		case len(targets) == 1 && !weighted:
+			binding.TargetServiceName = targets[0].TargetServiceName
+			binding.TargetPort = targets[0].TargetPort
+		default:
+			binding.Targets = targets
+		}
+
+		result = append(result, binding)
+	}
+	return
+}
+
+func parseRouteTarget(s string) (result WeightedRouteTarget, weighted bool, _ error) {
+	s, weight, weighted := strings.Cut(s, "@")
+	if weighted {
+		n, err := strconv.Atoi(weight)
+		if err != nil || n < 1 {
+			return result, false, fmt.Errorf("weight [%s] of target must be a number greater than 0", weight)
+		}
+		result.Weight = n
+	}
+
+	name, port, hasPort := strings.Cut(s, ":")
+	if !nameRegexp.MatchString(name) {
+		return result, false, fmt.Errorf("invalid target service name [%s]", name)
+	}
+	result.TargetServiceName = name
+	if hasPort {
+		n, err := strconv.Atoi(port)
+		if err != nil || n < 1 || n > 65535 {
+			return result, false, fmt.Errorf("invalid target port [%s]", port)
+		}
+		result.TargetPort = n
+	}
+	return result, weighted, nil
+}
+
+// BindRoutes adds the routes of the bindings to the routers of the app. The bindings replace the routes of the router
+// with the same path, several bindings with the same path are matched in the order they are given.
+func (in *AppSpec) BindRoutes(bindings []RouteBinding) error {
+	byRouter := map[string][]RouteBinding{}
+	for _, binding := range bindings {
+		if _, ok := in.Routers[binding.Router]; !ok {
+			return fmt.Errorf("route %s is bound to the router [%s] which is not defined", binding.Path, binding.Router)
+		}
+		byRouter[binding.Router] = append(byRouter[binding.Router], binding)
+	}
+
+	for routerName, bindings := range byRouter {
+		router := in.Routers[routerName]
+
+		bound := map[string]bool{}
+		for _, binding := range bindings {
+			bound[binding.Path] = true
+		}
+
+		var routes Routes
+		for _, route := range router.Routes {
+			if !bound[route.Path] {
+				routes = append(routes, route)
+			}
+		}
+		for _, binding := range bindings {
+			routes = append(routes, binding.Route)
+		}
+
+		// The same order as the routes of the Acornfile, the longest paths first
+		sort.SliceStable(routes, func(i, j int) bool {
+			if len(routes[i].Path) != len(routes[j].Path) {
+				return len(routes[i].Path) > len(routes[j].Path)
+			}
+			return routes[i].Path < routes[j].Path
+		})
+
+		router.Routes = routes
+		in.Routers[routerName] = router
+	}
+
+	return nil
+}
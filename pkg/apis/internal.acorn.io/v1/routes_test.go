@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRouteBindings(t *testing.T) {
+	bindings, err := ParseRouteBindings([]string{
+		"web:/api,target=api:8080",
+		"web:/,target=web@90,target=web-canary@10,header=x-env=beta,query=debug=true,pathType=exact",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []RouteBinding{
+		{
+			Router: "web",
+			Route: Route{
+				Path:              "/api",
+				PathType:          PathTypePrefix,
+				TargetServiceName: "api",
+				TargetPort:        8080,
+			},
+		},
+		{
+			Router: "web",
+			Route: Route{
+				Path:     "/",
+				PathType: PathTypeExact,
+				Targets: []WeightedRouteTarget{
+					{TargetServiceName: "web", Weight: 90},
+					{TargetServiceName: "web-canary", Weight: 10},
+				},
+				Headers:     NameValues{{Name: "x-env", Value: "beta"}},
+				QueryParams: NameValues{{Name: "debug", Value: "true"}},
+			},
+		},
+	}, bindings)
+}
+
+func TestParseRouteBindingsInvalid(t *testing.T) {
+	for _, arg := range []string{
+		"web",
+		"web:api,target=api",
+		":/api,target=api",
+		"web:/api",
+		"web:/api,target=Api",
+		"web:/api,target=api:0",
+		"web:/api,target=api@0",
+		"web:/api,target=api,header=x-env",
+		"web:/api,target=api,pathType=regex",
+		"web:/api,target=api,method=GET",
+	} {
+		_, err := ParseRouteBindings([]string{arg})
+		assert.Error(t, err, arg)
+	}
+}
+
+func TestBindRoutes(t *testing.T) {
+	app := AppSpec{
+		Routers: map[string]Router{
+			"web": {
+				Routes: Routes{
+					{Path: "/api", TargetServiceName: "api", PathType: PathTypePrefix},
+					{Path: "/", TargetServiceName: "web", PathType: PathTypePrefix},
+				},
+			},
+		},
+	}
+
+	err := app.BindRoutes([]RouteBinding{
+		{
+			Router: "web",
+			Route: Route{
+				Path:     "/",
+				PathType: PathTypePrefix,
+				Targets: []WeightedRouteTarget{
+					{TargetServiceName: "web", Weight: 90},
+					{TargetServiceName: "web-canary", Weight: 10},
+				},
+			},
+		},
+		{
+			Router: "web",
+			Route:  Route{Path: "/auth", TargetServiceName: "auth", PathType: PathTypePrefix},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Routes{
+		{Path: "/auth", TargetServiceName: "auth", PathType: PathTypePrefix},
+		{Path: "/api", TargetServiceName: "api", PathType: PathTypePrefix},
+		{
+			Path:     "/",
+			PathType: PathTypePrefix,
+			Targets: []WeightedRouteTarget{
+				{TargetServiceName: "web", Weight: 90},
+				{TargetServiceName: "web-canary", Weight: 10},
+			},
+		},
+	}, app.Routers["web"].Routes)
+
+	err = app.BindRoutes([]RouteBinding{{Router: "missing", Route: Route{Path: "/"}}})
+	assert.Error(t, err)
+}
@@ -34,6 +34,12 @@ func AddToScheme(scheme *runtime.Scheme) error {
 		&DevSessionInstanceList{},
 		&ProjectInstance{},
 		&ProjectInstanceList{},
+		&SecretShareInstance{},
+		&SecretShareInstanceList{},
+		&SecretImportInstance{},
+		&SecretImportInstanceList{},
+		&ServiceExportInstance{},
+		&ServiceExportInstanceList{},
 	)
 
 	// Add common types
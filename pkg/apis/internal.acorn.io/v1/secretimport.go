@@ -0,0 +1,34 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecretImportInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretImportInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecretImportInstance lives in a project a secret is shared with and accepts the copy of the secret that another
+// project shares with a SecretShareInstance.
+type SecretImportInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec SecretImportInstanceSpec `json:"spec,omitempty"`
+}
+
+type SecretImportInstanceSpec struct {
+	// Project is the project the secret is shared from
+	Project string `json:"project,omitempty"`
+	// SecretName is the name of the copy of the shared secret in the project of the import
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// Imports returns true if the copy of the secret shared from the project is accepted
+func (in *SecretImportInstance) Imports(project, secretName string) bool {
+	return in.Spec.Project == project && in.Spec.SecretName == secretName
+}
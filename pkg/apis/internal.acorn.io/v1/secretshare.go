@@ -0,0 +1,50 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecretShareInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretShareInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecretShareInstance lives in the project of the shared secret and grants the listed projects access to
+// a synced copy of the secret.
+type SecretShareInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   SecretShareInstanceSpec   `json:"spec,omitempty"`
+	Status SecretShareInstanceStatus `json:"status,omitempty"`
+}
+
+type SecretShareInstanceSpec struct {
+	// SecretName is the name of the secret in the project of the share
+	SecretName string `json:"secretName,omitempty"`
+	// TargetName is the name of the copy of the secret in the target projects, defaults to SecretName
+	TargetName string `json:"targetName,omitempty"`
+	// Projects are the projects the secret is shared with
+	Projects []string `json:"projects,omitempty"`
+}
+
+type SecretShareInstanceStatus struct {
+	ObservedGeneration int64                      `json:"observedGeneration,omitempty"`
+	Projects           []SecretShareProjectStatus `json:"projects,omitempty"`
+}
+
+type SecretShareProjectStatus struct {
+	Project string `json:"project,omitempty"`
+	Synced  bool   `json:"synced,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func (in *SecretShareInstance) TargetName() string {
+	if in.Spec.TargetName != "" {
+		return in.Spec.TargetName
+	}
+	return in.Spec.SecretName
+}
@@ -62,10 +62,13 @@ type ServiceInstanceSpec struct {
 }
 
 type PortPublish struct {
-	Port       int32    `json:"port,omitempty"`
-	Protocol   Protocol `json:"protocol,omitempty"`
-	Hostname   string   `json:"hostname,omitempty"`
-	TargetPort int32    `json:"targetPort,omitempty"`
+	Port       int32     `json:"port,omitempty"`
+	Protocol   Protocol  `json:"protocol,omitempty"`
+	Hostname   string    `json:"hostname,omitempty"`
+	TargetPort int32     `json:"targetPort,omitempty"`
+	Auth       *PortAuth `json:"auth,omitempty"`
+	// HTTP changes how requests to the published http port are handled by the ingress controller
+	HTTP *PortHTTPOptions `json:"http,omitempty"`
 }
 
 func (in PortPublish) Complete() PortPublish {
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceExportInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceExportInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceExportInstance lives in the project of the exported service and allows apps in the listed projects to link
+// to the service with links of the form project/app.service.
+type ServiceExportInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec ServiceExportInstanceSpec `json:"spec,omitempty"`
+}
+
+type ServiceExportInstanceSpec struct {
+	// Service is the exported service in the form app.service, or app for the default service of the app
+	Service string `json:"service,omitempty"`
+	// Projects are the projects allowed to link to the service
+	Projects []string `json:"projects,omitempty"`
+}
+
+// Exports returns true if the service is exported to the project
+func (in *ServiceExportInstance) Exports(service, project string) bool {
+	return in.Spec.Service == service && slices.Contains(in.Spec.Projects, project)
+}
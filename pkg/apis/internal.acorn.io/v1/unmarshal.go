@@ -26,9 +26,12 @@ var (
 )
 
 type routeTarget struct {
-	PathType          PathType `json:"pathType,omitempty"`
-	TargetPort        int      `json:"targetPort,omitempty"`
-	TargetServiceName string   `json:"targetServiceName,omitempty"`
+	PathType          PathType              `json:"pathType,omitempty"`
+	TargetPort        int                   `json:"targetPort,omitempty"`
+	TargetServiceName string                `json:"targetServiceName,omitempty"`
+	Targets           []WeightedRouteTarget `json:"targets,omitempty"`
+	Headers           NameValues            `json:"headers,omitempty"`
+	QueryParams       NameValues            `json:"queryParams,omitempty"`
 }
 
 func (in *routeTarget) UnmarshalJSON(data []byte) error {
@@ -73,6 +76,9 @@ func (in *Routes) UnmarshalJSON(data []byte) error {
 			TargetServiceName: v.TargetServiceName,
 			TargetPort:        v.TargetPort,
 			PathType:          v.PathType,
+			Targets:           v.Targets,
+			Headers:           v.Headers,
+			QueryParams:       v.QueryParams,
 		})
 	}
 	sort.Slice(routes, func(i, j int) bool {
@@ -769,6 +775,50 @@ func (in *Permissions) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (in *EgressRules) UnmarshalJSON(data []byte) error {
+	if !isString(data) {
+		return json.Unmarshal(data, (*[]EgressRule)(in))
+	}
+	var rule EgressRule
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return err
+	}
+	*in = append(*in, rule)
+	return nil
+}
+
+func (in *EgressRule) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		s, err := parseString(data)
+		if err != nil {
+			return err
+		}
+		*in, err = ParseEgressRule(s)
+		return err
+	}
+
+	type egressRule EgressRule
+	if err := json.Unmarshal(data, (*egressRule)(in)); err != nil {
+		return err
+	}
+	return in.Validate()
+}
+
+func (in *EgressBinding) UnmarshalJSON(data []byte) error {
+	// EgressRule has its own UnmarshalJSON, which would otherwise be used for the whole binding
+	var binding struct {
+		Container string `json:"container,omitempty"`
+	}
+	if err := json.Unmarshal(data, &binding); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &in.EgressRule); err != nil {
+		return err
+	}
+	in.Container = binding.Container
+	return nil
+}
+
 func (in *Dependency) UnmarshalJSON(data []byte) error {
 	if isString(data) {
 		s, err := parseString(data)
@@ -806,7 +856,16 @@ func (in *PortDef) UnmarshalJSON(data []byte) error {
 	}
 
 	type portDef PortDef
-	return json.Unmarshal(data, (*portDef)(in))
+	if err := json.Unmarshal(data, (*portDef)(in)); err != nil {
+		return err
+	}
+	if in.HTTP != nil {
+		if in.Protocol != "" && in.Protocol != ProtocolHTTP {
+			return fmt.Errorf("http options can only be set on http ports, not [%s]", in.Protocol)
+		}
+		return in.HTTP.Validate()
+	}
+	return nil
 }
 
 func (in *Ports) UnmarshalJSON(data []byte) error {
@@ -57,7 +57,9 @@ func (in *Acorn) DeepCopyInto(out *Acorn) {
 	if in.Publish != nil {
 		in, out := &in.Publish, &out.Publish
 		*out = make(PortBindings, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Environment != nil {
 		in, out := &in.Environment, &out.Environment
@@ -244,6 +246,30 @@ func (in *AcornImageBuildInstanceSpec) DeepCopyInto(out *AcornImageBuildInstance
 	}
 	out.Args = in.Args.DeepCopy()
 	in.VCS.DeepCopyInto(&out.VCS)
+	if in.CacheFrom != nil {
+		in, out := &in.CacheFrom, &out.CacheFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheTo != nil {
+		in, out := &in.CacheTo, &out.CacheTo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]BuildSecretID, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SSH != nil {
+		in, out := &in.SSH, &out.SSH
+		*out = make([]BuildSecretID, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcornImageBuildInstanceSpec.
@@ -267,6 +293,14 @@ func (in *AcornImageBuildInstanceStatus) DeepCopyInto(out *AcornImageBuildInstan
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcornImageBuildInstanceStatus.
@@ -450,7 +484,9 @@ func (in *AppInstanceSpec) DeepCopyInto(out *AppInstanceSpec) {
 	if in.Publish != nil {
 		in, out := &in.Publish, &out.Publish
 		*out = make([]PortBinding, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	out.DeployArgs = in.DeployArgs.DeepCopy()
 	if in.Permissions != nil {
@@ -499,6 +535,20 @@ func (in *AppInstanceSpec) DeepCopyInto(out *AppInstanceSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]RouteBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]EgressBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppInstanceSpec.
@@ -690,7 +740,9 @@ func (in *AppStatus) DeepCopyInto(out *AppStatus) {
 	if in.Endpoints != nil {
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]Endpoint, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -704,6 +756,36 @@ func (in *AppStatus) DeepCopy() *AppStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttestationRules) DeepCopyInto(out *AttestationRules) {
+	*out = *in
+	if in.PredicateTypes != nil {
+		in, out := &in.PredicateTypes, &out.PredicateTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(ProvenanceRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(SBOMRules)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttestationRules.
+func (in *AttestationRules) DeepCopy() *AttestationRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AttestationRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Build) DeepCopyInto(out *Build) {
 	*out = *in
@@ -728,6 +810,16 @@ func (in *Build) DeepCopyInto(out *Build) {
 			(*out)[key] = val
 		}
 	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSH != nil {
+		in, out := &in.SSH, &out.SSH
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Build.
@@ -775,6 +867,26 @@ func (in *BuildRecord) DeepCopy() *BuildRecord {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildSecretID) DeepCopyInto(out *BuildSecretID) {
+	*out = *in
+	if in.Builds != nil {
+		in, out := &in.Builds, &out.Builds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSecretID.
+func (in *BuildSecretID) DeepCopy() *BuildSecretID {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildSecretID)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuilderInstance) DeepCopyInto(out *BuilderInstance) {
 	*out = *in
@@ -1033,7 +1145,9 @@ func (in *Container) DeepCopyInto(out *Container) {
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make(Ports, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Probes != nil {
 		in, out := &in.Probes, &out.Probes
@@ -1062,6 +1176,13 @@ func (in *Container) DeepCopyInto(out *Container) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.Metrics = in.Metrics
 	if in.Scale != nil {
 		in, out := &in.Scale, &out.Scale
@@ -1422,9 +1543,71 @@ func (in *DevSessionInstanceStatus) DeepCopy() *DevSessionInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressBinding) DeepCopyInto(out *EgressBinding) {
+	*out = *in
+	in.EgressRule.DeepCopyInto(&out.EgressRule)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressBinding.
+func (in *EgressBinding) DeepCopy() *EgressBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressRule) DeepCopyInto(out *EgressRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRule.
+func (in *EgressRule) DeepCopy() *EgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in EgressRules) DeepCopyInto(out *EgressRules) {
+	{
+		in := &in
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRules.
+func (in EgressRules) DeepCopy() EgressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(EndpointHealth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
@@ -1437,6 +1620,26 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointHealth) DeepCopyInto(out *EndpointHealth) {
+	*out = *in
+	in.LastChecked.DeepCopyInto(&out.LastChecked)
+	if in.CertificateNotAfter != nil {
+		in, out := &in.CertificateNotAfter, &out.CertificateNotAfter
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointHealth.
+func (in *EndpointHealth) DeepCopy() *EndpointHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvVar) DeepCopyInto(out *EnvVar) {
 	*out = *in
@@ -1700,6 +1903,28 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAllowRuleAttestations) DeepCopyInto(out *ImageAllowRuleAttestations) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AttestationRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAllowRuleAttestations.
+func (in *ImageAllowRuleAttestations) DeepCopy() *ImageAllowRuleAttestations {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAllowRuleAttestations)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageAllowRuleInstance) DeepCopyInto(out *ImageAllowRuleInstance) {
 	*out = *in
@@ -1711,6 +1936,7 @@ func (in *ImageAllowRuleInstance) DeepCopyInto(out *ImageAllowRuleInstance) {
 		copy(*out, *in)
 	}
 	in.Signatures.DeepCopyInto(&out.Signatures)
+	in.Attestations.DeepCopyInto(&out.Attestations)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAllowRuleInstance.
@@ -1785,6 +2011,22 @@ func (in *ImageAllowRuleSignatures) DeepCopy() *ImageAllowRuleSignatures {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAttestation) DeepCopyInto(out *ImageAttestation) {
+	*out = *in
+	out.Predicate = in.Predicate.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAttestation.
+func (in *ImageAttestation) DeepCopy() *ImageAttestation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAttestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageBuilderSpec) DeepCopyInto(out *ImageBuilderSpec) {
 	*out = *in
@@ -1887,6 +2129,21 @@ func (in *ImageInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRetention) DeepCopyInto(out *ImageRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRetention.
+func (in *ImageRetention) DeepCopy() *ImageRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagesData) DeepCopyInto(out *ImagesData) {
 	*out = *in
@@ -2165,9 +2422,34 @@ func (in *PolicyRule) DeepCopy() *PolicyRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortAuth) DeepCopyInto(out *PortAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortAuth.
+func (in *PortAuth) DeepCopy() *PortAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(PortAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortBinding) DeepCopyInto(out *PortBinding) {
 	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(PortAuth)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(PortHTTPOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortBinding.
@@ -2185,7 +2467,9 @@ func (in PortBindings) DeepCopyInto(out *PortBindings) {
 	{
 		in := &in
 		*out = make(PortBindings, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2202,6 +2486,16 @@ func (in PortBindings) DeepCopy() PortBindings {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortDef) DeepCopyInto(out *PortDef) {
 	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(PortAuth)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(PortHTTPOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortDef.
@@ -2215,60 +2509,142 @@ func (in *PortDef) DeepCopy() *PortDef {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PortPublish) DeepCopyInto(out *PortPublish) {
+func (in *PortHTTPCORS) DeepCopyInto(out *PortHTTPCORS) {
 	*out = *in
+	if in.AllowOrigins != nil {
+		in, out := &in.AllowOrigins, &out.AllowOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowMethods != nil {
+		in, out := &in.AllowMethods, &out.AllowMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowHeaders != nil {
+		in, out := &in.AllowHeaders, &out.AllowHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortPublish.
-func (in *PortPublish) DeepCopy() *PortPublish {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortHTTPCORS.
+func (in *PortHTTPCORS) DeepCopy() *PortHTTPCORS {
 	if in == nil {
 		return nil
 	}
-	out := new(PortPublish)
+	out := new(PortHTTPCORS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in Ports) DeepCopyInto(out *Ports) {
-	{
-		in := &in
-		*out = make(Ports, len(*in))
-		copy(*out, *in)
+func (in *PortHTTPOptions) DeepCopyInto(out *PortHTTPOptions) {
+	*out = *in
+	if in.Rewrite != nil {
+		in, out := &in.Rewrite, &out.Rewrite
+		*out = new(PortHTTPRewrite)
+		**out = **in
+	}
+	if in.CORS != nil {
+		in, out := &in.CORS, &out.CORS
+		*out = new(PortHTTPCORS)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ports.
-func (in Ports) DeepCopy() Ports {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortHTTPOptions.
+func (in *PortHTTPOptions) DeepCopy() *PortHTTPOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(Ports)
+	out := new(PortHTTPOptions)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Probe) DeepCopyInto(out *Probe) {
+func (in *PortHTTPRewrite) DeepCopyInto(out *PortHTTPRewrite) {
 	*out = *in
-	if in.Exec != nil {
-		in, out := &in.Exec, &out.Exec
-		*out = new(ExecProbe)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HTTP != nil {
-		in, out := &in.HTTP, &out.HTTP
-		*out = new(HTTPProbe)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TCP != nil {
-		in, out := &in.TCP, &out.TCP
-		*out = new(TCPProbe)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortHTTPRewrite.
+func (in *PortHTTPRewrite) DeepCopy() *PortHTTPRewrite {
+	if in == nil {
+		return nil
+	}
+	out := new(PortHTTPRewrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortPublish) DeepCopyInto(out *PortPublish) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(PortAuth)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(PortHTTPOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortPublish.
+func (in *PortPublish) DeepCopy() *PortPublish {
+	if in == nil {
+		return nil
+	}
+	out := new(PortPublish)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Ports) DeepCopyInto(out *Ports) {
+	{
+		in := &in
+		*out = make(Ports, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ports.
+func (in Ports) DeepCopy() Ports {
+	if in == nil {
+		return nil
+	}
+	out := new(Ports)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Probe) DeepCopyInto(out *Probe) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPProbe)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
 func (in *Probe) DeepCopy() *Probe {
 	if in == nil {
 		return nil
@@ -2381,6 +2757,11 @@ func (in *ProjectInstanceSpec) DeepCopyInto(out *ProjectInstanceSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ImageRetention != nil {
+		in, out := &in.ImageRetention, &out.ImageRetention
+		*out = new(ImageRetention)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectInstanceSpec.
@@ -2413,6 +2794,26 @@ func (in *ProjectInstanceStatus) DeepCopy() *ProjectInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvenanceRules) DeepCopyInto(out *ProvenanceRules) {
+	*out = *in
+	if in.BuilderIDs != nil {
+		in, out := &in.BuilderIDs, &out.BuilderIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvenanceRules.
+func (in *ProvenanceRules) DeepCopy() *ProvenanceRules {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvenanceRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicasSummary) DeepCopyInto(out *ReplicasSummary) {
 	*out = *in
@@ -2441,6 +2842,21 @@ func (in *ReplicasSummary) DeepCopy() *ReplicasSummary {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]WeightedRouteTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(NameValues, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make(NameValues, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
@@ -2453,6 +2869,22 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteBinding) DeepCopyInto(out *RouteBinding) {
+	*out = *in
+	in.Route.DeepCopyInto(&out.Route)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteBinding.
+func (in *RouteBinding) DeepCopy() *RouteBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Router) DeepCopyInto(out *Router) {
 	*out = *in
@@ -2473,7 +2905,9 @@ func (in *Router) DeepCopyInto(out *Router) {
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
 		*out = make(Routes, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2508,7 +2942,9 @@ func (in Routes) DeepCopyInto(out *Routes) {
 	{
 		in := &in
 		*out = make(Routes, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2522,6 +2958,26 @@ func (in Routes) DeepCopy() Routes {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SBOMRules) DeepCopyInto(out *SBOMRules) {
+	*out = *in
+	if in.DenyPackages != nil {
+		in, out := &in.DenyPackages, &out.DenyPackages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOMRules.
+func (in *SBOMRules) DeepCopy() *SBOMRules {
+	if in == nil {
+		return nil
+	}
+	out := new(SBOMRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scheduling) DeepCopyInto(out *Scheduling) {
 	*out = *in
@@ -2655,6 +3111,79 @@ func (in SecretBindings) DeepCopy() SecretBindings {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretImportInstance) DeepCopyInto(out *SecretImportInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretImportInstance.
+func (in *SecretImportInstance) DeepCopy() *SecretImportInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretImportInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretImportInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretImportInstanceList) DeepCopyInto(out *SecretImportInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretImportInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretImportInstanceList.
+func (in *SecretImportInstanceList) DeepCopy() *SecretImportInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretImportInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretImportInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretImportInstanceSpec) DeepCopyInto(out *SecretImportInstanceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretImportInstanceSpec.
+func (in *SecretImportInstanceSpec) DeepCopy() *SecretImportInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretImportInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -2670,6 +3199,120 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretShareInstance) DeepCopyInto(out *SecretShareInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretShareInstance.
+func (in *SecretShareInstance) DeepCopy() *SecretShareInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretShareInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretShareInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretShareInstanceList) DeepCopyInto(out *SecretShareInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretShareInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretShareInstanceList.
+func (in *SecretShareInstanceList) DeepCopy() *SecretShareInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretShareInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretShareInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretShareInstanceSpec) DeepCopyInto(out *SecretShareInstanceSpec) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretShareInstanceSpec.
+func (in *SecretShareInstanceSpec) DeepCopy() *SecretShareInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretShareInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretShareInstanceStatus) DeepCopyInto(out *SecretShareInstanceStatus) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]SecretShareProjectStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretShareInstanceStatus.
+func (in *SecretShareInstanceStatus) DeepCopy() *SecretShareInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretShareInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretShareProjectStatus) DeepCopyInto(out *SecretShareProjectStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretShareProjectStatus.
+func (in *SecretShareProjectStatus) DeepCopy() *SecretShareProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretShareProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretStatus) DeepCopyInto(out *SecretStatus) {
 	*out = *in
@@ -2717,7 +3360,9 @@ func (in *Service) DeepCopyInto(out *Service) {
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make(Ports, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	out.Data = in.Data.DeepCopy()
 	if in.Generated != nil {
@@ -2824,6 +3469,84 @@ func (in ServiceBindings) DeepCopy() ServiceBindings {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceExportInstance) DeepCopyInto(out *ServiceExportInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceExportInstance.
+func (in *ServiceExportInstance) DeepCopy() *ServiceExportInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceExportInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceExportInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceExportInstanceList) DeepCopyInto(out *ServiceExportInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceExportInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceExportInstanceList.
+func (in *ServiceExportInstanceList) DeepCopy() *ServiceExportInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceExportInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceExportInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceExportInstanceSpec) DeepCopyInto(out *ServiceExportInstanceSpec) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceExportInstanceSpec.
+func (in *ServiceExportInstanceSpec) DeepCopy() *ServiceExportInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceExportInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceInstance) DeepCopyInto(out *ServiceInstance) {
 	*out = *in
@@ -2903,7 +3626,9 @@ func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make(Ports, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ContainerLabels != nil {
 		in, out := &in.ContainerLabels, &out.ContainerLabels
@@ -2921,12 +3646,16 @@ func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
 		*out = make([]Route, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Publish != nil {
 		in, out := &in.Publish, &out.Publish
 		*out = make([]PortPublish, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2953,7 +3682,9 @@ func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 	if in.Endpoints != nil {
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]Endpoint, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2974,7 +3705,9 @@ func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make(Ports, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	out.Data = in.Data.DeepCopy()
 	if in.Secrets != nil {
@@ -3248,3 +3981,18 @@ func (in *VolumeStatus) DeepCopy() *VolumeStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedRouteTarget) DeepCopyInto(out *WeightedRouteTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedRouteTarget.
+func (in *WeightedRouteTarget) DeepCopy() *WeightedRouteTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedRouteTarget)
+	in.DeepCopyInto(out)
+	return out
+}
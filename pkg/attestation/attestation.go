@@ -0,0 +1,187 @@
+package attestation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	// MediaType is the media type of in-toto statements. It is also the artifact type of the referrers that hold them.
+	MediaType = "application/vnd.in-toto+json"
+
+	StatementType            = "https://in-toto.io/Statement/v0.1"
+	PredicateTypeSPDX        = "https://spdx.dev/Document"
+	PredicateTypeProvenance  = "https://slsa.dev/provenance/v0.2"
+	PredicateTypeAnnotation  = "in-toto.io/predicate-type"
+	referenceTypeAnnotation  = "vnd.docker.reference.type"
+	attestationManifestValue = "attestation-manifest"
+)
+
+// Statement is an in-toto statement about the images in its subject
+type Statement struct {
+	Type          string          `json:"_type"`
+	PredicateType string          `json:"predicateType"`
+	Subject       []Subject       `json:"subject"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Write stores the statement as an OCI referrer of the subject in the repository
+func Write(repo name.Repository, subject ggcrv1.Descriptor, predicateType string, statement []byte, opts ...remote.Option) error {
+	img, err := mutate.AppendLayers(mutate.MediaType(empty.Image, types.OCIManifestSchema1), static.NewLayer(statement, MediaType))
+	if err != nil {
+		return err
+	}
+
+	artifact, ok := mutate.Subject(mutate.Annotations(mutate.ConfigMediaType(img, MediaType), map[string]string{
+		PredicateTypeAnnotation: predicateType,
+	}), ggcrv1.Descriptor{
+		MediaType: subject.MediaType,
+		Digest:    subject.Digest,
+		Size:      subject.Size,
+	}).(ggcrv1.Image)
+	if !ok {
+		return fmt.Errorf("failed to create attestation for %s", subject.Digest)
+	}
+
+	digest, err := artifact.Digest()
+	if err != nil {
+		return err
+	}
+
+	return remote.Write(repo.Digest(digest.String()), artifact, opts...)
+}
+
+// List returns the attestations of the image and, if it is an index, of the images in it
+func List(d name.Digest, opts ...remote.Option) (result []v1.ImageAttestation, _ error) {
+	err := walk(d, opts, func(subject name.Digest, referrer ggcrv1.Descriptor) error {
+		statement, err := read(subject.Context().Digest(referrer.Digest.String()), opts)
+		if err != nil {
+			return err
+		}
+
+		var predicate v1.GenericMap
+		if err := json.Unmarshal(statement.Predicate, &predicate); err != nil {
+			return fmt.Errorf("invalid attestation %s: %w", referrer.Digest, err)
+		}
+
+		result = append(result, v1.ImageAttestation{
+			Subject:       subject.DigestStr(),
+			PredicateType: statement.PredicateType,
+			Predicate:     predicate,
+		})
+		return nil
+	})
+	return result, err
+}
+
+// Copy copies the attestations of the image and the images in it to the repository the image was copied to
+func Copy(src name.Digest, dest name.Repository, srcOpts, destOpts []remote.Option) error {
+	return walk(src, srcOpts, func(subject name.Digest, referrer ggcrv1.Descriptor) error {
+		img, err := remote.Image(subject.Context().Digest(referrer.Digest.String()), srcOpts...)
+		if err != nil {
+			return err
+		}
+		return remote.Write(dest.Digest(referrer.Digest.String()), img, destOpts...)
+	})
+}
+
+// walk calls fn for each attestation referring to the image and the images in it
+func walk(d name.Digest, opts []remote.Option, fn func(subject name.Digest, referrer ggcrv1.Descriptor) error) error {
+	referrers, err := getReferrers(d, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, referrer := range referrers.Manifests {
+		if referrer.ArtifactType != MediaType {
+			continue
+		}
+		if err := fn(d, referrer); err != nil {
+			return err
+		}
+	}
+
+	descriptor, err := remote.Head(d, opts...)
+	if err != nil {
+		return err
+	}
+	if !descriptor.MediaType.IsIndex() {
+		return nil
+	}
+
+	index, err := remote.Index(d, opts...)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return err
+	}
+
+	for _, child := range manifest.Manifests {
+		if err := walk(d.Context().Digest(child.Digest.String()), opts, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getReferrers returns the referrers of the image. Registries without the referrers API return not found for images
+// without referrers.
+func getReferrers(d name.Digest, opts []remote.Option) (*ggcrv1.IndexManifest, error) {
+	referrers, err := remote.Referrers(d, opts...)
+	var terr *transport.Error
+	if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+		return &ggcrv1.IndexManifest{}, nil
+	}
+	return referrers, err
+}
+
+func read(d name.Digest, opts []remote.Option) (*Statement, error) {
+	img, err := remote.Image(d, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, err
+	}
+	if len(layers) == 0 {
+		return nil, fmt.Errorf("invalid attestation %s, no layers", d)
+	}
+
+	return readStatement(layers[0])
+}
+
+func readStatement(layer ggcrv1.Layer) (*Statement, error) {
+	data, err := readAll(layer)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &Statement{}
+	if err := json.Unmarshal(data, statement); err != nil {
+		return nil, fmt.Errorf("invalid in-toto statement: %w", err)
+	}
+	return statement, nil
+}
@@ -0,0 +1,192 @@
+package attestation
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegistry(t *testing.T, referrers bool) string {
+	t.Helper()
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0)), registry.WithReferrersSupport(referrers)))
+	t.Cleanup(s.Close)
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	return u.Host
+}
+
+func statement(t *testing.T, predicateType string, predicate map[string]any) []byte {
+	t.Helper()
+	data, err := json.Marshal(predicate)
+	require.NoError(t, err)
+	result, err := json.Marshal(Statement{
+		Type:          StatementType,
+		PredicateType: predicateType,
+		Predicate:     data,
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func TestWriteListCopy(t *testing.T) {
+	for _, referrers := range []bool{true, false} {
+		host := newRegistry(t, referrers)
+
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		index := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{Add: img})
+
+		repo, err := name.NewRepository(host + "/acorn/app")
+		require.NoError(t, err)
+		indexDigest, err := index.Digest()
+		require.NoError(t, err)
+		d := repo.Digest(indexDigest.String())
+		require.NoError(t, remote.WriteIndex(d, index))
+
+		indexDesc, err := partial.Descriptor(index)
+		require.NoError(t, err)
+		imgDesc, err := partial.Descriptor(img)
+		require.NoError(t, err)
+
+		require.NoError(t, Write(repo, *indexDesc, PredicateTypeProvenance, statement(t, PredicateTypeProvenance, map[string]any{
+			"buildType": BuildType,
+		})))
+		require.NoError(t, Write(repo, *imgDesc, PredicateTypeSPDX, statement(t, PredicateTypeSPDX, map[string]any{
+			"spdxVersion": "SPDX-2.3",
+		})))
+
+		expected := []v1.ImageAttestation{
+			{
+				Subject:       indexDigest.String(),
+				PredicateType: PredicateTypeProvenance,
+				Predicate:     v1.GenericMap{"buildType": BuildType},
+			},
+			{
+				Subject:       imgDesc.Digest.String(),
+				PredicateType: PredicateTypeSPDX,
+				Predicate:     v1.GenericMap{"spdxVersion": "SPDX-2.3"},
+			},
+		}
+
+		attestations, err := List(d)
+		require.NoError(t, err)
+		assert.Equal(t, expected, attestations, "referrers api: %v", referrers)
+
+		dest, err := name.NewRepository(host + "/other/app")
+		require.NoError(t, err)
+		require.NoError(t, remote.WriteIndex(dest.Digest(indexDigest.String()), index))
+		require.NoError(t, Copy(d, dest, nil, nil))
+
+		attestations, err = List(dest.Digest(indexDigest.String()))
+		require.NoError(t, err)
+		assert.Equal(t, expected, attestations, "referrers api: %v", referrers)
+	}
+}
+
+func TestFromBuildkitIndex(t *testing.T) {
+	host := newRegistry(t, false)
+	repo, err := name.NewRepository(host + "/acorn/app")
+	require.NoError(t, err)
+
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	imgDigest, err := img.Digest()
+	require.NoError(t, err)
+
+	attestationManifest, err := mutate.Append(mutate.MediaType(empty.Image, types.OCIManifestSchema1), mutate.Addendum{
+		Layer: static.NewLayer(statement(t, PredicateTypeSPDX, map[string]any{"spdxVersion": "SPDX-2.3"}), MediaType),
+		Annotations: map[string]string{
+			PredicateTypeAnnotation: PredicateTypeSPDX,
+		},
+	})
+	require.NoError(t, err)
+
+	index := mutate.AppendManifests(mutate.IndexMediaType(empty.Index, types.OCIImageIndex), mutate.IndexAddendum{
+		Add: img,
+	}, mutate.IndexAddendum{
+		Add: attestationManifest,
+		Descriptor: ggcrv1.Descriptor{
+			Annotations: map[string]string{
+				referenceTypeAnnotation:       attestationManifestValue,
+				"vnd.docker.reference.digest": imgDigest.String(),
+			},
+		},
+	})
+	indexDigest, err := index.Digest()
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(repo.Digest(indexDigest.String()), index))
+
+	ref, err := FromBuildkitIndex(repo.Digest(indexDigest.String()).String())
+	require.NoError(t, err)
+	assert.Equal(t, repo.Digest(imgDigest.String()).String(), ref)
+
+	d, err := name.NewDigest(ref)
+	require.NoError(t, err)
+	attestations, err := List(d)
+	require.NoError(t, err)
+	assert.Equal(t, []v1.ImageAttestation{
+		{
+			Subject:       imgDigest.String(),
+			PredicateType: PredicateTypeSPDX,
+			Predicate:     v1.GenericMap{"spdxVersion": "SPDX-2.3"},
+		},
+	}, attestations)
+
+	// An image without attestations is returned unchanged
+	ref, err = FromBuildkitIndex(ref)
+	require.NoError(t, err)
+	assert.Equal(t, repo.Digest(imgDigest.String()).String(), ref)
+}
+
+func TestNewProvenance(t *testing.T) {
+	d, err := name.NewDigest("ghcr.io/acorn-io/app@sha256:ed5c185df419ed5c185df419ed5c185df419ed5c185df419ed5c185df419ed5c")
+	require.NoError(t, err)
+
+	started := time.Date(2023, 4, 9, 4, 59, 3, 0, time.UTC)
+	data, err := NewProvenance(d, v1.AcornImageBuildInstanceSpec{
+		Profiles: []string{"prod"},
+		VCS: v1.VCS{
+			Remotes:  []string{"https://github.com/acorn-io/app.git"},
+			Revision: "8ea3cc3f2b1e0c9d",
+			Clean:    true,
+		},
+	}, started, started.Add(time.Minute))
+	require.NoError(t, err)
+
+	statement := &Statement{}
+	require.NoError(t, json.Unmarshal(data, statement))
+	assert.Equal(t, PredicateTypeProvenance, statement.PredicateType)
+	assert.Equal(t, []Subject{{
+		Name:   "ghcr.io/acorn-io/app",
+		Digest: map[string]string{"sha256": "ed5c185df419ed5c185df419ed5c185df419ed5c185df419ed5c185df419ed5c"},
+	}}, statement.Subject)
+
+	provenance := &Provenance{}
+	require.NoError(t, json.Unmarshal(statement.Predicate, provenance))
+	assert.Equal(t, ProvenanceConfigSource{
+		URI:        "https://github.com/acorn-io/app.git",
+		Digest:     map[string]string{"sha1": "8ea3cc3f2b1e0c9d"},
+		EntryPoint: "Acornfile",
+	}, provenance.Invocation.ConfigSource)
+	assert.Equal(t, []string{"prod"}, provenance.Invocation.Parameters.Profiles)
+	assert.True(t, provenance.Invocation.Environment.VCS.Clean)
+	assert.Equal(t, started.Add(time.Minute), provenance.Metadata.BuildFinishedOn)
+}
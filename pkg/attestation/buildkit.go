@@ -0,0 +1,95 @@
+package attestation
+
+import (
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// FromBuildkitIndex moves the attestations buildkit adds to the index of an image to OCI referrers of the image. It
+// returns the reference of the image without the index, or ref unchanged if it has no attestations.
+func FromBuildkitIndex(ref string, opts ...remote.Option) (string, error) {
+	d, err := name.NewDigest(ref)
+	if err != nil {
+		return "", err
+	}
+
+	descriptor, err := remote.Head(d, opts...)
+	if err != nil {
+		return "", err
+	}
+	if !descriptor.MediaType.IsIndex() {
+		return ref, nil
+	}
+
+	index, err := remote.Index(d, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		images       []ggcrv1.Descriptor
+		attestations []ggcrv1.Descriptor
+	)
+	for _, desc := range manifest.Manifests {
+		if desc.Annotations[referenceTypeAnnotation] == attestationManifestValue {
+			attestations = append(attestations, desc)
+		} else {
+			images = append(images, desc)
+		}
+	}
+
+	if len(images) != 1 || len(attestations) == 0 {
+		return ref, nil
+	}
+
+	for _, desc := range attestations {
+		img, err := index.Image(desc.Digest)
+		if err != nil {
+			return "", err
+		}
+
+		imgManifest, err := img.Manifest()
+		if err != nil {
+			return "", err
+		}
+
+		for _, layerDesc := range imgManifest.Layers {
+			if layerDesc.MediaType != MediaType {
+				continue
+			}
+
+			layer, err := img.LayerByDigest(layerDesc.Digest)
+			if err != nil {
+				return "", err
+			}
+
+			data, err := readAll(layer)
+			if err != nil {
+				return "", err
+			}
+
+			if err := Write(d.Context(), images[0], layerDesc.Annotations[PredicateTypeAnnotation], data, opts...); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return d.Context().Digest(images[0].Digest.String()).String(), nil
+}
+
+func readAll(layer ggcrv1.Layer) ([]byte, error) {
+	reader, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
@@ -0,0 +1,125 @@
+package attestation
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/version"
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+const (
+	BuilderID = "https://github.com/acorn-io/runtime"
+	BuildType = "https://github.com/acorn-io/runtime/acornfile@v1"
+)
+
+// Provenance is the SLSA v0.2 provenance predicate of an Acorn image
+type Provenance struct {
+	Builder    ProvenanceBuilder    `json:"builder"`
+	BuildType  string               `json:"buildType"`
+	Invocation ProvenanceInvocation `json:"invocation"`
+	Metadata   ProvenanceMetadata   `json:"metadata"`
+	Materials  []ProvenanceMaterial `json:"materials,omitempty"`
+}
+
+type ProvenanceBuilder struct {
+	ID      string `json:"id"`
+	Version string `json:"version,omitempty"`
+}
+
+type ProvenanceInvocation struct {
+	ConfigSource ProvenanceConfigSource `json:"configSource,omitempty"`
+	Parameters   ProvenanceParameters   `json:"parameters,omitempty"`
+	Environment  ProvenanceEnvironment  `json:"environment,omitempty"`
+}
+
+type ProvenanceConfigSource struct {
+	URI        string            `json:"uri,omitempty"`
+	Digest     map[string]string `json:"digest,omitempty"`
+	EntryPoint string            `json:"entryPoint,omitempty"`
+}
+
+type ProvenanceParameters struct {
+	Args      v1.GenericMap `json:"args,omitempty"`
+	Profiles  []string      `json:"profiles,omitempty"`
+	Platforms []v1.Platform `json:"platforms,omitempty"`
+}
+
+type ProvenanceEnvironment struct {
+	// VCS is the state of the working tree the image was built from
+	VCS v1.VCS `json:"vcs,omitempty"`
+}
+
+type ProvenanceMetadata struct {
+	BuildStartedOn  time.Time `json:"buildStartedOn"`
+	BuildFinishedOn time.Time `json:"buildFinishedOn"`
+	Reproducible    bool      `json:"reproducible"`
+}
+
+type ProvenanceMaterial struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// NewProvenance returns the in-toto provenance statement of the Acorn image built with the options
+func NewProvenance(image name.Digest, opts v1.AcornImageBuildInstanceSpec, started, finished time.Time) ([]byte, error) {
+	provenance := Provenance{
+		Builder: ProvenanceBuilder{
+			ID:      BuilderID,
+			Version: version.Get().String(),
+		},
+		BuildType: BuildType,
+		Invocation: ProvenanceInvocation{
+			Parameters: ProvenanceParameters{
+				Args:      opts.Args,
+				Profiles:  opts.Profiles,
+				Platforms: opts.Platforms,
+			},
+			Environment: ProvenanceEnvironment{
+				VCS: opts.VCS,
+			},
+		},
+		Metadata: ProvenanceMetadata{
+			BuildStartedOn:  started.UTC(),
+			BuildFinishedOn: finished.UTC(),
+		},
+	}
+
+	if opts.VCS.Revision != "" {
+		revision := map[string]string{
+			"sha1": opts.VCS.Revision,
+		}
+		for _, remote := range opts.VCS.Remotes {
+			provenance.Materials = append(provenance.Materials, ProvenanceMaterial{
+				URI:    remote,
+				Digest: revision,
+			})
+		}
+		if len(opts.VCS.Remotes) > 0 {
+			provenance.Invocation.ConfigSource = ProvenanceConfigSource{
+				URI:        opts.VCS.Remotes[0],
+				Digest:     revision,
+				EntryPoint: "Acornfile",
+			}
+		}
+	}
+
+	predicate, err := json.Marshal(provenance)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(Statement{
+		Type:          StatementType,
+		PredicateType: PredicateTypeProvenance,
+		Subject: []Subject{
+			{
+				Name:   image.Context().String(),
+				Digest: map[string]string{"sha256": strings.TrimPrefix(image.DigestStr(), "sha256:")},
+			},
+		},
+		Predicate: predicate,
+	})
+}
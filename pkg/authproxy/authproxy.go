@@ -0,0 +1,160 @@
+package authproxy
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// SecretKeyHTPasswd is the key of the htpasswd file in a basic auth secret. If it's not set, the username and
+	// password keys of the secret are used.
+	SecretKeyHTPasswd = "htpasswd"
+	SecretKeyUsername = "username"
+	SecretKeyPassword = "password"
+
+	SecretKeyIssuer       = "issuer"
+	SecretKeyClientID     = "clientId"
+	SecretKeyClientSecret = "clientSecret"
+	// SecretKeyAllowedEmails is an optional comma separated list of emails, or domains in the form of @example.com,
+	// that are allowed to log in with OIDC. All authenticated users are allowed if it's not set.
+	SecretKeyAllowedEmails = "allowedEmails"
+)
+
+type Options struct {
+	// Type is basic or oidc
+	Type string
+	// Upstream is the URL requests are proxied to once authenticated
+	Upstream string
+	// SecretDir is the directory the keys of the auth secret are mounted in
+	SecretDir string
+	// Name identifies the endpoint, sessions of the endpoint are not accepted by proxies with another name
+	Name string
+}
+
+// New returns a handler that authenticates requests with the credentials in the secret dir before passing them
+// to the upstream.
+func New(opts Options) (http.Handler, error) {
+	upstream, err := url.Parse(opts.Upstream)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream %q: %w", opts.Upstream, err)
+	}
+	proxy := httputil.NewSingleHostReverseProxy(upstream)
+
+	switch opts.Type {
+	case v1.PortAuthTypeBasic:
+		users, err := readBasicUsers(opts.SecretDir)
+		if err != nil {
+			return nil, err
+		}
+		return &basicAuth{
+			users: users,
+			next:  proxy,
+		}, nil
+	case v1.PortAuthTypeOIDC:
+		config, err := readOIDCConfig(opts.SecretDir)
+		if err != nil {
+			return nil, err
+		}
+		return newOIDCAuth(opts.Name, config, http.DefaultClient, proxy), nil
+	}
+
+	return nil, fmt.Errorf("invalid auth type %q, must be %s or %s", opts.Type, v1.PortAuthTypeBasic, v1.PortAuthTypeOIDC)
+}
+
+type basicAuth struct {
+	// users maps the usernames to bcrypt hashes of their passwords
+	users map[string][]byte
+	next  http.Handler
+}
+
+func (b *basicAuth) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if user, password, ok := req.BasicAuth(); ok {
+		if hash, found := b.users[user]; found && bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil {
+			// The credentials are for the proxy, don't leak them to the upstream
+			req.Header.Del("Authorization")
+			req.Header.Set("X-Forwarded-User", user)
+			b.next.ServeHTTP(rw, req)
+			return
+		}
+	}
+
+	rw.Header().Set("WWW-Authenticate", `Basic realm="acorn", charset="UTF-8"`)
+	http.Error(rw, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
+
+func readSecretKey(dir, key string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, key))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	return strings.TrimSpace(string(data)), err
+}
+
+func readBasicUsers(dir string) (map[string][]byte, error) {
+	users := map[string][]byte{}
+
+	htpasswd, err := readSecretKey(dir, SecretKeyHTPasswd)
+	if err != nil {
+		return nil, err
+	}
+	if htpasswd != "" {
+		for _, line := range strings.Split(htpasswd, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			user, hash, ok := strings.Cut(line, ":")
+			if !ok || !strings.HasPrefix(hash, "$2") {
+				return nil, fmt.Errorf("invalid htpasswd entry for user %q, only bcrypt entries (htpasswd -B) are supported", user)
+			}
+			users[user] = []byte(hash)
+		}
+		return users, nil
+	}
+
+	username, err := readSecretKey(dir, SecretKeyUsername)
+	if err != nil {
+		return nil, err
+	}
+	password, err := readSecretKey(dir, SecretKeyPassword)
+	if err != nil {
+		return nil, err
+	}
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("basic auth secret must have a %s key or %s and %s keys", SecretKeyHTPasswd, SecretKeyUsername, SecretKeyPassword)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	users[username] = hash
+	return users, nil
+}
+
+// ValidateSecret checks that the data of an auth secret has the keys needed for the auth type.
+func ValidateSecret(authType string, data map[string][]byte) error {
+	switch authType {
+	case v1.PortAuthTypeBasic:
+		if len(data[SecretKeyHTPasswd]) == 0 && (len(data[SecretKeyUsername]) == 0 || len(data[SecretKeyPassword]) == 0) {
+			return fmt.Errorf("basic auth secret must have a %s key or %s and %s keys", SecretKeyHTPasswd, SecretKeyUsername, SecretKeyPassword)
+		}
+	case v1.PortAuthTypeOIDC:
+		for _, key := range []string{SecretKeyIssuer, SecretKeyClientID, SecretKeyClientSecret} {
+			if len(data[key]) == 0 {
+				return fmt.Errorf("oidc auth secret must have a %s key", key)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid auth type %q, must be %s or %s", authType, v1.PortAuthTypeBasic, v1.PortAuthTypeOIDC)
+	}
+	return nil
+}
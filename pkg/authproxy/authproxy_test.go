@@ -0,0 +1,277 @@
+package authproxy
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/square/go-jose.v2"
+)
+
+func newUpstream(t *testing.T) string {
+	upstream := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte("hello " + req.Header.Get("X-Forwarded-User")))
+	}))
+	t.Cleanup(upstream.Close)
+	return upstream.URL
+}
+
+func writeSecret(t *testing.T, data map[string]string) string {
+	dir := t.TempDir()
+	for key, value := range data {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, key), []byte(value), 0600))
+	}
+	return dir
+}
+
+func TestBasicAuth(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	handler, err := New(Options{
+		Type:      v1.PortAuthTypeBasic,
+		Upstream:  newUpstream(t),
+		SecretDir: writeSecret(t, map[string]string{SecretKeyHTPasswd: "admin:" + string(hash) + "\n"}),
+	})
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		user, password string
+		status         int
+	}{
+		{status: http.StatusUnauthorized},
+		{user: "admin", password: "wrong", status: http.StatusUnauthorized},
+		{user: "other", password: "secret", status: http.StatusUnauthorized},
+		{user: "admin", password: "secret", status: http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if test.user != "" {
+			req.SetBasicAuth(test.user, test.password)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, test.status, rec.Code, test.user+":"+test.password)
+		if test.status == http.StatusOK {
+			assert.Equal(t, "hello admin", rec.Body.String())
+		}
+	}
+}
+
+func TestBasicAuthInvalidSecret(t *testing.T) {
+	_, err := New(Options{
+		Type:      v1.PortAuthTypeBasic,
+		Upstream:  "http://localhost",
+		SecretDir: writeSecret(t, map[string]string{SecretKeyHTPasswd: "admin:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g="}),
+	})
+	assert.EqualError(t, err, `invalid htpasswd entry for user "admin", only bcrypt entries (htpasswd -B) are supported`)
+
+	_, err = New(Options{
+		Type:      v1.PortAuthTypeBasic,
+		Upstream:  "http://localhost",
+		SecretDir: writeSecret(t, map[string]string{SecretKeyUsername: "admin"}),
+	})
+	assert.EqualError(t, err, "basic auth secret must have a htpasswd key or username and password keys")
+}
+
+// newIssuer starts a minimal OIDC provider that issues ID tokens for the email passed as code. Emails prefixed with
+// unverified: are issued as not verified.
+func newIssuer(t *testing.T, clientID string) *httptest.Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       jose.JSONWebKey{Key: key, KeyID: "test"},
+	}, nil)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	issuer := httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+
+	writeJSON := func(rw http.ResponseWriter, obj any) {
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode(obj)
+	}
+	mux.HandleFunc("/.well-known/openid-configuration", func(rw http.ResponseWriter, req *http.Request) {
+		writeJSON(rw, map[string]string{
+			"issuer":                 issuer.URL,
+			"authorization_endpoint": issuer.URL + "/authorize",
+			"token_endpoint":         issuer.URL + "/token",
+			"jwks_uri":               issuer.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(rw http.ResponseWriter, req *http.Request) {
+		writeJSON(rw, jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"}},
+		})
+	})
+	mux.HandleFunc("/token", func(rw http.ResponseWriter, req *http.Request) {
+		if _, secret, _ := req.BasicAuth(); secret != "client-secret" {
+			http.Error(rw, "invalid client", http.StatusUnauthorized)
+			return
+		}
+		claims := map[string]any{
+			"iss":   issuer.URL,
+			"aud":   clientID,
+			"exp":   time.Now().Add(time.Minute).Unix(),
+			"email": req.FormValue("code"),
+		}
+		if email, ok := strings.CutPrefix(req.FormValue("code"), "unverified:"); ok {
+			claims["email"] = email
+			claims["email_verified"] = false
+		}
+		claimsJSON, err := json.Marshal(claims)
+		require.NoError(t, err)
+		token, err := signer.Sign(claimsJSON)
+		require.NoError(t, err)
+		idToken, err := token.CompactSerialize()
+		require.NoError(t, err)
+		writeJSON(rw, map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+
+	return issuer
+}
+
+// oidcLogin logs in to the proxy with the stand-in provider of newIssuer
+func oidcLogin(t *testing.T, issuer, proxy *httptest.Server, email string) (*http.Client, *http.Response) {
+	t.Helper()
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(proxy.URL + "/some/path?x=1")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	authorize, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, issuer.URL+"/authorize", authorize.Scheme+"://"+authorize.Host+authorize.Path)
+	assert.Equal(t, proxy.URL+CallbackPath, authorize.Query().Get("redirect_uri"))
+
+	// The stand-in provider skips the login page and uses the email as the authorization code
+	resp, err = client.Get(proxy.URL + CallbackPath + "?" + url.Values{
+		"code":  []string{email},
+		"state": []string{authorize.Query().Get("state")},
+	}.Encode())
+	require.NoError(t, err)
+	resp.Body.Close()
+	return client, resp
+}
+
+func newOIDCProxy(t *testing.T, issuer *httptest.Server, name string) *httptest.Server {
+	t.Helper()
+	handler, err := New(Options{
+		Type:     v1.PortAuthTypeOIDC,
+		Upstream: newUpstream(t),
+		SecretDir: writeSecret(t, map[string]string{
+			SecretKeyIssuer:        issuer.URL,
+			SecretKeyClientID:      "client",
+			SecretKeyClientSecret:  "client-secret",
+			SecretKeyAllowedEmails: "admin@example.com, @acorn.io",
+		}),
+		Name: name,
+	})
+	require.NoError(t, err)
+
+	proxy := httptest.NewServer(handler)
+	t.Cleanup(proxy.Close)
+	return proxy
+}
+
+func TestOIDCAuth(t *testing.T) {
+	issuer := newIssuer(t, "client")
+	proxy := newOIDCProxy(t, issuer, "app-namespace/app-auth")
+	login := func(email string) (*http.Client, *http.Response) {
+		return oidcLogin(t, issuer, proxy, email)
+	}
+
+	client, resp := login("admin@example.com")
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "/some/path?x=1", resp.Header.Get("Location"))
+
+	resp, err := client.Get(proxy.URL + "/some/path?x=1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, resp = login("someone@acorn.io")
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+	_, resp = login("intruder@example.com")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// Users have to be allowed and have a verified email
+	_, resp = login("unverified:admin@example.com")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+// TestOIDCAuthSessionBinding tests that a session is only accepted by the endpoint and on the host it was issued for,
+// even if other endpoints use the same OIDC client
+func TestOIDCAuthSessionBinding(t *testing.T) {
+	issuer := newIssuer(t, "client")
+	proxy := newOIDCProxy(t, issuer, "app-namespace/app-auth")
+	other := newOIDCProxy(t, issuer, "other-namespace/other-auth")
+
+	client, resp := oidcLogin(t, issuer, proxy, "admin@example.com")
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	var sessionValue string
+	for _, cookie := range client.Jar.Cookies(proxyURL) {
+		if cookie.Name == sessionCookie {
+			sessionValue = cookie.Value
+		}
+	}
+	require.NotEmpty(t, sessionValue)
+
+	get := func(server *httptest.Server, host string) int {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/", nil)
+		require.NoError(t, err)
+		if host != "" {
+			req.Host = host
+		}
+		req.AddCookie(&http.Cookie{Name: sessionCookie, Value: sessionValue})
+		resp, err := http.DefaultTransport.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get(proxy, ""))
+	// Another endpoint with the same client doesn't accept the session
+	assert.Equal(t, http.StatusFound, get(other, proxyURL.Host))
+	// Nor does the endpoint on another hostname
+	assert.Equal(t, http.StatusFound, get(proxy, "other.example.com"))
+}
+
+func TestOIDCAuthInvalidState(t *testing.T) {
+	handler := newOIDCAuth("app-namespace/app-auth", oidcConfig{Issuer: "http://localhost", ClientID: "client", ClientSecret: "secret"}, http.DefaultClient, nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, CallbackPath+"?code=a&state=b", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
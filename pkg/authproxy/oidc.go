@@ -0,0 +1,416 @@
+package authproxy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2"
+)
+
+const (
+	// CallbackPath is the path the OIDC provider redirects to after login. The URL with this path has to be allowed
+	// as redirect URL of the client for each hostname of the endpoint.
+	CallbackPath = "/.acorn/oauth2/callback"
+
+	sessionCookie = "acorn_auth_session"
+	stateCookie   = "acorn_auth_state"
+	sessionTTL    = 12 * time.Hour
+	stateTTL      = 10 * time.Minute
+)
+
+type oidcConfig struct {
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	AllowedEmails []string
+}
+
+func readOIDCConfig(dir string) (config oidcConfig, err error) {
+	for key, value := range map[string]*string{
+		SecretKeyIssuer:       &config.Issuer,
+		SecretKeyClientID:     &config.ClientID,
+		SecretKeyClientSecret: &config.ClientSecret,
+	} {
+		if *value, err = readSecretKey(dir, key); err != nil {
+			return config, err
+		} else if *value == "" {
+			return config, fmt.Errorf("oidc auth secret must have a %s key", key)
+		}
+	}
+
+	allowedEmails, err := readSecretKey(dir, SecretKeyAllowedEmails)
+	if err != nil {
+		return config, err
+	}
+	for _, email := range strings.Split(allowedEmails, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			config.AllowedEmails = append(config.AllowedEmails, strings.ToLower(email))
+		}
+	}
+
+	return config, nil
+}
+
+// provider is the discovered configuration of the OIDC issuer
+type provider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+
+	keys jose.JSONWebKeySet
+}
+
+type idTokenClaims struct {
+	Issuer   string          `json:"iss"`
+	Audience json.RawMessage `json:"aud"`
+	Expiry   int64           `json:"exp"`
+	Email    string          `json:"email"`
+	// EmailVerified is a boolean, or a string with some providers
+	EmailVerified json.RawMessage `json:"email_verified"`
+}
+
+// session is the signed value of the session cookie. The audience and host bind it to the client and the hostname
+// it was issued for, so it can't be used with another endpoint that shares the OIDC client.
+type session struct {
+	Email    string `json:"email"`
+	Expiry   int64  `json:"exp"`
+	Audience string `json:"aud"`
+	Host     string `json:"host"`
+}
+
+type oidcAuth struct {
+	config oidcConfig
+	client *http.Client
+	next   http.Handler
+	// key signs the cookies. It is derived from the client secret and the name of the endpoint, so all replicas of
+	// the proxy of an endpoint share it, but the proxies of other endpoints don't.
+	key []byte
+
+	lock     sync.Mutex
+	provider *provider
+}
+
+func newOIDCAuth(name string, config oidcConfig, client *http.Client, next http.Handler) *oidcAuth {
+	mac := hmac.New(sha256.New, []byte(config.ClientSecret))
+	mac.Write([]byte("acorn-auth-proxy:" + name))
+	return &oidcAuth{
+		config: config,
+		client: client,
+		next:   next,
+		key:    mac.Sum(nil),
+	}
+}
+
+func (o *oidcAuth) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == CallbackPath {
+		o.callback(rw, req)
+		return
+	}
+
+	var s session
+	if cookie, err := req.Cookie(sessionCookie); err == nil && o.verifyValue(cookie.Value, &s) && s.Expiry > time.Now().Unix() &&
+		s.Audience == o.config.ClientID && s.Host == req.Host {
+		req.Header.Set("X-Forwarded-User", s.Email)
+		req.Header.Set("X-Forwarded-Email", s.Email)
+		o.next.ServeHTTP(rw, req)
+		return
+	}
+
+	o.login(rw, req)
+}
+
+func (o *oidcAuth) login(rw http.ResponseWriter, req *http.Request) {
+	p, err := o.getProvider(req.Context())
+	if err != nil {
+		logrus.Errorf("failed to discover OIDC issuer %s: %v", o.config.Issuer, err)
+		http.Error(rw, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	state := make([]byte, 16)
+	if _, err := rand.Read(state); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	value, err := o.signValue([]string{hex.EncodeToString(state), req.URL.RequestURI(), req.Host})
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	o.setCookie(rw, req, stateCookie, value, stateTTL)
+
+	http.Redirect(rw, req, o.oauth2Config(req, p).AuthCodeURL(hex.EncodeToString(state)), http.StatusFound)
+}
+
+func (o *oidcAuth) callback(rw http.ResponseWriter, req *http.Request) {
+	var state []string
+	cookie, err := req.Cookie(stateCookie)
+	if err != nil || !o.verifyValue(cookie.Value, &state) || len(state) != 3 || state[0] != req.URL.Query().Get("state") || state[2] != req.Host {
+		http.Error(rw, "invalid login state, please retry", http.StatusBadRequest)
+		return
+	}
+
+	p, err := o.getProvider(req.Context())
+	if err != nil {
+		logrus.Errorf("failed to discover OIDC issuer %s: %v", o.config.Issuer, err)
+		http.Error(rw, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	ctx := context.WithValue(req.Context(), oauth2.HTTPClient, o.client)
+	token, err := o.oauth2Config(req, p).Exchange(ctx, req.URL.Query().Get("code"))
+	if err != nil {
+		http.Error(rw, "failed to exchange authorization code: "+err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	rawIDToken, _ := token.Extra("id_token").(string)
+	claims, err := o.verifyIDToken(req.Context(), p, rawIDToken)
+	if err != nil {
+		http.Error(rw, "invalid id token: "+err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if !emailVerified(claims.EmailVerified) {
+		http.Error(rw, "email is not verified", http.StatusForbidden)
+		return
+	}
+
+	if !o.emailAllowed(claims.Email) {
+		http.Error(rw, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	value, err := o.signValue(session{
+		Email:    claims.Email,
+		Expiry:   time.Now().Add(sessionTTL).Unix(),
+		Audience: o.config.ClientID,
+		Host:     req.Host,
+	})
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	o.setCookie(rw, req, sessionCookie, value, sessionTTL)
+	o.setCookie(rw, req, stateCookie, "", -1)
+
+	// Only redirect to paths on this host
+	redirect := state[1]
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") {
+		redirect = "/"
+	}
+	http.Redirect(rw, req, redirect, http.StatusFound)
+}
+
+// emailVerified returns false if the provider says the email of the user is not verified. Providers that don't
+// verify emails don't set the claim.
+func emailVerified(claim json.RawMessage) bool {
+	if len(claim) == 0 {
+		return true
+	}
+	var verified bool
+	if err := json.Unmarshal(claim, &verified); err == nil {
+		return verified
+	}
+	var verifiedStr string
+	if err := json.Unmarshal(claim, &verifiedStr); err == nil {
+		return !strings.EqualFold(verifiedStr, "false")
+	}
+	return false
+}
+
+func (o *oidcAuth) emailAllowed(email string) bool {
+	if email == "" {
+		return false
+	}
+	if len(o.config.AllowedEmails) == 0 {
+		return true
+	}
+	email = strings.ToLower(email)
+	for _, allowed := range o.config.AllowedEmails {
+		if allowed == email || (strings.HasPrefix(allowed, "@") && strings.HasSuffix(email, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *oidcAuth) oauth2Config(req *http.Request, p *provider) *oauth2.Config {
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	if proto := req.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+
+	return &oauth2.Config{
+		ClientID:     o.config.ClientID,
+		ClientSecret: o.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  p.AuthorizationEndpoint,
+			TokenURL: p.TokenEndpoint,
+		},
+		RedirectURL: scheme + "://" + req.Host + CallbackPath,
+		Scopes:      []string{"openid", "email"},
+	}
+}
+
+func (o *oidcAuth) getProvider(ctx context.Context) (*provider, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if o.provider != nil {
+		return o.provider, nil
+	}
+
+	p := &provider{}
+	if err := o.getJSON(ctx, strings.TrimSuffix(o.config.Issuer, "/")+"/.well-known/openid-configuration", p); err != nil {
+		return nil, err
+	}
+	if p.Issuer != o.config.Issuer {
+		return nil, fmt.Errorf("issuer %q of the discovery document does not match %q", p.Issuer, o.config.Issuer)
+	}
+	if err := o.getJSON(ctx, p.JWKSURI, &p.keys); err != nil {
+		return nil, err
+	}
+
+	o.provider = p
+	return p, nil
+}
+
+func (o *oidcAuth) getJSON(ctx context.Context, url string, obj any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(obj)
+}
+
+func (o *oidcAuth) verifyIDToken(ctx context.Context, p *provider, rawIDToken string) (*idTokenClaims, error) {
+	jws, err := jose.ParseSigned(rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, fmt.Errorf("expected exactly one signature")
+	}
+
+	keyID := jws.Signatures[0].Header.KeyID
+	keys := p.keys.Key(keyID)
+	if len(keys) == 0 {
+		// The issuer may have rotated its keys since they were fetched
+		o.lock.Lock()
+		err = o.getJSON(ctx, p.JWKSURI, &p.keys)
+		keys = p.keys.Key(keyID)
+		o.lock.Unlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var payload []byte
+	for _, key := range keys {
+		// Only accept signatures of asymmetric keys, a symmetric key would be known to the client as well
+		if !key.IsPublic() {
+			continue
+		}
+		if payload, err = jws.Verify(key); err == nil {
+			break
+		}
+	}
+	if payload == nil {
+		return nil, fmt.Errorf("no key of the issuer verifies the signature")
+	}
+
+	claims := &idTokenClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, err
+	}
+	if claims.Issuer != p.Issuer {
+		return nil, fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	if claims.Expiry < time.Now().Unix() {
+		return nil, fmt.Errorf("token is expired")
+	}
+
+	var audience []string
+	if err := json.Unmarshal(claims.Audience, &audience); err != nil {
+		audience = []string{""}
+		if err := json.Unmarshal(claims.Audience, &audience[0]); err != nil {
+			return nil, fmt.Errorf("invalid audience: %w", err)
+		}
+	}
+	for _, aud := range audience {
+		if aud == o.config.ClientID {
+			return claims, nil
+		}
+	}
+	return nil, fmt.Errorf("token is not issued for client %q", o.config.ClientID)
+}
+
+func (o *oidcAuth) setCookie(rw http.ResponseWriter, req *http.Request, name, value string, ttl time.Duration) {
+	http.SetCookie(rw, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   int(ttl.Seconds()),
+		HttpOnly: true,
+		Secure:   req.TLS != nil || req.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// signValue returns the value as JSON with an HMAC, so it can be stored in a cookie and trusted when it comes back
+func (o *oidcAuth) signValue(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, o.key)
+	mac.Write(data)
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (o *oidcAuth) verifyValue(signed string, value any) bool {
+	dataStr, sigStr, ok := strings.Cut(signed, ".")
+	if !ok {
+		return false
+	}
+	data, err := base64.RawURLEncoding.DecodeString(dataStr)
+	if err != nil {
+		return false
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(sigStr)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, o.key)
+	mac.Write(data)
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return false
+	}
+	return json.Unmarshal(data, value) == nil
+}
@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
-	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/acorn-io/aml/pkg/cue"
@@ -14,6 +14,7 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/appdefinition"
+	"github.com/acorn-io/runtime/pkg/attestation"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	"github.com/acorn-io/runtime/pkg/build/buildkit"
 	"github.com/acorn-io/runtime/pkg/buildclient"
@@ -26,9 +27,13 @@ import (
 	"github.com/google/uuid"
 	client2 "github.com/moby/buildkit/client"
 	"github.com/opencontainers/go-digest"
+	"golang.org/x/sync/errgroup"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// maxParallelBuilds is how many images of an Acornfile are built at once
+const maxParallelBuilds = 4
+
 func ResolveAndParse(file string) (*appdefinition.AppDefinition, error) {
 	fileData, err := cue.ReadCUE(file)
 	if err != nil {
@@ -50,10 +55,49 @@ type buildContext struct {
 	messages       buildclient.Messages
 }
 
+// withLabel returns a copy of the build context for building one entry of the Acornfile concurrently with others
+func (b *buildContext) withLabel(ctx context.Context, label string) *buildContext {
+	newCtx := *b
+	newCtx.ctx = ctx
+	newCtx.messages = labeledMessages{
+		Messages: b.messages,
+		label:    label,
+	}
+	return &newCtx
+}
+
+// labeledMessages prefixes the names of the vertices in status messages with a label, so that the progress of
+// concurrent builds can be told apart.
+type labeledMessages struct {
+	buildclient.Messages
+	label string
+}
+
+func (l labeledMessages) Send(msg *buildclient.Message) error {
+	if msg.Status == nil || len(msg.Status.Vertexes) == 0 {
+		return l.Messages.Send(msg)
+	}
+
+	status := *msg.Status
+	status.Vertexes = make([]*client2.Vertex, 0, len(msg.Status.Vertexes))
+	for _, vertex := range msg.Status.Vertexes {
+		labeled := *vertex
+		labeled.Name = "[" + l.label + "] " + vertex.Name
+		status.Vertexes = append(status.Vertexes, &labeled)
+	}
+
+	labeled := *msg
+	labeled.Status = &status
+	return l.Messages.Send(&labeled)
+}
+
 func Build(ctx context.Context, messages buildclient.Messages, pushRepo, buildNamespace string, opts v1.AcornImageBuildInstanceSpec, keychain authn.Keychain, remoteOpts ...remote.Option) (*v1.AppImage, error) {
 	remoteKc := NewRemoteKeyChain(ctx, messages, keychain)
+	buildkitCtx := buildkit.WithContextCacheKey(ctx, opts.ContextCacheKey)
+	buildkitCtx = buildkit.WithRemoteCache(buildkitCtx, opts.CacheFrom, opts.CacheTo)
+	buildkitCtx = buildkit.WithSBOM(buildkitCtx, opts.SBOM)
 	buildContext := &buildContext{
-		ctx:            buildkit.WithContextCacheKey(ctx, opts.ContextCacheKey),
+		ctx:            buildkitCtx,
 		cwd:            "",
 		pushRepo:       pushRepo,
 		buildNamespace: buildNamespace,
@@ -69,6 +113,7 @@ func Build(ctx context.Context, messages buildclient.Messages, pushRepo, buildNa
 func build(ctx *buildContext) (*v1.AppImage, error) {
 	var (
 		acornfileData []byte
+		started       = time.Now()
 		err           error
 	)
 
@@ -114,171 +159,188 @@ func build(ctx *buildContext) (*v1.AppImage, error) {
 	appImage.ID = id
 	appImage.Digest = "sha256:" + id
 
+	if ctx.opts.Provenance {
+		if err := writeProvenance(ctx, appImage.Digest, started); err != nil {
+			return nil, fmt.Errorf("failed to attach provenance: %w", err)
+		}
+	}
+
 	return appImage, nil
 }
 
+func writeProvenance(ctx *buildContext, imageDigest string, started time.Time) error {
+	d, err := imagename.NewDigest(ctx.pushRepo + "@" + imageDigest)
+	if err != nil {
+		return err
+	}
+
+	descriptor, err := remote.Head(d, ctx.remoteOpts...)
+	if err != nil {
+		return err
+	}
+
+	statement, err := attestation.NewProvenance(d, ctx.opts, started, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return attestation.Write(d.Context(), *descriptor, attestation.PredicateTypeProvenance, statement, ctx.remoteOpts...)
+}
+
 func buildContainers(ctx *buildContext, buildCache *buildCache, containers map[string]v1.ContainerImageBuilderSpec) (map[string]v1.ContainerData, []v1.BuildRecord, error) {
-	var builds []v1.BuildRecord
-	result := map[string]v1.ContainerData{}
+	return buildParallel(ctx, containers, func(ctx *buildContext, key string, container v1.ContainerImageBuilderSpec) (*v1.ContainerData, []v1.BuildRecord, error) {
+		return buildContainer(ctx, buildCache, key, container)
+	})
+}
 
-	for _, entry := range typed.Sorted(containers) {
-		key, container := entry.Key, entry.Value
+func buildContainer(ctx *buildContext, buildCache *buildCache, key string, container v1.ContainerImageBuilderSpec) (*v1.ContainerData, []v1.BuildRecord, error) {
+	if container.Image == "" && container.Build == nil {
+		return nil, nil, fmt.Errorf("either image or build field must be set")
+	}
 
-		if container.Image == "" && container.Build == nil {
-			return nil, nil, fmt.Errorf("either image or build field must be set")
+	if container.Image != "" && container.Build == nil {
+		// this is a copy, it's fine to modify it
+		container.Build = &v1.Build{
+			BaseImage: container.Image,
 		}
+	}
+
+	id, err := fromBuild(ctx, buildCache, withBuildSecrets(ctx, key, *container.Build))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &v1.ContainerData{
+		Image:    id,
+		Sidecars: map[string]v1.ImageData{},
+	}
 
-		if container.Image != "" && container.Build == nil {
+	builds := []v1.BuildRecord{{
+		ContainerBuild: container.Normalize(),
+		ImageKey:       key,
+	}}
+
+	for _, entry := range typed.Sorted(container.Sidecars) {
+		sidecarKey, sidecar := entry.Key, entry.Value
+		if sidecar.Image != "" || sidecar.Build == nil {
 			// this is a copy, it's fine to modify it
-			container.Build = &v1.Build{
-				BaseImage: container.Image,
+			if sidecar.Build == nil {
+				sidecar.Build = &v1.Build{
+					BaseImage: sidecar.Image,
+				}
+			} else {
+				sidecar.Build.BaseImage = sidecar.Image
 			}
 		}
 
-		id, err := fromBuild(ctx, buildCache, *container.Build)
+		id, err := fromBuild(ctx, buildCache, withBuildSecrets(ctx, key+"."+sidecarKey, *sidecar.Build))
 		if err != nil {
 			return nil, nil, err
 		}
-
-		result[key] = v1.ContainerData{
-			Image:    id,
-			Sidecars: map[string]v1.ImageData{},
+		result.Sidecars[sidecarKey] = v1.ImageData{
+			Image: id,
 		}
-
 		builds = append(builds, v1.BuildRecord{
-			ContainerBuild: container.Normalize(),
-			ImageKey:       key,
+			ContainerBuild: sidecar.Normalize(),
+			ImageKey:       key + "." + sidecarKey,
 		})
-
-		var sidecarKeys []string
-		for k := range container.Sidecars {
-			sidecarKeys = append(sidecarKeys, k)
-		}
-		sort.Strings(sidecarKeys)
-
-		for _, entry := range typed.Sorted(container.Sidecars) {
-			sidecarKey, sidecar := entry.Key, entry.Value
-			if sidecar.Image != "" || sidecar.Build == nil {
-				// this is a copy, it's fine to modify it
-				if sidecar.Build == nil {
-					sidecar.Build = &v1.Build{
-						BaseImage: sidecar.Image,
-					}
-				} else {
-					sidecar.Build.BaseImage = sidecar.Image
-				}
-			}
-
-			id, err := fromBuild(ctx, buildCache, *sidecar.Build)
-			if err != nil {
-				return nil, nil, err
-			}
-			result[key].Sidecars[sidecarKey] = v1.ImageData{
-				Image: id,
-			}
-			builds = append(builds, v1.BuildRecord{
-				ContainerBuild: sidecar.Normalize(),
-				ImageKey:       key + "." + sidecarKey,
-			})
-		}
 	}
 
 	return result, builds, nil
 }
 
 func buildAcorns(ctx *buildContext, acorns map[string]v1.AcornBuilderSpec) (map[string]v1.ImageData, []v1.BuildRecord, error) {
-	var builds []v1.BuildRecord
-	result := map[string]v1.ImageData{}
-
-	for _, entry := range typed.Sorted(acorns) {
-		key, acornImage := entry.Key, entry.Value
-		if acornImage.Image != "" {
-			if _, auto := autoupgrade.AutoUpgradePattern(acornImage.Image); auto || acornImage.AutoUpgrade {
-				// This is the one situation where ImageKey is not set
-				builds = append(builds, v1.BuildRecord{
-					AcornBuild: &acornImage,
-				})
-				continue
-			}
+	return buildParallel(ctx, acorns, buildAcorn)
+}
 
-			// first attempt to resolve the image locally
-			id, err := resolveLocalImage(ctx, acornImage.Image)
-			if err != nil {
-				// see if it can be pulled from a remote registry
-				id, err = pullImage(ctx, acornImage.Image)
-				if err != nil {
-					return nil, nil, err
-				}
-			}
+func buildAcorn(ctx *buildContext, key string, acornImage v1.AcornBuilderSpec) (*v1.ImageData, []v1.BuildRecord, error) {
+	if acornImage.Image != "" {
+		if _, auto := autoupgrade.AutoUpgradePattern(acornImage.Image); auto || acornImage.AutoUpgrade {
+			// This is the one situation where ImageKey is not set
+			return nil, []v1.BuildRecord{{
+				AcornBuild: &acornImage,
+			}}, nil
+		}
 
-			result[key] = v1.ImageData{
-				Image: id,
-			}
-			builds = append(builds, v1.BuildRecord{
-				AcornBuild: acornImage.Normalize(),
-				ImageKey:   key,
-			})
-		} else if acornImage.Build != nil {
-			newCtx := *ctx
-			newCtx.opts.Profiles = nil
-			newCtx.opts.Args = acornImage.Build.BuildArgs
-			newCtx.opts.Acornfile = ""
-			newCtx.acornfilePath = filepath.Join(ctx.cwd, acornImage.Build.Acornfile)
-			newCtx.cwd = filepath.Join(ctx.cwd, acornImage.Build.Context)
-			appImage, err := build(&newCtx)
-			if err != nil {
-				return nil, nil, err
-			}
-			repo, err := imagename.NewRepository(ctx.pushRepo)
+		// first attempt to resolve the image locally
+		id, err := resolveLocalImage(ctx, acornImage.Image)
+		if err != nil {
+			// see if it can be pulled from a remote registry
+			id, err = pullImage(ctx, acornImage.Image)
 			if err != nil {
 				return nil, nil, err
 			}
-			result[key] = v1.ImageData{
-				Image: repo.Digest(appImage.Digest).String(),
-			}
-			builds = append(builds, v1.BuildRecord{
-				AcornBuild:    acornImage.Normalize(),
-				AcornAppImage: appImage,
-				ImageKey:      key,
-			})
 		}
+
+		return &v1.ImageData{
+			Image: id,
+		}, []v1.BuildRecord{{
+			AcornBuild: acornImage.Normalize(),
+			ImageKey:   key,
+		}}, nil
+	} else if acornImage.Build != nil {
+		newCtx := *ctx
+		newCtx.opts.Profiles = nil
+		newCtx.opts.Args = acornImage.Build.BuildArgs
+		newCtx.opts.Acornfile = ""
+		newCtx.acornfilePath = filepath.Join(ctx.cwd, acornImage.Build.Acornfile)
+		newCtx.cwd = filepath.Join(ctx.cwd, acornImage.Build.Context)
+		appImage, err := build(&newCtx)
+		if err != nil {
+			return nil, nil, err
+		}
+		repo, err := imagename.NewRepository(ctx.pushRepo)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &v1.ImageData{
+			Image: repo.Digest(appImage.Digest).String(),
+		}, []v1.BuildRecord{{
+			AcornBuild:    acornImage.Normalize(),
+			AcornAppImage: appImage,
+			ImageKey:      key,
+		}}, nil
 	}
 
-	return result, builds, nil
+	return nil, nil, nil
 }
 
 func buildImages(ctx *buildContext, buildCache *buildCache, images map[string]v1.ImageBuilderSpec) (map[string]v1.ImageData, []v1.BuildRecord, error) {
-	var builds []v1.BuildRecord
-	result := map[string]v1.ImageData{}
+	containerBuilds := map[string]v1.ImageBuilderSpec{}
 	acornBuilds := map[string]v1.AcornBuilderSpec{}
 
-	for _, entry := range typed.Sorted(images) {
-		key, image := entry.Key, entry.Value
+	for key, image := range images {
 		if image.ContainerBuild == nil {
 			acornBuilds[key] = v1.AcornBuilderSpec{
 				Image: image.Image,
 				Build: image.AcornBuild,
 			}
 		} else {
-			if image.Image != "" {
-				image.ContainerBuild = &v1.Build{
-					BaseImage: image.Image,
-				}
-			}
+			containerBuilds[key] = image
+		}
+	}
 
-			id, err := fromBuild(ctx, buildCache, *image.ContainerBuild)
-			if err != nil {
-				return nil, nil, err
+	result, builds, err := buildParallel(ctx, containerBuilds, func(ctx *buildContext, key string, image v1.ImageBuilderSpec) (*v1.ImageData, []v1.BuildRecord, error) {
+		if image.Image != "" {
+			image.ContainerBuild = &v1.Build{
+				BaseImage: image.Image,
 			}
+		}
 
-			result[key] = v1.ImageData{
-				Image: id,
-			}
-			builds = append(builds, v1.BuildRecord{
-				ImageBuild: image.Normalize(),
-				ImageKey:   key,
-			})
+		id, err := fromBuild(ctx, buildCache, withBuildSecrets(ctx, key, *image.ContainerBuild))
+		if err != nil {
+			return nil, nil, err
 		}
+
+		return &v1.ImageData{
+			Image: id,
+		}, []v1.BuildRecord{{
+			ImageBuild: image.Normalize(),
+			ImageKey:   key,
+		}}, nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
 	acornImages, acornBuildRecords, err := buildAcorns(ctx, acornBuilds)
@@ -289,6 +351,47 @@ func buildImages(ctx *buildContext, buildCache *buildCache, images map[string]v1
 	return typed.Concat(result, acornImages), append(builds, acornBuildRecords...), nil
 }
 
+// buildParallel builds the entries with up to maxParallelBuilds builds running at once. Each build gets a copy of the
+// build context whose progress messages are labeled with the key of the entry. The results are collected in the order
+// of the sorted keys, so they don't depend on which build finishes first. The first failed build cancels the others.
+func buildParallel[T, V any](ctx *buildContext, entries map[string]V, build func(ctx *buildContext, key string, entry V) (*T, []v1.BuildRecord, error)) (map[string]T, []v1.BuildRecord, error) {
+	type buildResult struct {
+		data   *T
+		builds []v1.BuildRecord
+	}
+
+	var (
+		sorted  = typed.Sorted(entries)
+		results = make([]buildResult, len(sorted))
+	)
+
+	eg, egCtx := errgroup.WithContext(ctx.ctx)
+	eg.SetLimit(maxParallelBuilds)
+	for i, entry := range sorted {
+		i, entry := i, entry
+		entryCtx := ctx.withLabel(egCtx, entry.Key)
+		eg.Go(func() (err error) {
+			results[i].data, results[i].builds, err = build(entryCtx, entry.Key, entry.Value)
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		data   = map[string]T{}
+		builds []v1.BuildRecord
+	)
+	for i, entry := range sorted {
+		if results[i].data != nil {
+			data[entry.Key] = *results[i].data
+		}
+		builds = append(builds, results[i].builds...)
+	}
+	return data, builds, nil
+}
+
 func fromSpec(ctx *buildContext, spec v1.BuilderSpec) (v1.ImagesData, error) {
 	var (
 		err  error
@@ -493,35 +596,44 @@ func printImageProgress(ctx *buildContext, id, name string, progress chan ggcrv1
 	}
 }
 
-func fromBuild(ctx *buildContext, buildCache *buildCache, build v1.Build) (id string, err error) {
-	id, err = buildCache.Get(build, ctx.opts.Platforms)
-	if err != nil || id != "" {
-		return id, err
+// withBuildSecrets sets the IDs of the secrets and SSH agents of the client that the build of the container, job,
+// sidecar or image with the key can use. They are part of the build, so builds with different secrets aren't shared.
+func withBuildSecrets(ctx *buildContext, key string, build v1.Build) v1.Build {
+	build.Secrets, build.SSH = nil, nil
+	for _, secret := range ctx.opts.Secrets {
+		if secret.Allowed(key) {
+			build.Secrets = append(build.Secrets, secret.ID)
+		}
 	}
-
-	defer func() {
-		if err == nil && id != "" {
-			buildCache.Store(build, ctx.opts.Platforms, id)
+	for _, agent := range ctx.opts.SSH {
+		if agent.Allowed(key) {
+			build.SSH = append(build.SSH, agent.ID)
 		}
-	}()
-
-	if build.Dockerfile == "" {
-		build.Dockerfile = "Dockerfile"
 	}
+	return build
+}
 
-	if build.Context == "" {
-		build.Context = "."
-	}
+func fromBuild(ctx *buildContext, buildCache *buildCache, build v1.Build) (string, error) {
+	return buildCache.Build(build, ctx.opts.Platforms, func() (string, error) {
+		if build.Dockerfile == "" {
+			build.Dockerfile = "Dockerfile"
+		}
 
-	if build.BaseImage != "" || len(build.ContextDirs) > 0 {
-		return buildWithContext(ctx, build)
-	}
+		if build.Context == "" {
+			build.Context = "."
+		}
+
+		if build.BaseImage != "" || len(build.ContextDirs) > 0 {
+			return buildWithContext(ctx, build)
+		}
 
-	return buildImageAndManifest(ctx, build)
+		return buildImageAndManifest(ctx, build)
+	})
 }
 
 func buildImageNoManifest(ctx *buildContext, cwd string, build v1.Build) (string, error) {
-	_, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, true, cwd, nil, build, ctx.messages, ctx.keychain)
+	// This image only holds the Acornfile, it has nothing an SBOM would list
+	_, ids, err := buildkit.Build(buildkit.WithSBOM(ctx.ctx, false), ctx.pushRepo, true, cwd, nil, build, ctx.messages, ctx.keychain)
 	if err != nil {
 		return "", err
 	}
@@ -534,6 +646,15 @@ func buildImageAndManifest(ctx *buildContext, build v1.Build) (string, error) {
 		return "", err
 	}
 
+	if ctx.opts.SBOM {
+		for i, id := range ids {
+			ids[i], err = attestation.FromBuildkitIndex(id, ctx.remoteOpts...)
+			if err != nil {
+				return "", fmt.Errorf("failed to attach SBOM: %w", err)
+			}
+		}
+	}
+
 	if len(ids) == 1 {
 		return ids[0], nil
 	}
@@ -603,8 +724,17 @@ func getAcornfile(ctx *buildContext, path string) ([]byte, error) {
 	}
 }
 
+// buildCache deduplicates the builds of an Acornfile. Identical builds are only built once, builds requested while
+// an identical build is running wait for its result.
 type buildCache struct {
-	cache map[string]string
+	lock  sync.Mutex
+	cache map[string]*cachedBuild
+}
+
+type cachedBuild struct {
+	done chan struct{}
+	id   string
+	err  error
 }
 
 func (b *buildCache) toKey(platforms []v1.Platform, build v1.Build) (string, error) {
@@ -615,23 +745,30 @@ func (b *buildCache) toKey(platforms []v1.Platform, build v1.Build) (string, err
 	return string(data), err
 }
 
-func (b *buildCache) Get(build v1.Build, platforms []v1.Platform) (string, error) {
+// Build returns the ID of the image of an identical build or else builds it with buildFunc
+func (b *buildCache) Build(build v1.Build, platforms []v1.Platform, buildFunc func() (string, error)) (string, error) {
 	key, err := b.toKey(platforms, build)
 	if err != nil {
-		// ignore error and return as cache miss
-		return "", nil
+		// ignore error and build without the cache
+		return buildFunc()
 	}
-	return b.cache[key], nil
-}
 
-func (b *buildCache) Store(build v1.Build, platforms []v1.Platform, id string) {
-	key, err := b.toKey(platforms, build)
-	if err != nil {
-		// ignore error and return as cache miss
-		return
+	b.lock.Lock()
+	if cached, ok := b.cache[key]; ok {
+		b.lock.Unlock()
+		<-cached.done
+		return cached.id, cached.err
 	}
 	if b.cache == nil {
-		b.cache = map[string]string{}
+		b.cache = map[string]*cachedBuild{}
 	}
-	b.cache[key] = id
+	cached := &cachedBuild{
+		done: make(chan struct{}),
+	}
+	b.cache[key] = cached
+	b.lock.Unlock()
+
+	cached.id, cached.err = buildFunc()
+	close(cached.done)
+	return cached.id, cached.err
 }
@@ -1,10 +1,20 @@
 package build
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/buildclient"
 	vcs2 "github.com/acorn-io/runtime/pkg/vcs"
+	client2 "github.com/moby/buildkit/client"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestVCS(t *testing.T) {
@@ -43,3 +53,150 @@ COPY --link "./files2" "/var/tmp2/"
 		})
 	}
 }
+
+func TestBuildCacheDeduplicates(t *testing.T) {
+	var (
+		cache  = &buildCache{}
+		builds atomic.Int32
+		wg     sync.WaitGroup
+		ids    = make([]string, 5)
+	)
+
+	for i := range ids {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := cache.Build(v1.Build{Context: "."}, nil, func() (string, error) {
+				builds.Add(1)
+				time.Sleep(10 * time.Millisecond)
+				return "image", nil
+			})
+			assert.NoError(t, err)
+			ids[i] = id
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), builds.Load())
+	assert.Equal(t, []string{"image", "image", "image", "image", "image"}, ids)
+
+	id, err := cache.Build(v1.Build{Context: "other"}, nil, func() (string, error) {
+		return "other", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "other", id)
+}
+
+func TestWithBuildSecrets(t *testing.T) {
+	ctx := &buildContext{
+		opts: v1.AcornImageBuildInstanceSpec{
+			Secrets: []v1.BuildSecretID{
+				{ID: "npmrc"},
+				{ID: "deploy", Builds: []string{"web", "web.proxy"}},
+			},
+			SSH: []v1.BuildSecretID{
+				{ID: "default", Builds: []string{"worker"}},
+			},
+		},
+	}
+	build := v1.Build{Context: ".", Secrets: []string{"other"}}
+
+	web := withBuildSecrets(ctx, "web", build)
+	assert.Equal(t, []string{"npmrc", "deploy"}, web.Secrets)
+	assert.Nil(t, web.SSH)
+
+	proxy := withBuildSecrets(ctx, "web.proxy", build)
+	assert.Equal(t, []string{"npmrc", "deploy"}, proxy.Secrets)
+
+	worker := withBuildSecrets(ctx, "worker", build)
+	assert.Equal(t, []string{"npmrc"}, worker.Secrets)
+	assert.Equal(t, []string{"default"}, worker.SSH)
+
+	// The same Dockerfile built with different secrets isn't shared
+	var (
+		cache  = &buildCache{}
+		builds int
+	)
+	for _, b := range []v1.Build{web, worker, web} {
+		_, err := cache.Build(b, nil, func() (string, error) {
+			builds++
+			return "image", nil
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, builds)
+}
+
+func TestBuildParallel(t *testing.T) {
+	var (
+		running, maxRunning atomic.Int32
+		ctx                 = &buildContext{ctx: context.Background()}
+		entries             = map[string]int{}
+	)
+	for i := 0; i < 10; i++ {
+		entries[fmt.Sprintf("image%02d", i)] = i
+	}
+
+	data, builds, err := buildParallel(ctx, entries, func(ctx *buildContext, key string, entry int) (*v1.ImageData, []v1.BuildRecord, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			previous := maxRunning.Load()
+			if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		// Finish the builds in reverse order
+		time.Sleep(time.Duration(10-entry) * time.Millisecond)
+		return &v1.ImageData{Image: key}, []v1.BuildRecord{{ImageKey: key}}, nil
+	})
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, maxRunning.Load(), int32(maxParallelBuilds))
+	assert.Len(t, data, 10)
+	for i, build := range builds {
+		assert.Equal(t, fmt.Sprintf("image%02d", i), build.ImageKey)
+	}
+
+	_, _, err = buildParallel(ctx, entries, func(ctx *buildContext, key string, entry int) (*v1.ImageData, []v1.BuildRecord, error) {
+		if entry == 3 {
+			return nil, nil, errors.New("build failed")
+		}
+		<-ctx.ctx.Done()
+		return nil, nil, ctx.ctx.Err()
+	})
+	assert.EqualError(t, err, "build failed")
+}
+
+type recordedMessages struct {
+	buildclient.Messages
+	sent []*buildclient.Message
+}
+
+func (r *recordedMessages) Send(msg *buildclient.Message) error {
+	r.sent = append(r.sent, msg)
+	return nil
+}
+
+func TestLabeledMessages(t *testing.T) {
+	recorded := &recordedMessages{}
+	ctx := (&buildContext{ctx: context.Background(), messages: recorded}).withLabel(context.Background(), "web")
+
+	vertex := &client2.Vertex{Name: "RUN make"}
+	require.NoError(t, ctx.messages.Send(&buildclient.Message{
+		StatusSessionID: "session",
+		Status: &client2.SolveStatus{
+			Vertexes: []*client2.Vertex{vertex},
+		},
+	}))
+	require.NoError(t, ctx.messages.Send(&buildclient.Message{
+		Acornfile: "Acornfile",
+	}))
+
+	require.Len(t, recorded.sent, 2)
+	assert.Equal(t, "[web] RUN make", recorded.sent[0].Status.Vertexes[0].Name)
+	assert.Equal(t, "session", recorded.sent[0].StatusSessionID)
+	assert.Equal(t, "RUN make", vertex.Name)
+	assert.Equal(t, "Acornfile", recorded.sent[1].Acornfile)
+}
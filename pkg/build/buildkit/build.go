@@ -12,6 +12,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/digest"
 	cplatforms "github.com/containerd/containerd/platforms"
 	"github.com/google/go-containerregistry/pkg/authn"
+	imagename "github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/uuid"
 	buildkit "github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/session"
@@ -30,6 +31,73 @@ func getCacheKey(ctx context.Context) string {
 	return v
 }
 
+type sbomKey struct{}
+
+// WithSBOM sets whether builds generate an SPDX SBOM of the image
+func WithSBOM(ctx context.Context, sbom bool) context.Context {
+	return context.WithValue(ctx, sbomKey{}, sbom)
+}
+
+func getSBOM(ctx context.Context) bool {
+	v, _ := ctx.Value(sbomKey{}).(bool)
+	return v
+}
+
+type remoteCacheKey struct{}
+
+type remoteCache struct {
+	from, to []string
+}
+
+// WithRemoteCache sets the registry repositories the build cache is imported from and exported to
+func WithRemoteCache(ctx context.Context, from, to []string) context.Context {
+	return context.WithValue(ctx, remoteCacheKey{}, remoteCache{
+		from: from,
+		to:   to,
+	})
+}
+
+func getRemoteCache(ctx context.Context) remoteCache {
+	v, _ := ctx.Value(remoteCacheKey{}).(remoteCache)
+	return v
+}
+
+// remoteCacheRef returns the reference of the cache of one build in the repository. Each build and platform gets its
+// own tag, so that the builds of an Acornfile don't overwrite each other's cache. If the repository has a tag, it is
+// used as prefix of the tags of the builds.
+func remoteCacheRef(repo, cwd string, buildData []byte, platform string) (string, error) {
+	tag := digest.SHA256(cwd, string(buildData), platform)[:16]
+	if repository, err := imagename.NewRepository(repo); err == nil {
+		return repository.Tag(tag).String(), nil
+	}
+
+	ref, err := imagename.NewTag(repo)
+	if err != nil {
+		return "", fmt.Errorf("invalid build cache [%s]: %w", repo, err)
+	}
+	return ref.Context().Tag(ref.TagStr() + "-" + tag).String(), nil
+}
+
+func remoteCacheEntries(refs []string, cwd string, buildData []byte, platform string, attrs map[string]string) (result []buildkit.CacheOptionsEntry, _ error) {
+	for _, repo := range refs {
+		ref, err := remoteCacheRef(repo, cwd, buildData, platform)
+		if err != nil {
+			return nil, err
+		}
+		entry := buildkit.CacheOptionsEntry{
+			Type: "registry",
+			Attrs: map[string]string{
+				"ref": ref,
+			},
+		}
+		for k, v := range attrs {
+			entry.Attrs[k] = v
+		}
+		result = append(result, entry)
+	}
+	return
+}
+
 func Build(ctx context.Context, pushRepo string, local bool, cwd string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain) ([]v1.Platform, []string, error) {
 	bkc, err := buildkit.New(ctx, "")
 	if err != nil {
@@ -110,6 +178,32 @@ func Build(ctx context.Context, pushRepo string, local bool, cwd string, platfor
 					build.DockerfileContents))
 		}
 
+		// Only the IDs of the secrets and agents are part of the build, the values are requested from the client
+		if len(build.Secrets) > 0 {
+			options.Session = append(options.Session, buildclient.NewSecretProvider(messages, build.Secrets))
+		}
+		if len(build.SSH) > 0 {
+			options.Session = append(options.Session, buildclient.NewSSHProvider(messages, build.SSH))
+		}
+
+		remoteCache := getRemoteCache(ctx)
+		options.CacheImports, err = remoteCacheEntries(remoteCache.from, cwd, buildData, options.FrontendAttrs["platform"], nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Export the cache of all stages, not only the final one
+		options.CacheExports, err = remoteCacheEntries(remoteCache.to, cwd, buildData, options.FrontendAttrs["platform"], map[string]string{
+			"mode": "max",
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The SBOM is added to an index with the image, the digest of the result is the digest of the index
+		if getSBOM(ctx) {
+			options.FrontendAttrs["attest:sbom"] = ""
+		}
+
 		for key, value := range build.BuildArgs {
 			options.FrontendAttrs["build-arg:"+key] = value
 		}
@@ -0,0 +1,31 @@
+package buildkit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteCacheRef(t *testing.T) {
+	ref, err := remoteCacheRef("ghcr.io/acorn-io/cache", ".", []byte(`{"context":"."}`), "linux/amd64")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ref, "ghcr.io/acorn-io/cache:"), ref)
+
+	// Each build and platform gets its own tag
+	other, err := remoteCacheRef("ghcr.io/acorn-io/cache", ".", []byte(`{"context":"."}`), "linux/arm64")
+	require.NoError(t, err)
+	assert.NotEqual(t, ref, other)
+
+	same, err := remoteCacheRef("ghcr.io/acorn-io/cache", ".", []byte(`{"context":"."}`), "linux/amd64")
+	require.NoError(t, err)
+	assert.Equal(t, ref, same)
+
+	tagged, err := remoteCacheRef("ghcr.io/acorn-io/cache:main", ".", []byte(`{"context":"."}`), "linux/amd64")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Replace(ref, "cache:", "cache:main-", 1), tagged)
+
+	_, err = remoteCacheRef("ghcr.io/Acorn-IO/cache", ".", nil, "linux/amd64")
+	assert.Error(t, err)
+}
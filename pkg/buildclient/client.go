@@ -31,7 +31,7 @@ type CredentialLookup func(ctx context.Context, serverAddress string) (*apiv1.Re
 type WebSocketDialer func(ctx context.Context, urlStr string, requestHeader http.Header) (*websocket.Conn, *http.Response, error)
 
 func Stream(ctx context.Context, cwd string, streams *streams.Output, dialer WebSocketDialer,
-	creds CredentialLookup, build *apiv1.AcornImageBuild) (*v1.AppImage, error) {
+	creds CredentialLookup, secrets *BuildSecrets, build *apiv1.AcornImageBuild) (*v1.AppImage, error) {
 	conn, response, err := dialer(ctx, wsURL(build.Status.BuildURL), map[string][]string{
 		"X-Acorn-Build-Token": {build.Status.Token},
 	})
@@ -63,6 +63,9 @@ func Stream(ctx context.Context, cwd string, streams *streams.Output, dialer Web
 	}()
 	defer messages.Close()
 
+	agents := newSSHAgents(messages, secrets)
+	defer agents.Close()
+
 	msgs, cancel := messages.Recv()
 	defer cancel()
 
@@ -112,6 +115,16 @@ func Stream(ctx context.Context, cwd string, streams *streams.Output, dialer Web
 			if err != nil {
 				return nil, err
 			}
+		} else if msg.BuildSecretID != "" {
+			resp, err := secrets.lookupSecret(msg.BuildSecretID)
+			if err != nil {
+				return nil, err
+			}
+			if err := messages.Send(resp); err != nil {
+				return nil, err
+			}
+		} else if msg.SSHSessionID != "" {
+			agents.handle(msg)
 		} else if msg.Error != "" {
 			return nil, errors.New(msg.Error)
 		}
@@ -31,6 +31,8 @@ type Message struct {
 	//         Error - Build failed, error
 	//         Acornfile - Request/Response for Acornfile lookup
 	//         RegistryServerAddress - Server requesting a registry credential, or Client responding
+	//         BuildSecretID - Server requesting a build secret, or Client responding
+	//         SSHSessionID - SSH agent forwarding message
 
 	FileSessionID         string       `json:"fileSessionID,omitempty"`
 	StatusSessionID       string       `json:"statusSessionID,omitempty"`
@@ -38,16 +40,23 @@ type Message struct {
 	Error                 string       `json:"error,omitempty"`
 	Acornfile             string       `json:"acornfile,omitempty"`
 	RegistryServerAddress string       `json:"registryServerAddress,omitempty"`
+	BuildSecretID         string       `json:"buildSecretID,omitempty"`
+	SSHSessionID          string       `json:"sshSessionID,omitempty"`
 
 	// The below fields are additional metadata for each one of the above messages types
 
-	FileSessionClose bool                `json:"fileSessionClose,omitempty"`
-	RegistryAuth     *apiv1.RegistryAuth `json:"registryAuth,omitempty"`
-	SyncOptions      *SyncOptions        `json:"syncOptions,omitempty"`
-	Packet           *types.Packet       `json:"packet,omitempty"`
-	PacketData       []byte              `json:"packetData,omitempty"`
-	Status           *client.SolveStatus `json:"status,omitempty"`
-	Compress         bool                `json:"compress,omitempty"`
+	FileSessionClose    bool                `json:"fileSessionClose,omitempty"`
+	RegistryAuth        *apiv1.RegistryAuth `json:"registryAuth,omitempty"`
+	SyncOptions         *SyncOptions        `json:"syncOptions,omitempty"`
+	Packet              *types.Packet       `json:"packet,omitempty"`
+	PacketData          []byte              `json:"packetData,omitempty"`
+	Status              *client.SolveStatus `json:"status,omitempty"`
+	Compress            bool                `json:"compress,omitempty"`
+	BuildSecret         []byte              `json:"buildSecret,omitempty"`
+	BuildSecretNotFound bool                `json:"buildSecretNotFound,omitempty"`
+	SSHID               string              `json:"sshID,omitempty"`
+	SSHData             []byte              `json:"sshData,omitempty"`
+	SSHSessionClose     bool                `json:"sshSessionClose,omitempty"`
 }
 
 type message Message
@@ -126,7 +135,12 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 }
 
 func (m *Message) String() string {
-	data, _ := json.Marshal(m)
+	cp := *m
+	if cp.BuildSecret != nil {
+		// never log the values of secrets
+		cp.BuildSecret = []byte("<redacted>")
+	}
+	data, _ := json.Marshal(&cp)
 	return string(data)
 }
 
@@ -11,11 +11,10 @@ import (
 )
 
 type clientProgressStatus struct {
-	streams        *streams.Output
-	currentSession string
-	progressChan   chan *buildkit.SolveStatus
-	doneChan       chan struct{}
-	ctx            context.Context
+	streams      *streams.Output
+	progressChan chan *buildkit.SolveStatus
+	doneChan     chan struct{}
+	ctx          context.Context
 }
 
 func newClientProgress(ctx context.Context, stream *streams.Output) *clientProgressStatus {
@@ -25,16 +24,16 @@ func newClientProgress(ctx context.Context, stream *streams.Output) *clientProgr
 	}
 }
 
+// Display shows the progress of all status sessions in one display, since the images of an Acornfile are built
+// concurrently and the messages of their sessions are interleaved. The vertices of each build are labeled by the server.
 func (c *clientProgressStatus) Display(msg *Message) {
 	if msg.StatusSessionID == "" {
 		return
 	}
-	if c.currentSession != msg.StatusSessionID {
-		logrus.Debugf("Switching from status session %s => %s", c.currentSession, msg.StatusSessionID)
-		c.Close()
+	if c.progressChan == nil {
+		logrus.Debugf("Starting progress display with status session %s", msg.StatusSessionID)
 		c.progressChan = make(chan *buildkit.SolveStatus, 1)
 		c.doneChan = make(chan struct{})
-		c.currentSession = msg.StatusSessionID
 		go c.display(c.progressChan)
 	}
 	c.progressChan <- msg.Status
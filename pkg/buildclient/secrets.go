@@ -0,0 +1,224 @@
+package buildclient
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/secrets"
+	"github.com/moby/buildkit/session/secrets/secretsprovider"
+	"golang.org/x/exp/slices"
+)
+
+// BuildSecrets are the sources of the secrets and SSH agents the client provides to the builds of an Acornfile. The
+// values never leave the client other than when a build requests them.
+type BuildSecrets struct {
+	// Secrets are the sources of the secrets by ID
+	Secrets map[string]BuildSecretSource
+	// SSH are the sockets of the SSH agents by ID
+	SSH map[string]string
+
+	secretIDs map[string]*v1.BuildSecretID
+	sshIDs    map[string]*v1.BuildSecretID
+}
+
+type BuildSecretSource struct {
+	File string
+	Env  string
+}
+
+// ParseBuildSecrets parses secrets in the form [BUILD:]id=ID[,src=FILE|env=VAR] and SSH agents in the form
+// [BUILD:]ID[=SOCKET]. A secret without a source is read from the environment variable named ID, an agent without a
+// socket is the agent of SSH_AUTH_SOCK. BUILD is the key of the container, job, sidecar (as container.sidecar) or
+// image whose build can use the secret or agent, without it every build can use it.
+func ParseBuildSecrets(secrets, ssh []string) (*BuildSecrets, error) {
+	result := &BuildSecrets{
+		Secrets:   map[string]BuildSecretSource{},
+		SSH:       map[string]string{},
+		secretIDs: map[string]*v1.BuildSecretID{},
+		sshIDs:    map[string]*v1.BuildSecretID{},
+	}
+
+	for _, secret := range secrets {
+		var (
+			id     string
+			source BuildSecretSource
+		)
+		build, fields := cutBuild(secret)
+		for _, field := range strings.Split(fields, ",") {
+			key, value, _ := strings.Cut(field, "=")
+			switch key {
+			case "id":
+				id = value
+			case "src", "source":
+				source.File = value
+			case "env":
+				source.Env = value
+			default:
+				return nil, fmt.Errorf("invalid build secret [%s], unknown field [%s]", secret, key)
+			}
+		}
+		if id == "" {
+			return nil, fmt.Errorf("invalid build secret [%s], id is required", secret)
+		}
+		if source.File != "" && source.Env != "" {
+			return nil, fmt.Errorf("invalid build secret [%s], only one of src and env can be set", secret)
+		}
+		if source.File == "" && source.Env == "" {
+			source.Env = id
+		}
+		if existing, ok := result.Secrets[id]; ok && existing != source {
+			return nil, fmt.Errorf("invalid build secret [%s], secret [%s] is already given with another source", secret, id)
+		}
+		result.Secrets[id] = source
+		addBuild(result.secretIDs, id, build)
+	}
+
+	for _, agent := range ssh {
+		build, agentID := cutBuild(agent)
+		id, socket, _ := strings.Cut(agentID, "=")
+		if id == "" {
+			return nil, fmt.Errorf("invalid ssh agent [%s], id is required", agent)
+		}
+		if socket == "" {
+			socket = os.Getenv("SSH_AUTH_SOCK")
+			if socket == "" {
+				return nil, fmt.Errorf("invalid ssh agent [%s], SSH_AUTH_SOCK is not set", agent)
+			}
+		}
+		if existing, ok := result.SSH[id]; ok && existing != socket {
+			return nil, fmt.Errorf("invalid ssh agent [%s], agent [%s] is already given with another socket", agent, id)
+		}
+		result.SSH[id] = socket
+		addBuild(result.sshIDs, id, build)
+	}
+
+	return result, nil
+}
+
+// cutBuild splits the key of the build, which is followed by a colon, from a secret or agent. A colon after the first
+// equal sign belongs to the value, such as the path of a file.
+func cutBuild(s string) (string, string) {
+	colon := strings.Index(s, ":")
+	if equal := strings.Index(s, "="); colon <= 0 || (equal >= 0 && equal < colon) {
+		return "", s
+	}
+	return s[:colon], s[colon+1:]
+}
+
+// addBuild adds the build to the builds that can use the ID, an empty build means every build can use it
+func addBuild(ids map[string]*v1.BuildSecretID, id, build string) {
+	existing, ok := ids[id]
+	switch {
+	case !ok:
+		existing = &v1.BuildSecretID{ID: id}
+		if build != "" {
+			existing.Builds = []string{build}
+		}
+		ids[id] = existing
+	case build == "":
+		existing.Builds = nil
+	case len(existing.Builds) > 0 && !slices.Contains(existing.Builds, build):
+		existing.Builds = append(existing.Builds, build)
+	}
+}
+
+// IDs returns the IDs of the secrets and of the SSH agents and the builds that can use them, sorted by ID
+func (b *BuildSecrets) IDs() (secrets []v1.BuildSecretID, ssh []v1.BuildSecretID) {
+	for _, id := range typed.SortedKeys(b.secretIDs) {
+		secrets = append(secrets, *b.secretIDs[id])
+	}
+	for _, id := range typed.SortedKeys(b.sshIDs) {
+		ssh = append(ssh, *b.sshIDs[id])
+	}
+	return
+}
+
+// lookupSecret returns the response of the client to the request of the server for a build secret
+func (b *BuildSecrets) lookupSecret(id string) (*Message, error) {
+	result := &Message{
+		BuildSecretID: id,
+	}
+
+	var source BuildSecretSource
+	if b != nil {
+		source = b.Secrets[id]
+	}
+
+	switch {
+	case source.File != "":
+		data, err := os.ReadFile(source.File)
+		if err != nil {
+			return nil, fmt.Errorf("reading build secret [%s]: %w", id, err)
+		}
+		result.BuildSecret = data
+	case source.Env != "":
+		if value, ok := os.LookupEnv(source.Env); ok {
+			result.BuildSecret = []byte(value)
+		} else {
+			result.BuildSecretNotFound = true
+		}
+	default:
+		result.BuildSecretNotFound = true
+	}
+
+	return result, nil
+}
+
+// NewSecretProvider returns the session attachable that requests the secrets of a build from the client
+func NewSecretProvider(messages Messages, ids []string) session.Attachable {
+	store := &secretStore{
+		messages: messages,
+		ids:      map[string]bool{},
+	}
+	for _, id := range ids {
+		store.ids[id] = true
+	}
+	return secretsprovider.NewSecretProvider(store)
+}
+
+type secretStore struct {
+	messages Messages
+	ids      map[string]bool
+}
+
+func (s *secretStore) GetSecret(ctx context.Context, id string) ([]byte, error) {
+	if !s.ids[id] {
+		return nil, fmt.Errorf("%w: secret [%s] is not in the secrets of the build", secrets.ErrNotFound, id)
+	}
+
+	msgs, cancel := s.messages.Recv()
+	defer cancel()
+
+	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, 15*time.Second)
+	defer timeoutCancel()
+
+	if err := s.messages.Send(&Message{
+		BuildSecretID: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case <-timeoutCtx.Done():
+			return nil, fmt.Errorf("timeout waiting for build secret [%s]", id)
+		case resp, ok := <-msgs:
+			if !ok {
+				return nil, fmt.Errorf("connection closed waiting for build secret [%s]", id)
+			}
+			if resp.BuildSecretID != id {
+				continue
+			}
+			if resp.BuildSecretNotFound {
+				return nil, fmt.Errorf("%w: secret [%s] is not provided by the client", secrets.ErrNotFound, id)
+			}
+			return resp.BuildSecret, nil
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package buildclient
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/moby/buildkit/session/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBuildSecrets(t *testing.T) {
+	t.Setenv("SSH_AUTH_SOCK", "/tmp/agent.sock")
+
+	result, err := ParseBuildSecrets([]string{
+		"id=npmrc,src=/home/user/.npmrc",
+		"id=token,env=GITHUB_TOKEN",
+		"id=NPM_TOKEN",
+		"web:id=deploy,src=/tmp/a:b",
+		"worker:id=deploy,src=/tmp/a:b",
+		"web:id=NPM_TOKEN",
+	}, []string{"default", "web:github=/tmp/github.sock"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]BuildSecretSource{
+		"npmrc":     {File: "/home/user/.npmrc"},
+		"token":     {Env: "GITHUB_TOKEN"},
+		"NPM_TOKEN": {Env: "NPM_TOKEN"},
+		"deploy":    {File: "/tmp/a:b"},
+	}, result.Secrets)
+	assert.Equal(t, map[string]string{
+		"default": "/tmp/agent.sock",
+		"github":  "/tmp/github.sock",
+	}, result.SSH)
+
+	secretIDs, sshIDs := result.IDs()
+	assert.Equal(t, []v1.BuildSecretID{
+		{ID: "NPM_TOKEN"},
+		{ID: "deploy", Builds: []string{"web", "worker"}},
+		{ID: "npmrc"},
+		{ID: "token"},
+	}, secretIDs)
+	assert.Equal(t, []v1.BuildSecretID{
+		{ID: "default"},
+		{ID: "github", Builds: []string{"web"}},
+	}, sshIDs)
+
+	for _, invalid := range [][]string{
+		{"src=/tmp/file"},
+		{"id=x,src=/tmp/file,env=X"},
+		{"id=x,type=file"},
+		{"web:id=x,src=/tmp/file", "worker:id=x,src=/tmp/other"},
+	} {
+		_, err := ParseBuildSecrets(invalid, nil)
+		assert.Error(t, err, invalid)
+	}
+}
+
+// fakeMessages delivers the messages sent by the server to a handler that responds like the client
+type fakeMessages struct {
+	client func(msg *Message) *Message
+	recv   chan *Message
+}
+
+func (f *fakeMessages) Recv() (<-chan *Message, func()) {
+	return f.recv, func() {}
+}
+
+func (f *fakeMessages) Send(msg *Message) error {
+	if resp := f.client(msg); resp != nil {
+		f.recv <- resp
+	}
+	return nil
+}
+
+func (f *fakeMessages) Close() {}
+
+func TestSecretStore(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "npmrc")
+	require.NoError(t, os.WriteFile(file, []byte("//registry.npmjs.org/:_authToken=abc"), 0600))
+	t.Setenv("GITHUB_TOKEN", "ghp_123")
+
+	buildSecrets, err := ParseBuildSecrets([]string{"id=npmrc,src=" + file, "id=token,env=GITHUB_TOKEN", "id=missing"}, nil)
+	require.NoError(t, err)
+
+	messages := &fakeMessages{
+		recv: make(chan *Message, 1),
+		client: func(msg *Message) *Message {
+			resp, err := buildSecrets.lookupSecret(msg.BuildSecretID)
+			require.NoError(t, err)
+			return resp
+		},
+	}
+	store := &secretStore{
+		messages: messages,
+		ids: map[string]bool{
+			"npmrc":   true,
+			"token":   true,
+			"missing": true,
+		},
+	}
+
+	data, err := store.GetSecret(context.Background(), "npmrc")
+	require.NoError(t, err)
+	assert.Equal(t, "//registry.npmjs.org/:_authToken=abc", string(data))
+
+	data, err = store.GetSecret(context.Background(), "token")
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_123", string(data))
+
+	_, err = store.GetSecret(context.Background(), "missing")
+	assert.True(t, errors.Is(err, secrets.ErrNotFound), err)
+
+	// Secrets that aren't listed in the build are never requested from the client
+	_, err = store.GetSecret(context.Background(), "other")
+	assert.True(t, errors.Is(err, secrets.ErrNotFound), err)
+}
+
+func TestMessageStringRedactsSecrets(t *testing.T) {
+	msg := &Message{
+		BuildSecretID: "token",
+		BuildSecret:   []byte("ghp_123"),
+	}
+	assert.NotContains(t, msg.String(), "ghp_123")
+	assert.NotContains(t, msg.String(), "Z2hwXzEyMw")
+	assert.Equal(t, "ghp_123", string(msg.BuildSecret))
+}
@@ -0,0 +1,212 @@
+package buildclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/google/uuid"
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/sshforward"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// NewSSHProvider returns the session attachable that forwards the SSH agents of the client to a build. The bytes of
+// each connection of the build to an agent are relayed through the messages to the agent socket of the client.
+func NewSSHProvider(messages Messages, ids []string) session.Attachable {
+	forwarder := &sshForwarder{
+		messages: messages,
+		ids:      map[string]bool{},
+	}
+	for _, id := range ids {
+		forwarder.ids[id] = true
+	}
+	return forwarder
+}
+
+type sshForwarder struct {
+	messages Messages
+	ids      map[string]bool
+}
+
+func (s *sshForwarder) Register(server *grpc.Server) {
+	sshforward.RegisterSSHServer(server, s)
+}
+
+func (s *sshForwarder) CheckAgent(_ context.Context, req *sshforward.CheckAgentRequest) (*sshforward.CheckAgentResponse, error) {
+	id := req.ID
+	if id == "" {
+		id = sshforward.DefaultID
+	}
+	if !s.ids[id] {
+		return nil, fmt.Errorf("ssh agent [%s] is not in the ssh agents of the build", id)
+	}
+	return &sshforward.CheckAgentResponse{}, nil
+}
+
+func (s *sshForwarder) ForwardAgent(stream sshforward.SSH_ForwardAgentServer) error {
+	id := sshforward.DefaultID
+	opts, _ := metadata.FromIncomingContext(stream.Context())
+	if v := opts[sshforward.KeySSHID]; len(v) > 0 && v[0] != "" {
+		id = v[0]
+	}
+	if !s.ids[id] {
+		return fmt.Errorf("ssh agent [%s] is not in the ssh agents of the build", id)
+	}
+
+	sessionID := uuid.New().String()
+	logrus.Tracef("Starting ssh forwarding [%s] for agent [%s]", sessionID, id)
+
+	msgs, cancel := s.messages.Recv()
+	defer cancel()
+
+	if err := s.messages.Send(&Message{
+		SSHSessionID: sessionID,
+		SSHID:        id,
+	}); err != nil {
+		return err
+	}
+	defer func() {
+		_ = s.messages.Send(&Message{
+			SSHSessionID:    sessionID,
+			SSHSessionClose: true,
+		})
+	}()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			data, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				recvErr <- nil
+				return
+			} else if err != nil {
+				recvErr <- err
+				return
+			}
+			if err := s.messages.Send(&Message{
+				SSHSessionID: sessionID,
+				SSHData:      data.Data,
+			}); err != nil {
+				recvErr <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case err := <-recvErr:
+			return err
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case msg, ok := <-msgs:
+			if !ok {
+				return fmt.Errorf("connection closed forwarding ssh agent [%s]", id)
+			}
+			if msg.SSHSessionID != sessionID {
+				continue
+			}
+			if msg.SSHSessionClose {
+				return nil
+			}
+			if err := stream.Send(&sshforward.BytesMessage{Data: msg.SSHData}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sshAgents relays the connections of the builds to the SSH agents of the client
+type sshAgents struct {
+	messages Messages
+	sockets  map[string]string
+	conns    map[string]net.Conn
+}
+
+func newSSHAgents(messages Messages, secrets *BuildSecrets) *sshAgents {
+	agents := &sshAgents{
+		messages: messages,
+		conns:    map[string]net.Conn{},
+	}
+	if secrets != nil {
+		agents.sockets = secrets.SSH
+	}
+	return agents
+}
+
+func (s *sshAgents) handle(msg *Message) {
+	conn, ok := s.conns[msg.SSHSessionID]
+	if msg.SSHSessionClose {
+		if ok {
+			conn.Close()
+			delete(s.conns, msg.SSHSessionID)
+		}
+		return
+	}
+
+	if !ok {
+		var err error
+		conn, err = s.dial(msg.SSHID)
+		if err != nil {
+			logrus.Warnf("Failed to forward ssh agent [%s] to build: %v", msg.SSHID, err)
+			s.close(msg.SSHSessionID)
+			return
+		}
+		s.conns[msg.SSHSessionID] = conn
+		go s.relay(msg.SSHSessionID, conn)
+	}
+
+	if len(msg.SSHData) > 0 {
+		if _, err := conn.Write(msg.SSHData); err != nil {
+			logrus.Warnf("Failed to write to ssh agent [%s]: %v", msg.SSHID, err)
+			s.close(msg.SSHSessionID)
+		}
+	}
+}
+
+func (s *sshAgents) dial(id string) (net.Conn, error) {
+	socket, ok := s.sockets[id]
+	if !ok {
+		return nil, fmt.Errorf("ssh agent is not enabled, use --ssh %s", id)
+	}
+	return net.Dial("unix", socket)
+}
+
+// relay sends the responses of the agent to the build until the agent closes the connection
+func (s *sshAgents) relay(sessionID string, conn net.Conn) {
+	defer s.close(sessionID)
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			if err := s.messages.Send(&Message{
+				SSHSessionID: sessionID,
+				SSHData:      append([]byte(nil), buf[:n]...),
+			}); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *sshAgents) close(sessionID string) {
+	_ = s.messages.Send(&Message{
+		SSHSessionID:    sessionID,
+		SSHSessionClose: true,
+	})
+}
+
+func (s *sshAgents) Close() {
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+}
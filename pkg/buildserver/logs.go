@@ -0,0 +1,179 @@
+package buildserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/buildclient"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
+	buildkit "github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/util/progress/progressui"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxBuildLogSize is how much of the output of a build is kept, the end of the output is kept if it is longer
+	maxBuildLogSize = 1 << 20
+	buildLogsKey    = "logs.gz"
+)
+
+// BuildLogsSecretName is the name of the secret the logs of the build are stored in
+func BuildLogsSecretName(buildName string) string {
+	return name.SafeHashConcatName(buildName, "logs")
+}
+
+// logMessages records the progress of the build sent to the client as plain text, like the client displays it when
+// its output is not a terminal
+type logMessages struct {
+	buildclient.Messages
+
+	lock   sync.Mutex
+	closed bool
+	status chan *buildkit.SolveStatus
+	done   chan struct{}
+	output *tailWriter
+}
+
+func newLogMessages(messages buildclient.Messages) *logMessages {
+	l := &logMessages{
+		Messages: messages,
+		status:   make(chan *buildkit.SolveStatus, 10),
+		done:     make(chan struct{}),
+		output:   &tailWriter{max: maxBuildLogSize},
+	}
+	go func() {
+		defer close(l.done)
+		_, _ = progressui.DisplaySolveStatus(context.Background(), "", nil, l.output, l.status)
+	}()
+	return l
+}
+
+func (l *logMessages) Send(msg *buildclient.Message) error {
+	if msg.Status != nil {
+		l.lock.Lock()
+		if !l.closed {
+			l.status <- msg.Status
+		}
+		l.lock.Unlock()
+	}
+	return l.Messages.Send(msg)
+}
+
+// Logs stops recording and returns the recorded output, followed by the error the build failed with, if any
+func (l *logMessages) Logs(buildErr error) []byte {
+	l.lock.Lock()
+	if !l.closed {
+		l.closed = true
+		close(l.status)
+	}
+	l.lock.Unlock()
+	<-l.done
+
+	if buildErr != nil {
+		_, _ = fmt.Fprintf(l.output, "ERROR: %v\n", buildErr)
+	}
+	return l.output.Bytes()
+}
+
+// tailWriter keeps the last max bytes written to it
+type tailWriter struct {
+	max       int
+	buf       []byte
+	truncated bool
+}
+
+func (t *tailWriter) Write(p []byte) (int, error) {
+	t.buf = append(t.buf, p...)
+	if over := len(t.buf) - t.max; over > 0 {
+		t.buf = append(t.buf[:0], t.buf[over:]...)
+		t.truncated = true
+	}
+	return len(p), nil
+}
+
+// Bytes returns the kept output, starting at a line boundary if the beginning of the output was dropped
+func (t *tailWriter) Bytes() []byte {
+	if !t.truncated {
+		return t.buf
+	}
+	data := t.buf
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		data = data[i+1:]
+	}
+	return append([]byte("[output truncated]\n"), data...)
+}
+
+// recordBuildLogs stores the compressed logs of the build in a secret owned by the recorded build, if the build is
+// recorded
+func (s *Server) recordBuildLogs(ctx context.Context, build *v1.AcornImageBuildInstance, logs []byte) error {
+	recordedBuild := &v1.AcornImageBuildInstance{}
+	err := s.client.Get(ctx, kclient.ObjectKeyFromObject(build), recordedBuild)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(logs); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return s.client.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BuildLogsSecretName(recordedBuild.Name),
+			Namespace: recordedBuild.Namespace,
+			Labels: map[string]string{
+				labels.AcornManaged: "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         v1.SchemeGroupVersion.String(),
+				Kind:               "AcornImageBuildInstance",
+				Name:               recordedBuild.Name,
+				UID:                recordedBuild.UID,
+				BlockOwnerDeletion: z.Pointer(false),
+			}},
+		},
+		Type: apiv1.SecretTypeBuildLogs,
+		Data: map[string][]byte{
+			buildLogsKey: buf.Bytes(),
+		},
+	})
+}
+
+// GetBuildLogs returns the logs of the build, or an empty result if no logs were recorded
+func GetBuildLogs(ctx context.Context, c kclient.Reader, build *v1.AcornImageBuildInstance) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, router.Key(build.Namespace, BuildLogsSecretName(build.Name)), secret); apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(secret.OwnerReferences) == 0 || secret.OwnerReferences[0].UID != build.UID {
+		// The logs of a previous build with the same name
+		return nil, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(secret.Data[buildLogsKey]))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
@@ -0,0 +1,53 @@
+package buildserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestTailWriter(t *testing.T) {
+	w := &tailWriter{max: 16}
+	_, _ = w.Write([]byte("line1\n"))
+	assert.Equal(t, "line1\n", string(w.Bytes()))
+
+	_, _ = w.Write([]byte("line2\nline3\nline4\n"))
+	assert.Equal(t, "[output truncated]\nline3\nline4\n", string(w.Bytes()))
+}
+
+func TestRecordBuildLogs(t *testing.T) {
+	build := &v1.AcornImageBuildInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bld-test",
+			Namespace: "acorn",
+			UID:       "1234",
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(build).Build()
+	s := &Server{client: c}
+
+	logs := strings.Repeat("#1 [internal] load build definition from Acornfile\n", 100)
+	require.NoError(t, s.recordBuildLogs(context.Background(), build, []byte(logs)))
+
+	result, err := GetBuildLogs(context.Background(), c, build)
+	require.NoError(t, err)
+	assert.Equal(t, logs, string(result))
+
+	// The logs of a previous build with the same name are not returned
+	result, err = GetBuildLogs(context.Background(), c, &v1.AcornImageBuildInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bld-test",
+			Namespace: "acorn",
+			UID:       "5678",
+		},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
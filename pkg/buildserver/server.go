@@ -15,6 +15,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/k8schannel"
 	"github.com/acorn-io/runtime/pkg/pullsecret"
+	"github.com/acorn-io/z"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -126,7 +127,11 @@ func (s *Server) build(ctx context.Context, messages buildclient.Messages, token
 	if err != nil {
 		return nil, err
 	}
-	image, err := build.Build(ctx, messages, token.PushRepo, token.Build.Namespace, token.Build.Spec, keychain)
+	logs := newLogMessages(messages)
+	image, err := build.Build(ctx, logs, token.PushRepo, token.Build.Namespace, token.Build.Spec, keychain)
+	if logErr := s.recordBuildLogs(ctx, &token.Build, logs.Logs(err)); logErr != nil {
+		logrus.Warnf("Failed to record the logs of build [%s/%s]: %v", token.Build.Namespace, token.Build.Name, logErr)
+	}
 	if err != nil {
 		_ = s.recordBuildError(ctx, &token.Build, err)
 		return nil, err
@@ -150,6 +155,7 @@ func (s *Server) recordBuildStart(ctx context.Context, build *v1.AcornImageBuild
 	}
 
 	condition.Setter(recordedBuild, nil, v1.AcornImageBuildInstanceConditionBuild).Unknown("Building")
+	recordedBuild.Status.StartTime = z.Pointer(metav1.Now())
 	recordedBuild.Status.ObservedGeneration = build.Generation
 	return s.client.Status().Update(ctx, recordedBuild)
 }
@@ -164,6 +170,7 @@ func (s *Server) recordBuildError(ctx context.Context, build *v1.AcornImageBuild
 	}
 
 	recordedBuild.Status.BuildError = buildError.Error()
+	recordedBuild.Status.CompletionTime = z.Pointer(metav1.Now())
 	condition.Setter(recordedBuild, nil, v1.AcornImageBuildInstanceConditionBuild).Error(buildError)
 	recordedBuild.Status.ObservedGeneration = build.Generation
 	return s.client.Status().Update(ctx, recordedBuild)
@@ -207,6 +214,7 @@ func (s *Server) recordBuild(ctx context.Context, recordRepo string, build *v1.A
 
 	condition.Setter(recordedBuild, nil, v1.AcornImageBuildInstanceConditionBuild).Success()
 	recordedBuild.Status.AppImage = *image
+	recordedBuild.Status.CompletionTime = z.Pointer(metav1.Now())
 	recordedBuild.Status.ObservedGeneration = build.Generation
 	if err := s.client.Status().Update(ctx, recordedBuild); err != nil {
 		return err
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/acorn-io/runtime/pkg/acme"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewACMESolver(c CommandContext) *cobra.Command {
+	return cli.Command(&ACMESolver{}, cobra.Command{
+		Use:          "acme-solver",
+		Hidden:       true,
+		SilenceUsage: true,
+		Short:        "Answer ACME HTTP-01 challenges for custom domains",
+		Args:         cobra.NoArgs,
+	})
+}
+
+type ACMESolver struct {
+	Thumbprint string `usage:"Thumbprint of the ACME account key"`
+	ListenPort int    `usage:"HTTP listen port" default:"8080"`
+}
+
+func (s *ACMESolver) Run(cmd *cobra.Command, _ []string) error {
+	if s.Thumbprint == "" {
+		return fmt.Errorf("--thumbprint is required")
+	}
+
+	address := fmt.Sprintf("0.0.0.0:%d", s.ListenPort)
+	logrus.Infof("Listening on %s", address)
+	return http.ListenAndServe(address, acme.SolverHandler(s.Thumbprint))
+}
@@ -33,8 +33,10 @@ func New() *cobra.Command {
 		StdIn:  nil,
 	}
 	root.AddCommand(
+		NewACMESolver(cmdContext),
 		NewAll(cmdContext),
 		NewApiServer(cmdContext),
+		NewAuthProxy(cmdContext),
 		NewBuild(cmdContext),
 		NewBuildServer(cmdContext),
 		NewCheck(cmdContext),
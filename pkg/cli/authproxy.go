@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/acorn-io/runtime/pkg/authproxy"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewAuthProxy(c CommandContext) *cobra.Command {
+	return cli.Command(&AuthProxy{}, cobra.Command{
+		Use:          "auth-proxy",
+		Hidden:       true,
+		SilenceUsage: true,
+		Short:        "Run the auth proxy in front of a published endpoint",
+		Args:         cobra.NoArgs,
+	})
+}
+
+type AuthProxy struct {
+	Type       string `usage:"Auth type, basic or oidc"`
+	Upstream   string `usage:"URL to proxy authenticated requests to"`
+	SecretDir  string `usage:"Directory the keys of the auth secret are mounted in" default:"/run/secrets/acorn-auth"`
+	Name       string `usage:"Name of the endpoint, sessions are only valid for proxies with the same name"`
+	ListenPort int    `usage:"HTTP listen port" default:"8080"`
+}
+
+func (s *AuthProxy) Run(cmd *cobra.Command, _ []string) error {
+	handler, err := authproxy.New(authproxy.Options{
+		Type:      s.Type,
+		Upstream:  s.Upstream,
+		SecretDir: s.SecretDir,
+		Name:      s.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	address := fmt.Sprintf("0.0.0.0:%d", s.ListenPort)
+	logrus.Infof("Listening on %s, proxying to %s", address, s.Upstream)
+	return http.ListenAndServe(address, handler)
+}
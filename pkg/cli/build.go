@@ -18,22 +18,43 @@ func NewBuild(c CommandContext) *cobra.Command {
 		Use: "build [flags] DIRECTORY",
 		Example: `
 # Build from Acornfile file in the local directory
-acorn build .`,
+acorn build .
+
+# Build with a secret from a file and the local SSH agent
+acorn build --secret id=npmrc,src=$HOME/.npmrc --ssh default .
+
+# Build with the cache of a registry repository and update it
+acorn build --cache-from ghcr.io/myorg/cache --cache-to ghcr.io/myorg/cache .
+
+# Build with SBOMs and provenance attached to the image
+acorn build --sbom --provenance -t ghcr.io/myorg/app:v1.0 .
+
+# Build the v1.0 tag of the git repository without the uncommitted changes
+acorn build --git-ref v1.0 -t ghcr.io/myorg/app:v1.0 .`,
 		SilenceUsage: true,
 		Short:        "Build an app from a Acornfile file",
 		Long:         "Build all dependent container and app images from your Acornfile file",
 	})
 	cmd.Flags().SetInterspersed(false)
+	cmd.AddCommand(NewBuildList(c))
+	cmd.AddCommand(NewBuildLogs(c))
 	return cmd
 }
 
 type Build struct {
-	Push     bool     `usage:"Push image after build"`
-	File     string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
-	Tag      []string `short:"t" usage:"Apply a tag to the final build"`
-	Platform []string `short:"p" usage:"Target platforms (form os/arch[/variant][:osversion] example linux/amd64)"`
-	Profile  []string `usage:"Profile to assign default values"`
-	client   ClientFactory
+	Push       bool     `usage:"Push image after build" local:"true"`
+	File       string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")" local:"true"`
+	Tag        []string `short:"t" usage:"Apply a tag to the final build" local:"true"`
+	Platform   []string `short:"p" usage:"Target platforms (form os/arch[/variant][:osversion] example linux/amd64)" local:"true"`
+	Profile    []string `usage:"Profile to assign default values" local:"true"`
+	Secret     []string `usage:"Secret to provide to the builds, or only to the build of the container, job, sidecar or image named BUILD (form [BUILD:]id=ID[,src=FILE|env=VAR] example web:id=npmrc,src=$HOME/.npmrc)" local:"true"`
+	SSH        []string `usage:"SSH agent to forward to the builds, or only to the build of the container, job, sidecar or image named BUILD (form [BUILD:]default|[BUILD:]ID[=SOCKET])" local:"true"`
+	CacheFrom  []string `usage:"Registry repository to import the build cache from (default the build cache of the project)" local:"true"`
+	CacheTo    []string `usage:"Registry repository to export the build cache to (default the build cache of the project)" local:"true"`
+	SBOM       bool     `usage:"Attach an SPDX SBOM of each container image to the image" local:"true"`
+	Provenance bool     `usage:"Attach an in-toto provenance statement of the build to the image" local:"true"`
+	GitRef     string   `usage:"Build a clean checkout of a git ref (branch, tag, or commit) of the repository of DIRECTORY instead of its contents" local:"true"`
+	client     ClientFactory
 }
 
 func (s *Build) Run(cmd *cobra.Command, args []string) error {
@@ -58,6 +79,13 @@ func (s *Build) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	helper := imagesource.NewImageSource(s.File, args, s.Profile, s.Platform, false)
+	helper.Secrets = s.Secret
+	helper.SSH = s.SSH
+	helper.CacheFrom = s.CacheFrom
+	helper.CacheTo = s.CacheTo
+	helper.SBOM = s.SBOM
+	helper.Provenance = s.Provenance
+	helper.GitRef = s.GitRef
 
 	image, _, err := helper.GetImageAndDeployArgs(cmd.Context(), c)
 	if err != nil {
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewBuildLogs(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&BuildLogs{client: c.ClientFactory}, cobra.Command{
+		Use: "logs [flags] BUILD_NAME",
+		Example: `
+acorn build logs bld-abc12`,
+		SilenceUsage:      true,
+		Short:             "Show the output of a recorded build",
+		Long:              "Show the output of a recorded build, like it was displayed when the build ran. If the output was too long, only its end is kept.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, buildsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	return cmd
+}
+
+type BuildLogs struct {
+	client ClientFactory
+}
+
+func (a *BuildLogs) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	logs, err := c.AcornImageBuildLogs(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	if len(logs.Logs) == 0 {
+		return fmt.Errorf("no logs were recorded for build %s", args[0])
+	}
+
+	_, err = os.Stdout.Write(logs.Logs)
+	return err
+}
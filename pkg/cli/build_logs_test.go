@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildLogs(t *testing.T) {
+	type args struct {
+		cmd    *cobra.Command
+		args   []string
+		client *testdata.MockClient
+	}
+	var _, w, _ = os.Pipe()
+	tests := []struct {
+		name           string
+		args           args
+		wantErr        bool
+		wantOut        string
+		commandContext CommandContext
+	}{
+		{
+			name: "acorn build logs bld-found",
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"logs", "bld-found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "#1 [internal] load build definition from Dockerfile\nERROR: failed to solve\n",
+		},
+		{
+			name: "acorn build logs dne",
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"logs", "dne"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "error: build dne does not exist",
+		},
+		{
+			name: "acorn build ls -q",
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"ls", "-q"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "bld-found\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			tt.args.cmd = NewBuild(tt.commandContext)
+			tt.args.cmd.SetArgs(tt.args.args)
+			err := tt.args.cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
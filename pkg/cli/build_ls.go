@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"sort"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewBuildList(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&BuildList{client: c.ClientFactory}, cobra.Command{
+		Use:     "ls [flags]",
+		Aliases: []string{"list"},
+		Example: `
+acorn build ls`,
+		SilenceUsage: true,
+		Short:        "List the recorded builds",
+		Long:         "List the recorded builds of the project with their result, duration, the user that started them and the revision they were built from. Builds are only recorded if the record-builds setting is enabled.",
+		Args:         cobra.NoArgs,
+	})
+	return cmd
+}
+
+type BuildList struct {
+	Quiet  bool   `usage:"Output only names" short:"q" local:"true"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o" local:"true"`
+	client ClientFactory
+}
+
+func (a *BuildList) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	builds, err := c.AcornImageBuildList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	// Newest first
+	sort.SliceStable(builds, func(i, j int) bool {
+		return builds[j].CreationTimestamp.Before(&builds[i].CreationTimestamp)
+	})
+
+	out := table.NewWriter(tables.Build, a.Quiet, a.Output)
+	for i := range builds {
+		out.Write(&builds[i])
+	}
+
+	return out.Err()
+}
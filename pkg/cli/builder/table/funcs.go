@@ -8,6 +8,7 @@ import (
 	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/tags"
@@ -43,6 +44,9 @@ var (
 		"ownerName":     OwnerReferenceName,
 		"imageName":     ImageName,
 		"imageCommit":   ImageCommit,
+		"buildResult":   BuildResult,
+		"buildDuration": BuildDuration,
+		"buildRevision": BuildRevision,
 	}
 )
 
@@ -324,3 +328,49 @@ func ImageCommit(obj metav1.Object) string {
 
 	return app.Status.AppImage.VCS.Revision
 }
+
+func BuildResult(obj metav1.Object) string {
+	build, ok := obj.(*apiv1.AcornImageBuild)
+	if !ok {
+		return ""
+	}
+
+	for _, cond := range build.Status.Conditions {
+		if cond.Type != v1.AcornImageBuildInstanceConditionBuild {
+			continue
+		}
+		if cond.Success {
+			return "Succeeded"
+		} else if cond.Error {
+			return "Failed"
+		}
+		return "Building"
+	}
+	return "Pending"
+}
+
+func BuildDuration(obj metav1.Object) string {
+	build, ok := obj.(*apiv1.AcornImageBuild)
+	if !ok || build.Status.StartTime == nil {
+		return ""
+	}
+
+	end := time.Now()
+	if build.Status.CompletionTime != nil {
+		end = build.Status.CompletionTime.Time
+	}
+	return duration.HumanDuration(end.Sub(build.Status.StartTime.Time))
+}
+
+func BuildRevision(obj metav1.Object) string {
+	build, ok := obj.(*apiv1.AcornImageBuild)
+	if !ok || build.Spec.VCS.Revision == "" {
+		return ""
+	}
+
+	revision := Trunc(build.Spec.VCS.Revision)
+	if build.Spec.VCS.Modified || build.Spec.VCS.Untracked {
+		revision += "-dirty"
+	}
+	return revision
+}
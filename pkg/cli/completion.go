@@ -224,6 +224,22 @@ func credentialsCompletion(ctx context.Context, c client.Client, toComplete stri
 	return result, nil
 }
 
+func buildsCompletion(ctx context.Context, c client.Client, toComplete string) ([]string, error) {
+	builds, err := c.AcornImageBuildList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, build := range builds {
+		if strings.HasPrefix(build.Name, toComplete) {
+			result = append(result, build.Name)
+		}
+	}
+
+	return result, nil
+}
+
 func volumesCompletion(ctx context.Context, c client.Client, toComplete string) ([]string, error) {
 	volumes, err := c.VolumeList(ctx)
 	if err != nil {
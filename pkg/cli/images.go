@@ -30,6 +30,11 @@ acorn images`,
 	cmd.AddCommand(NewImageDelete(c))
 	cmd.AddCommand(NewImageDetails(c))
 	cmd.AddCommand(NewImageCopy(c))
+	cmd.AddCommand(NewImageSave(c))
+	cmd.AddCommand(NewImageLoad(c))
+	cmd.AddCommand(NewImageSign(c))
+	cmd.AddCommand(NewImageVerify(c))
+	cmd.AddCommand(NewImagePrune(c))
 	return cmd
 }
 
@@ -11,8 +11,11 @@ import (
 
 func NewImageDetails(c CommandContext) *cobra.Command {
 	cmd := cli.Command(&ImageDetails{client: c.ClientFactory}, cobra.Command{
-		Use:               "details IMAGE_NAME [NESTED DIGEST]",
-		Example:           `acorn image details my-image`,
+		Use: "details IMAGE_NAME [NESTED DIGEST]",
+		Example: `acorn image details my-image
+
+# Show the SBOMs and provenance attached to the image
+acorn image details --sbom my-image`,
 		Aliases:           []string{"detail"},
 		SilenceUsage:      true,
 		Short:             "Show details of an Image",
@@ -25,6 +28,7 @@ func NewImageDetails(c CommandContext) *cobra.Command {
 type ImageDetails struct {
 	client ClientFactory
 	Output string `usage:"Output format (json, yaml, aml)" short:"o" local:"true" default:"yaml"`
+	SBOM   bool   `usage:"Show the SBOMs and provenance attached to the image instead of its details" local:"true"`
 }
 
 func (a *ImageDetails) Run(cmd *cobra.Command, args []string) error {
@@ -59,15 +63,20 @@ func (a *ImageDetails) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	image, err := c.ImageDetails(cmd.Context(), args[0], &client.ImageDetailsOptions{
-		NestedDigest: nested,
-		Auth:         auth,
+		NestedDigest:        nested,
+		Auth:                auth,
+		IncludeAttestations: a.SBOM,
 	})
 	if err != nil {
 		return err
 	}
 
 	w := table.NewWriter(nil, false, a.Output)
-	w.WriteFormatted(image.AppImage, nil)
+	if a.SBOM {
+		w.WriteFormatted(image.Attestations, nil)
+	} else {
+		w.WriteFormatted(image.AppImage, nil)
+	}
 
 	return w.Close()
 }
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"os"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/credentials"
+	"github.com/acorn-io/runtime/pkg/progressbar"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spf13/cobra"
+)
+
+func NewImageLoad(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageLoad{client: c.ClientFactory}, cobra.Command{
+		Use: "load FILE",
+		Example: `# Load an image saved with acorn image save, it is tagged with the name it was saved with
+acorn image load app.tar
+
+# Load it with another tag
+acorn image load app.tar -t app:v1
+
+# Push it to a registry instead
+acorn image load app.tar --target registry.local/myorg/app:v1`,
+		SilenceUsage: true,
+		Short:        "Load an Image from an OCI image layout tarball",
+		Args:         cobra.ExactArgs(1),
+	})
+	return cmd
+}
+
+type ImageLoad struct {
+	client ClientFactory
+	Tag    string `usage:"Tag to give the loaded image, by default the name it was saved with" short:"t" local:"true"`
+	Target string `usage:"Tag in a registry to push the image to, instead of loading it into the internal registry" local:"true"`
+}
+
+func (a *ImageLoad) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	opts := &client.ImageLoadOptions{
+		Tag:    a.Tag,
+		Target: a.Target,
+	}
+
+	if a.Target != "" {
+		ref, err := name.ParseReference(a.Target)
+		if err != nil {
+			return err
+		}
+
+		cfg, err := a.client.Options().CLIConfig()
+		if err != nil {
+			return err
+		}
+
+		creds, err := credentials.NewStore(cfg, c)
+		if err != nil {
+			return err
+		}
+
+		opts.Auth, _, err = creds.Get(cmd.Context(), ref.Context().RegistryStr())
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	progress, err := c.ImageLoad(cmd.Context(), f, opts)
+	if err != nil {
+		return err
+	}
+
+	return progressbar.Print(progress)
+}
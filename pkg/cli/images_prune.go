@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/spf13/cobra"
+)
+
+func NewImagePrune(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImagePrune{client: c.ClientFactory}, cobra.Command{
+		Use: "prune",
+		Example: `# Delete the untagged images that no app uses
+acorn image prune
+
+# Delete all images that no app uses and that were created more than a week ago
+acorn image prune --all --older-than 168h
+
+# Show the images that would be deleted
+acorn image prune --dry-run`,
+		SilenceUsage: true,
+		Short:        "Delete unused images",
+		Long:         "Delete the untagged images, or with --all every image, that no app in the project uses from the internal registry.",
+		Args:         cobra.NoArgs,
+	})
+	return cmd
+}
+
+type ImagePrune struct {
+	client    ClientFactory
+	All       bool   `usage:"Delete tagged images too, not only untagged images" short:"a" local:"true"`
+	OlderThan string `usage:"Only delete images created longer ago than this duration (e.g. 24h)" local:"true"`
+	DryRun    bool   `usage:"Only show the images that would be deleted" local:"true"`
+}
+
+func (a *ImagePrune) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	result, err := c.ImagePrune(cmd.Context(), &client.ImagePruneOptions{
+		All:       a.All,
+		OlderThan: a.OlderThan,
+		DryRun:    a.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	deleted, reclaimed := "Deleted", "Reclaimed"
+	if a.DryRun {
+		deleted, reclaimed = "Would delete", "Would reclaim"
+	}
+
+	for _, image := range result.Images {
+		if len(image.Tags) == 0 {
+			fmt.Printf("%s: %s\n", deleted, image.Digest)
+		} else {
+			fmt.Printf("%s: %s (%s)\n", deleted, strings.Join(image.Tags, ", "), image.Digest)
+		}
+	}
+	fmt.Printf("%s: %s\n", reclaimed, images.FormatBytes(result.ReclaimedBytes))
+	return nil
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagePrune(t *testing.T) {
+	type args struct {
+		cmd    *cobra.Command
+		args   []string
+		client *testdata.MockClient
+	}
+	var _, w, _ = os.Pipe()
+	tests := []struct {
+		name           string
+		args           args
+		wantErr        bool
+		wantOut        string
+		commandContext CommandContext
+	}{
+		{
+			name: "acorn image prune",
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "Deleted: sha256:1234567890123456789012345678901234567890123456789012345678901234\nReclaimed: 1.5 KiB\n",
+		},
+		{
+			name: "acorn image prune --all --dry-run",
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--all", "--dry-run"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "Would delete: sha256:1234567890123456789012345678901234567890123456789012345678901234\n" +
+				"Would delete: testtag:latest (sha256:4321567890123456789012345678901234567890123456789012345678901234)\n" +
+				"Would reclaim: 1.5 KiB\n",
+		},
+		{
+			name: "acorn image prune IMAGE",
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "unknown command \"found\" for \"prune\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			tt.args.cmd = NewImagePrune(tt.commandContext)
+			tt.args.cmd.SetArgs(tt.args.args)
+			err := tt.args.cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
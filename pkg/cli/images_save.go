@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/credentials"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spf13/cobra"
+)
+
+func NewImageSave(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageSave{client: c.ClientFactory}, cobra.Command{
+		Use: "save IMAGE_NAME -o FILE",
+		Example: `# Save the image and all images in it to a tarball
+acorn image save ghcr.io/myorg/app:v1 -o app.tar`,
+		SilenceUsage:      true,
+		Short:             "Save an Image to an OCI image layout tarball",
+		Long:              "Save an Image, with the images of all its containers and nested Acorns for all platforms, to an OCI image layout tarball that can be loaded with acorn image load",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).complete,
+		Args:              cobra.ExactArgs(1),
+	})
+	return cmd
+}
+
+type ImageSave struct {
+	client ClientFactory
+	Output string `usage:"File to write the tarball to" short:"o" local:"true"`
+}
+
+func (a *ImageSave) Run(cmd *cobra.Command, args []string) error {
+	if a.Output == "" {
+		return fmt.Errorf("--output is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	ref, err := name.ParseReference(args[0])
+	if err != nil {
+		return err
+	}
+
+	cfg, err := a.client.Options().CLIConfig()
+	if err != nil {
+		return err
+	}
+
+	creds, err := credentials.NewStore(cfg, c)
+	if err != nil {
+		return err
+	}
+
+	auth, _, err := creds.Get(cmd.Context(), ref.Context().RegistryStr())
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(a.Output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := c.ImageSave(cmd.Context(), args[0], f, &client.ImageSaveOptions{
+		Auth: auth,
+	}); err != nil {
+		_ = os.Remove(a.Output)
+		return err
+	}
+
+	return f.Close()
+}
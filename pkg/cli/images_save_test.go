@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageSaveLoad(t *testing.T) {
+	_, w, _ := os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+	archive := filepath.Join(t.TempDir(), "app.tar")
+
+	cmd := NewImageSave(commandContext)
+	cmd.SetArgs([]string{"found", "-o", archive})
+	require.NoError(t, cmd.Execute())
+
+	data, err := os.ReadFile(archive)
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(data))
+
+	cmd = NewImageLoad(commandContext)
+	cmd.SetArgs([]string{archive})
+	require.NoError(t, cmd.Execute())
+
+	// a failed save doesn't leave a partial tarball behind
+	cmd = NewImageSave(commandContext)
+	cmd.SetArgs([]string{"dne", "-o", archive})
+	assert.EqualError(t, cmd.Execute(), "error: image dne does not exist")
+	assert.NoFileExists(t, archive)
+}
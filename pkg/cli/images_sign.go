@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/credentials"
+	"github.com/google/go-containerregistry/pkg/name"
+	ocosign "github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretKeyPrefix      = "secret://"
+	defaultSecretKey     = "cosign.key"
+	secretPasswordKey    = "cosign.password"
+	cosignPasswordEnvVar = "COSIGN_PASSWORD"
+)
+
+func NewImageSign(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageSign{client: c.ClientFactory}, cobra.Command{
+		Use: "sign IMAGE_NAME --key KEY",
+		Example: `# Sign the image and the images in it with a cosign key
+acorn image sign my-image --key ./cosign.key
+
+# Sign with the key stored under cosign.key in the Acorn secret my-signing-key
+acorn image sign my-image --key secret://my-signing-key
+
+# Add annotations to the signatures
+acorn image sign my-image --key ./cosign.key -a tag=ok`,
+		SilenceUsage:      true,
+		Short:             "Sign an Image",
+		Long:              "Sign an Image and all images in it, including the images of its containers and nested Acorns, with a cosign private key. The password of the key is read from COSIGN_PASSWORD, the cosign.password key of the secret, or prompted for.",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).complete,
+		Args:              cobra.ExactArgs(1),
+	})
+	return cmd
+}
+
+type ImageSign struct {
+	client     ClientFactory
+	Key        string   `usage:"Private key to sign with, a file or secret://NAME[/KEY] for a key in an Acorn secret" short:"k" local:"true"`
+	Annotation []string `usage:"Annotations to add to the signatures (format key=value)" short:"a" local:"true"`
+}
+
+func (a *ImageSign) Run(cmd *cobra.Command, args []string) error {
+	if a.Key == "" {
+		return fmt.Errorf("--key is required")
+	}
+
+	annotations, err := parseSignatureAnnotations(a.Annotation)
+	if err != nil {
+		return err
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	key, password, err := loadPrivateKey(cmd.Context(), c, a.Key)
+	if err != nil {
+		return err
+	}
+
+	signer, err := ocosign.LoadPrivateKey(key, password)
+	if err != nil {
+		return fmt.Errorf("failed to load private key %s: %w", a.Key, err)
+	}
+
+	ref, err := name.ParseReference(args[0])
+	if err != nil {
+		return err
+	}
+
+	cfg, err := a.client.Options().CLIConfig()
+	if err != nil {
+		return err
+	}
+
+	creds, err := credentials.NewStore(cfg, c)
+	if err != nil {
+		return err
+	}
+
+	auth, _, err := creds.Get(cmd.Context(), ref.Context().RegistryStr())
+	if err != nil {
+		return err
+	}
+
+	result, err := c.ImageSign(cmd.Context(), args[0], signer, &client.ImageSignOptions{
+		Auth:        auth,
+		Annotations: annotations,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, sig := range result.Signatures {
+		fmt.Println(sig.Digest)
+	}
+	return nil
+}
+
+// loadPrivateKey returns the private key and its password from a file or an Acorn secret
+func loadPrivateKey(ctx context.Context, c client.Client, keyRef string) (key, password []byte, _ error) {
+	if secretRef, ok := strings.CutPrefix(keyRef, secretKeyPrefix); ok {
+		secretName, dataKey, _ := strings.Cut(secretRef, "/")
+		if dataKey == "" {
+			dataKey = defaultSecretKey
+		}
+
+		secret, err := c.SecretReveal(ctx, secretName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key, ok = secret.Data[dataKey]
+		if !ok {
+			return nil, nil, fmt.Errorf("secret %s has no key %s", secretName, dataKey)
+		}
+		password, ok = secret.Data[secretPasswordKey]
+		if ok {
+			return key, password, nil
+		}
+	} else {
+		var err error
+		key, err = os.ReadFile(keyRef)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if pw, ok := os.LookupEnv(cosignPasswordEnvVar); ok {
+		return key, []byte(pw), nil
+	}
+
+	var pw string
+	if err := survey.AskOne(&survey.Password{Message: "Password for the private key"}, &pw); err != nil {
+		return nil, nil, err
+	}
+	return key, []byte(pw), nil
+}
+
+func parseSignatureAnnotations(annotations []string) (map[string]string, error) {
+	if len(annotations) == 0 {
+		return nil, nil
+	}
+	result := map[string]string{}
+	for _, annotation := range annotations {
+		k, v, ok := strings.Cut(annotation, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid annotation %s, must be in the format key=value", annotation)
+		}
+		result[k] = v
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewImageVerify(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageVerify{client: c.ClientFactory}, cobra.Command{
+		Use: "verify IMAGE_NAME",
+		Example: `# Check the image against the ImageAllowRules of the project, like the controller does when the image is run
+acorn image verify my-image
+
+# Check that the image is signed with a key and the signature has the annotation tag=ok
+acorn image verify my-image --key ./cosign.pub -a tag=ok`,
+		SilenceUsage:      true,
+		Short:             "Verify an Image",
+		Long:              "Verify an Image. Without --key, the image is checked against the ImageAllowRules of the project exactly like the controller checks it before running it. The command fails if the image is not allowed.",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).complete,
+		Args:              cobra.ExactArgs(1),
+	})
+	return cmd
+}
+
+type ImageVerify struct {
+	client     ClientFactory
+	Key        string   `usage:"Public key the image must be signed with, a file, PEM or cosign key reference, instead of checking the ImageAllowRules" short:"k" local:"true"`
+	Annotation []string `usage:"Annotations the signature must have (format key=value), only with --key" short:"a" local:"true"`
+}
+
+func (a *ImageVerify) Run(cmd *cobra.Command, args []string) error {
+	annotations, err := parseSignatureAnnotations(a.Annotation)
+	if err != nil {
+		return err
+	}
+
+	key := a.Key
+	if key != "" {
+		if data, err := os.ReadFile(key); err == nil {
+			key = string(data)
+		}
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	result, err := c.ImageVerify(cmd.Context(), args[0], &client.ImageVerifyOptions{
+		Key: key,
+		Annotations: v1.SignatureAnnotations{
+			Match: annotations,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if !result.Verified {
+		return fmt.Errorf("%s", result.Reason)
+	}
+
+	fmt.Printf("%s@%s verified\n", args[0], result.Digest)
+	return nil
+}
@@ -29,6 +29,9 @@ acorn project`,
 	cmd.AddCommand(NewProjectRm(c))
 	cmd.AddCommand(NewProjectUse(c))
 	cmd.AddCommand(NewProjectUpdate(c))
+	cmd.AddCommand(NewProjectKeys(c))
+	cmd.AddCommand(NewProjectRotateKey(c))
+	cmd.AddCommand(NewProjectRetireKey(c))
 	return cmd
 }
 
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/project"
+	"github.com/spf13/cobra"
+)
+
+func NewProjectKeys(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ProjectKeys{client: c.ClientFactory}, cobra.Command{
+		Use: "keys [flags] PROJECT_NAME",
+		Example: `
+# List the encryption keys of a project and the objects holding data encrypted with them
+acorn project keys my-project`,
+		SilenceUsage:      true,
+		Short:             "List the encryption keys of a project",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, projectsCompletion).complete,
+	})
+	return cmd
+}
+
+type ProjectKeys struct {
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *ProjectKeys) Run(cmd *cobra.Command, args []string) error {
+	keys, err := project.Keys(cmd.Context(), a.client.Options(), args[0])
+	if err != nil {
+		return err
+	}
+	return writeProjectKeys(keys, a.Output)
+}
+
+type projectKeyEntry struct {
+	KeyID      string
+	Primary    bool
+	References string
+}
+
+func writeProjectKeys(keys *apiv1.ProjectKeys, output string) error {
+	out := table.NewWriter([][]string{
+		{"KEY ID", "KeyID"},
+		{"PRIMARY", "{{ boolToStar .Primary }}"},
+		{"REFERENCES", "References"},
+	}, false, output)
+
+	if output != "" {
+		// in non-table output, write all keys once instead of once per key
+		out.Write(keys)
+		return out.Err()
+	}
+
+	for _, key := range keys.Keys {
+		references := make([]string, 0, len(key.References))
+		for _, ref := range key.References {
+			references = append(references, ref.Kind+"/"+ref.Name)
+		}
+		out.WriteFormatted(&projectKeyEntry{
+			KeyID:      key.KeyID,
+			Primary:    key.Primary,
+			References: strings.Join(references, ","),
+		}, keys)
+	}
+
+	return out.Err()
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/project"
+	"github.com/spf13/cobra"
+)
+
+func NewProjectRetireKey(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ProjectRetireKey{client: c.ClientFactory}, cobra.Command{
+		Use: "retire-key [flags] PROJECT_NAME KEY_ID",
+		Example: `
+# Remove an encryption key that is no longer referenced from a project
+acorn project retire-key my-project KEY_ID`,
+		SilenceUsage: true,
+		Short:        "Retire an encryption key of a project",
+		Long:         "Remove an encryption key from a project. The primary key and keys that are still referenced by secrets, apps or images, as listed by \"acorn project keys\", can not be retired.",
+		Args:         cobra.ExactArgs(2),
+	})
+	return cmd
+}
+
+type ProjectRetireKey struct {
+	client ClientFactory
+}
+
+func (a *ProjectRetireKey) Run(cmd *cobra.Command, args []string) error {
+	if err := project.RetireKey(cmd.Context(), a.client.Options(), args[0], args[1]); err != nil {
+		return err
+	}
+	fmt.Println(args[1])
+	return nil
+}
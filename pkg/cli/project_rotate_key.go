@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/project"
+	"github.com/spf13/cobra"
+)
+
+func NewProjectRotateKey(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ProjectRotateKey{client: c.ClientFactory}, cobra.Command{
+		Use: "rotate-key [flags] PROJECT_NAME",
+		Example: `
+# Create a new primary encryption key and re-encrypt the secrets and apps of the project with it
+acorn project rotate-key my-project`,
+		SilenceUsage:      true,
+		Short:             "Rotate the encryption key of a project",
+		Long:              "Create a new primary encryption key for a project and re-encrypt the secrets and app deploy args that hold data encrypted with previous keys. Images can not be re-encrypted, the images still carrying data encrypted with previous keys are listed as references of those keys.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, projectsCompletion).complete,
+	})
+	return cmd
+}
+
+type ProjectRotateKey struct {
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *ProjectRotateKey) Run(cmd *cobra.Command, args []string) error {
+	rotation, err := project.RotateKey(cmd.Context(), a.client.Options(), args[0])
+	if err != nil {
+		return err
+	}
+
+	if a.Output == "" {
+		fmt.Printf("New primary key: %s\n", rotation.PrimaryKey)
+		for _, ref := range rotation.ReEncrypted {
+			fmt.Printf("Re-encrypted %s/%s\n", ref.Kind, ref.Name)
+		}
+	}
+
+	keys, err := project.Keys(cmd.Context(), a.client.Options(), args[0])
+	if err != nil {
+		return err
+	}
+	return writeProjectKeys(keys, a.Output)
+}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/project"
 	"github.com/spf13/cobra"
@@ -13,6 +15,9 @@ func NewProjectUpdate(c CommandContext) *cobra.Command {
 		Use: "update [flags] PROJECT_NAME",
 		Example: `
 acorn project update my-project
+
+# Prune untagged images no app uses after a day and tagged images after 30 days
+acorn project update my-project --image-retention untagged=24h --image-retention tagged=720h
 `,
 		SilenceUsage:      true,
 		Short:             "Update project",
@@ -34,6 +39,8 @@ type ProjectUpdate struct {
 	client           ClientFactory
 	DefaultRegion    string   `usage:"Default region for project resources"`
 	SupportedRegions []string `name:"supported-region" usage:"Supported regions for the created project"`
+	BuildCache       *string  `usage:"Registry repository builds in the project import their cache from and export it to (empty to unset)"`
+	ImageRetention   []string `usage:"How long images no app uses are kept before they are pruned, untagged=DURATION and tagged=DURATION (empty duration to keep forever)"`
 }
 
 func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
@@ -41,6 +48,16 @@ func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if a.BuildCache != nil && projectsDetails[0].Project != nil {
+		projectsDetails[0].Project.Spec.BuildCache = *a.BuildCache
+	}
+	if len(a.ImageRetention) > 0 && projectsDetails[0].Project != nil {
+		retention, err := parseImageRetention(projectsDetails[0].Project.Spec.ImageRetention, a.ImageRetention)
+		if err != nil {
+			return err
+		}
+		projectsDetails[0].Project.Spec.ImageRetention = retention
+	}
 	if err := project.Update(cmd.Context(), a.client.Options(), projectsDetails[0], a.DefaultRegion, a.SupportedRegions); err != nil {
 		return err
 	} else {
@@ -48,3 +65,26 @@ func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// parseImageRetention applies the untagged=DURATION and tagged=DURATION values to the image retention of the project
+func parseImageRetention(current *v1.ImageRetention, values []string) (*v1.ImageRetention, error) {
+	var retention v1.ImageRetention
+	if current != nil {
+		retention = *current
+	}
+	for _, value := range values {
+		key, duration, _ := strings.Cut(value, "=")
+		switch key {
+		case "untagged":
+			retention.Untagged = duration
+		case "tagged":
+			retention.Tagged = duration
+		default:
+			return nil, fmt.Errorf("invalid image retention %s, must be untagged=DURATION or tagged=DURATION", value)
+		}
+	}
+	if retention.Untagged == "" && retention.Tagged == "" {
+		return nil, nil
+	}
+	return &retention, nil
+}
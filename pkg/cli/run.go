@@ -12,11 +12,13 @@ import (
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/dev"
 	"github.com/acorn-io/runtime/pkg/imageallowrules"
 	"github.com/acorn-io/runtime/pkg/imagesource"
 	"github.com/acorn-io/runtime/pkg/rulerequest"
+	"github.com/acorn-io/runtime/pkg/secrets"
 	"github.com/acorn-io/runtime/pkg/wait"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -104,6 +106,13 @@ More Usages:
      - Expose port 80 to the rest of the cluster as port 8080
       	acorn run --expose 8080:80/http .
 
+     # Route Syntax
+     - Send 10% of the requests to /api of the router "web" to the container "api-canary" and the rest to "api"
+      	acorn run --route web:/api,target=api:8080@90,target=api-canary:8080@10 .
+
+     - Send the requests to /api with the header "X-Canary: true" to the container "api-canary"
+      	acorn run --route web:/api,target=api-canary:8080,header=X-Canary=true --route web:/api,target=api:8080 .
+
      # Labels and Annotations Syntax
      - Add a label to all resources created by the app
        	acorn run --label key=value .
@@ -122,6 +131,10 @@ More Usages:
      - Bind the acorn secret named "mycredentials" into the current app, replacing the secret named "creds". See "acorn secrets --help" for more info
          acorn run --secret mycredentials:creds .
 
+     # Dry Run Syntax
+     - Show which secrets of the existing app named "myapp" would change if it was updated from the current directory
+        acorn run --update --dry-run --secrets -n myapp .
+
      # Volume Syntax
      - Create the volume named "mydata" with a size of 5 gigabyes and using the "fast" storage class
         acorn run --volume mydata,size=5G,class=fast .
@@ -129,7 +142,7 @@ More Usages:
         acorn run --volume mydata:data .`
 
 var hideRunFlags = []string{"dangerous", "memory", "target-namespace", "secret", "volume", "region", "publish-all",
-	"publish", "link", "label", "interval", "env", "compute-class", "annotation", "update", "replace"}
+	"publish", "route", "egress", "link", "label", "interval", "env", "compute-class", "annotation", "update", "replace", "dry-run", "secrets"}
 
 type Run struct {
 	RunArgs
@@ -140,6 +153,8 @@ type Run struct {
 	Update            bool  `usage:"Update the app if it already exists" short:"u"`
 	Replace           bool  `usage:"Replace the app with only defined values, resetting undefined fields to default values" json:"replace,omitempty"` // Replace sets patchMode to false, resulting in a full update, resetting all undefined fields to their defaults
 	HelpAdvanced      bool  `usage:"Show verbose help text"`
+	DryRun            bool  `usage:"Show what updating an existing app would change without updating it, must be combined with --update or --replace and --secrets"`
+	Secrets           bool  `usage:"With --dry-run, show which secrets would be created, regenerated, rebound, removed or left unchanged and which containers would restart. Secret values are never shown"`
 
 	out    io.Writer
 	client ClientFactory
@@ -206,6 +221,16 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		return opts, err
 	}
 
+	opts.Routes, err = v1.ParseRouteBindings(s.Route)
+	if err != nil {
+		return opts, err
+	}
+
+	opts.Egress, err = v1.ParseEgressBindings(s.Egress)
+	if err != nil {
+		return opts, err
+	}
+
 	if s.PublishAll != nil && *s.PublishAll {
 		opts.PublishMode = v1.PublishModeAll
 	} else if s.PublishAll != nil && !*s.PublishAll {
@@ -248,6 +273,19 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	if s.DryRun {
+		if !s.Secrets {
+			return fmt.Errorf("--dry-run must be combined with --secrets")
+		}
+		if !s.Replace && !s.Update {
+			return fmt.Errorf("--dry-run can only preview changes to an existing app, it must be combined with --update or --replace")
+		}
+		if s.Name == "" {
+			return fmt.Errorf("--name is required for --dry-run")
+		}
+		return s.previewSecrets(cmd.Context(), c, imageSource, opts)
+	}
+
 	if s.Dev {
 		return dev.Dev(cmd.Context(), c, &dev.Options{
 			ImageSource:       imageSource,
@@ -332,29 +370,40 @@ func (s *Run) update(ctx context.Context, c client.Client, imageSource imagesour
 		return nil, false, err
 	}
 
+	updateOpts, err := s.updateOptions(ctx, c, app, imageSource, opts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	app, err = rulerequest.PromptUpdate(ctx, c, s.Dangerous, app.Name, updateOpts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return app, true, nil
+}
+
+// updateOptions returns the options to update the existing app with, resolving the image and deploy args from imageSource.
+func (s *Run) updateOptions(ctx context.Context, c client.Client, app *apiv1.App, imageSource imagesource.ImageSource, opts client.AppRunOptions) (client.AppUpdateOptions, error) {
 	updateOpts := opts.ToUpdate()
 	updateOpts.Replace = s.Replace
 
 	if imageSource.IsImageSet() {
 		image, deployArgs, err := imageSource.GetImageAndDeployArgs(ctx, c)
 		if err != nil {
-			return nil, false, err
+			return updateOpts, err
 		}
 		updateOpts.Image = image
 		updateOpts.DeployArgs = deployArgs
 	} else if len(imageSource.Args) > 0 {
+		var err error
 		imageSource.Image = app.Status.AppImage.Name
 		if _, updateOpts.DeployArgs, err = imageSource.GetImageAndDeployArgs(ctx, c); err != nil {
-			return nil, false, err
+			return updateOpts, err
 		}
 	}
 
-	app, err = rulerequest.PromptUpdate(ctx, c, s.Dangerous, app.Name, updateOpts)
-	if err != nil {
-		return nil, false, err
-	}
-
-	return app, true, nil
+	return updateOpts, nil
 }
 
 func outputApp(out io.Writer, format string, app *apiv1.App) error {
@@ -406,3 +455,54 @@ func setAdvancedHelp(cmd *cobra.Command, hideRunFlags []string, advancedHelp str
 		fmt.Println(advancedHelp)
 	})
 }
+
+// previewSecrets prints the changes to the secrets of the existing app that updating it from imageSource would make.
+func (s *Run) previewSecrets(ctx context.Context, c client.Client, imageSource imagesource.ImageSource, opts client.AppRunOptions) error {
+	app, err := c.AppGet(ctx, s.Name)
+	if err != nil {
+		return err
+	}
+
+	updateOpts, err := s.updateOptions(ctx, c, app, imageSource, opts)
+	if err != nil {
+		return err
+	}
+
+	updated, err := client.ToAppUpdate(ctx, c, s.Name, &updateOpts)
+	if err != nil {
+		return err
+	}
+
+	image := app.Status.AppImage.Name
+	if image == "" || updated.Spec.Image != app.Spec.Image {
+		image = updated.Spec.Image
+	}
+
+	details, err := c.ImageDetails(ctx, image, &client.ImageDetailsOptions{
+		Profiles:   updated.Spec.Profiles,
+		DeployArgs: updated.Spec.DeployArgs,
+	})
+	if err != nil {
+		return err
+	} else if details.ParseError != "" {
+		return errors.New(details.ParseError)
+	} else if details.AppSpec == nil {
+		return fmt.Errorf("failed to render the Acornfile of image %s", image)
+	}
+
+	changes := secrets.Preview(&app.Status.AppSpec, details.AppSpec, app.Spec.Secrets, updated.Spec.Secrets)
+
+	out := table.NewWriter([][]string{
+		{"NAME", "Name"},
+		{"TYPE", "Type"},
+		{"ACTION", "Action"},
+		{"BOUND TO", "BoundTo"},
+		{"RESTARTS", "{{ arrayNoSpace .Restarts }}"},
+	}, false, s.Output)
+
+	for _, change := range changes {
+		out.WriteFormatted(change, nil)
+	}
+
+	return out.Err()
+}
@@ -270,6 +270,64 @@ func TestRun(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name: "acorn run --dry-run --secrets --name found", fields: fields{
+				All:   false,
+				Force: true,
+			},
+			args: args{
+				args: []string{"--dry-run", "--secrets", "--name", "found"},
+			},
+			wantErr: true,
+			wantOut: "--dry-run can only preview changes to an existing app, it must be combined with --update or --replace",
+			prepare: func(t *testing.T, f *mocks.MockClient) {
+				t.Helper()
+				f.EXPECT().Info(gomock.Any()).Return(
+					[]apiv1.Info{
+						{
+							TypeMeta:   metav1.TypeMeta{},
+							ObjectMeta: metav1.ObjectMeta{},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "acorn run --update --dry-run --secrets --name found", fields: fields{
+				All:   false,
+				Force: true,
+			},
+			args: args{
+				args: []string{"--update", "--dry-run", "--secrets", "--name", "found", "-s", "other.secret:found"},
+			},
+			wantErr: false,
+			wantOut: `NAME      TYPE      ACTION    BOUND TO       RESTARTS
+found     opaque    create    other.secret   
+`,
+			prepare: func(t *testing.T, f *mocks.MockClient) {
+				t.Helper()
+				f.EXPECT().Info(gomock.Any()).Return(
+					[]apiv1.Info{
+						{
+							TypeMeta:   metav1.TypeMeta{},
+							ObjectMeta: metav1.ObjectMeta{},
+						},
+					}, nil)
+				f.EXPECT().ImageDetails(gomock.Any(), gomock.Any(), gomock.Any()).Return(&client.ImageDetails{
+					AppSpec: &v1.AppSpec{
+						Containers: map[string]v1.Container{
+							"web": {
+								Environment: []v1.EnvVar{
+									{Name: "SECRET", Secret: v1.SecretReference{Name: "found", Key: "key", OnChange: v1.ChangeTypeRedeploy}},
+								},
+							},
+						},
+						Secrets: map[string]v1.Secret{
+							"found": {Type: "opaque"},
+						},
+					},
+				}, nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewSecretHistory(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&SecretHistory{client: c.ClientFactory}, cobra.Command{
+		Use: "history [flags] SECRET_NAME",
+		Example: `
+# List the previous versions of a secret
+acorn secret history my-secret`,
+		SilenceUsage:      true,
+		Short:             "List the previous versions of a secret",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, secretsCompletion).complete,
+	})
+	return cmd
+}
+
+type SecretHistory struct {
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+type secretHistoryEntry struct {
+	Version int
+	Reason  string
+	Actor   string
+	Keys    string
+	Created metav1.Time
+}
+
+func (a *SecretHistory) Run(cmd *cobra.Command, args []string) error {
+	client, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	history, err := client.SecretHistory(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter([][]string{
+		{"VERSION", "Version"},
+		{"REASON", "Reason"},
+		{"ACTOR", "Actor"},
+		{"KEYS", "Keys"},
+		{"CREATED", "{{ago .Created}}"},
+	}, false, a.Output)
+
+	if a.Output != "" {
+		// in non-table output, write the whole history once instead of once per version
+		out.Write(history)
+		return out.Err()
+	}
+
+	for _, version := range history.Versions {
+		out.WriteFormatted(&secretHistoryEntry{
+			Version: version.Version,
+			Reason:  version.Reason,
+			Actor:   version.Actor,
+			Keys:    strings.Join(version.Keys, ","),
+			Created: version.Created,
+		}, history)
+	}
+
+	return out.Err()
+}
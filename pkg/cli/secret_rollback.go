@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewSecretRollback(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&SecretRollback{client: c.ClientFactory}, cobra.Command{
+		Use: "rollback [flags] SECRET_NAME",
+		Example: `
+# Restore the data of a secret from a previous version
+acorn secret rollback --version 2 my-secret`,
+		SilenceUsage:      true,
+		Short:             "Restore the data of a secret from a previous version",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, secretsCompletion).complete,
+	})
+	return cmd
+}
+
+type SecretRollback struct {
+	Version int `usage:"The version to restore, see acorn secret history"`
+	client  ClientFactory
+}
+
+func (a *SecretRollback) Run(cmd *cobra.Command, args []string) error {
+	if a.Version < 1 {
+		return fmt.Errorf("a version must be specified with --version")
+	}
+
+	client, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	secret, err := client.SecretRollback(cmd.Context(), args[0], a.Version)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(secret.Name)
+	return nil
+}
@@ -278,6 +278,101 @@ func TestSecret(t *testing.T) {
 			wantErr: false,
 			wantOut: "ACORNENC:e30::\n",
 		},
+		{
+			name: "acorn secret history found.secret", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"history", "found.secret"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "VERSION   REASON     ACTOR     KEYS      CREATED\n2         rollback   admin     foo       292y ago\n1         update     admin     foo,bar   292y ago\n",
+		},
+		{
+			name: "acorn secret history dne", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"history", "dne"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "error: Secret dne does not exist",
+		},
+		{
+			name: "acorn secret rollback --version 1 found.secret", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"rollback", "--version", "1", "found.secret"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "found.secret\n",
+		},
+		{
+			name: "acorn secret rollback --version 3 found.secret", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"rollback", "--version", "3", "found.secret"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "version 3 of secret found.secret not found",
+		},
+		{
+			name: "acorn secret rollback found.secret", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"rollback", "found.secret"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "a version must be specified with --version",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
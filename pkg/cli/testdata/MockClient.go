@@ -3,7 +3,9 @@ package testdata
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/typed"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -12,6 +14,8 @@ import (
 	"github.com/acorn-io/runtime/pkg/client/term"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/project"
+	"github.com/acorn-io/z"
+	"github.com/sigstore/sigstore/pkg/signature"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -57,6 +61,7 @@ type MockClientFactory struct {
 	RegionItem       *apiv1.Region
 	EventList        []apiv1.Event
 	EventItem        *apiv1.Event
+	ImageDetailsItem *client.ImageDetails
 }
 
 func (dc *MockClientFactory) Options() project.Options {
@@ -87,6 +92,7 @@ func (dc *MockClientFactory) CreateDefault() (client.Client, error) {
 		RegionItem:       dc.RegionItem,
 		Events:           dc.EventList,
 		EventItem:        dc.EventItem,
+		ImageDetailsItem: dc.ImageDetailsItem,
 	}, nil
 }
 
@@ -117,6 +123,7 @@ type MockClient struct {
 	RegionItem       *apiv1.Region
 	Events           []apiv1.Event
 	EventItem        *apiv1.Event
+	ImageDetailsItem *client.ImageDetails
 }
 
 func (m *MockClient) KubeProxyAddress(ctx context.Context) (string, error) {
@@ -152,7 +159,7 @@ func (m *MockClient) AppList(ctx context.Context) ([]apiv1.App, error) {
 
 func (m *MockClient) AppDelete(ctx context.Context, name string) (*apiv1.App, error) {
 	if m.AppItem != nil {
-		return m.AppItem, nil
+		return m.AppItem.DeepCopy(), nil
 	}
 	switch name {
 	case "dne":
@@ -171,7 +178,7 @@ func (m *MockClient) AppIgnoreDeleteCleanup(ctx context.Context, name string) er
 
 func (m *MockClient) AppGet(ctx context.Context, name string) (*apiv1.App, error) {
 	if m.AppItem != nil {
-		return m.AppItem, nil
+		return m.AppItem.DeepCopy(), nil
 	}
 	switch name {
 	case "dne":
@@ -245,7 +252,7 @@ func (m *MockClient) AppRun(ctx context.Context, image string, opts *client.AppR
 
 func (m *MockClient) AppUpdate(ctx context.Context, name string, opts *client.AppUpdateOptions) (*apiv1.App, error) {
 	if m.AppItem != nil {
-		return m.AppItem, nil
+		return m.AppItem.DeepCopy(), nil
 	}
 	switch name {
 	case "dne":
@@ -416,6 +423,50 @@ func (m *MockClient) SecretReveal(ctx context.Context, name string) (*apiv1.Secr
 	return nil, nil
 }
 
+func (m *MockClient) SecretHistory(ctx context.Context, name string) (*apiv1.SecretHistory, error) {
+	switch name {
+	case "dne":
+		return nil, fmt.Errorf("error: Secret %s does not exist", name)
+	case "found.secret":
+		return &apiv1.SecretHistory{
+			ObjectMeta: metav1.ObjectMeta{Name: "found.secret"},
+			Versions: []apiv1.SecretVersion{
+				{
+					Version: 2,
+					Actor:   "admin",
+					Reason:  "rollback",
+					Keys:    []string{"foo"},
+				},
+				{
+					Version: 1,
+					Actor:   "admin",
+					Reason:  "update",
+					Keys:    []string{"foo", "bar"},
+				},
+			},
+		}, nil
+	}
+	return &apiv1.SecretHistory{}, nil
+}
+
+func (m *MockClient) SecretRollback(ctx context.Context, name string, version int) (*apiv1.Secret, error) {
+	if m.SecretItem != nil {
+		return m.SecretItem, nil
+	}
+	switch name {
+	case "dne":
+		return nil, fmt.Errorf("error: Secret %s does not exist", name)
+	case "found.secret":
+		if version != 1 && version != 2 {
+			return nil, fmt.Errorf("version %d of secret %s not found", version, name)
+		}
+		return &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "found.secret"},
+		}, nil
+	}
+	return nil, nil
+}
+
 func (m *MockClient) SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error) {
 	if m.SecretItem != nil {
 		return m.SecretItem, nil
@@ -689,7 +740,83 @@ func (m *MockClient) ImageTag(ctx context.Context, image, tag string) error {
 	return nil
 }
 
+func (m *MockClient) ImageSave(_ context.Context, image string, w io.Writer, _ *client.ImageSaveOptions) error {
+	switch image {
+	case "found":
+		_, err := w.Write([]byte("archive"))
+		return err
+	default:
+		return fmt.Errorf("error: image %s does not exist", image)
+	}
+}
+
+func (m *MockClient) ImageLoad(_ context.Context, r io.Reader, _ *client.ImageLoadOptions) (<-chan client.ImageProgress, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if string(data) != "archive" {
+		return nil, fmt.Errorf("error: invalid image archive")
+	}
+	progresses := make(chan client.ImageProgress)
+	close(progresses)
+	return progresses, nil
+}
+
+func (m *MockClient) ImageSign(_ context.Context, image string, _ signature.Signer, _ *client.ImageSignOptions) (*apiv1.ImageSignature, error) {
+	switch image {
+	case "found":
+		return &apiv1.ImageSignature{
+			Signatures: []apiv1.ImageDigestSignature{
+				{Digest: "sha256:1234567890123456789012345678901234567890123456789012345678901234"},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("error: image %s does not exist", image)
+	}
+}
+
+func (m *MockClient) ImageVerify(_ context.Context, image string, _ *client.ImageVerifyOptions) (*apiv1.ImageVerification, error) {
+	switch image {
+	case "found":
+		return &apiv1.ImageVerification{
+			Digest:   "sha256:1234567890123456789012345678901234567890123456789012345678901234",
+			Verified: true,
+		}, nil
+	case "unsigned":
+		return &apiv1.ImageVerification{
+			Digest: "sha256:1234567890123456789012345678901234567890123456789012345678901234",
+			Reason: "image <unsigned> is not allowed by any ImageAllowRule in this project",
+		}, nil
+	default:
+		return nil, fmt.Errorf("error: image %s does not exist", image)
+	}
+}
+
+func (m *MockClient) ImagePrune(_ context.Context, opts *client.ImagePruneOptions) (*apiv1.ImagePrune, error) {
+	result := &apiv1.ImagePrune{
+		Images: []apiv1.PrunedImage{
+			{
+				Name:   "1234567890123456789012345678901234567890123456789012345678901234",
+				Digest: "sha256:1234567890123456789012345678901234567890123456789012345678901234",
+			},
+		},
+		ReclaimedBytes: 1536,
+	}
+	if opts != nil && opts.All {
+		result.Images = append(result.Images, apiv1.PrunedImage{
+			Name:   "4321567890123456789012345678901234567890123456789012345678901234",
+			Digest: "sha256:4321567890123456789012345678901234567890123456789012345678901234",
+			Tags:   []string{"testtag:latest"},
+		})
+	}
+	return result, nil
+}
+
 func (m *MockClient) ImageDetails(ctx context.Context, imageName string, opts *client.ImageDetailsOptions) (*client.ImageDetails, error) {
+	if m.ImageDetailsItem != nil {
+		return m.ImageDetailsItem, nil
+	}
 	return &client.ImageDetails{
 		AppImage: v1.AppImage{ID: imageName, ImageData: v1.ImagesData{
 			Containers: map[string]v1.ContainerData{"test-image-running-container": v1.ContainerData{
@@ -745,8 +872,43 @@ func (m *MockClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv
 }
 
 func (m *MockClient) AcornImageBuildList(ctx context.Context) ([]apiv1.AcornImageBuild, error) {
-	// TODO implement me
-	panic("implement me")
+	created := metav1.NewTime(time.Now().Add(-time.Hour))
+	return []apiv1.AcornImageBuild{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "bld-found",
+				CreationTimestamp: created,
+			},
+			Spec: v1.AcornImageBuildInstanceSpec{
+				Actor: "ci",
+				VCS: v1.VCS{
+					Revision: "5c1d2a4b3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b",
+					Modified: true,
+				},
+			},
+			Status: v1.AcornImageBuildInstanceStatus{
+				StartTime:      &created,
+				CompletionTime: z.Pointer(metav1.NewTime(created.Add(90 * time.Second))),
+				BuildError:     "failed to solve",
+				Conditions: []v1.Condition{
+					{
+						Type:    v1.AcornImageBuildInstanceConditionBuild,
+						Error:   true,
+						Message: "failed to solve",
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (m *MockClient) AcornImageBuildLogs(ctx context.Context, name string) (*apiv1.AcornImageBuildLogs, error) {
+	if name != "bld-found" {
+		return nil, fmt.Errorf("error: build %s does not exist", name)
+	}
+	return &apiv1.AcornImageBuildLogs{
+		Logs: []byte("#1 [internal] load build definition from Dockerfile\nERROR: failed to solve\n"),
+	}, nil
 }
 
 func (m *MockClient) AcornImageBuildDelete(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
@@ -814,6 +976,21 @@ func (m *MockClient) ProjectDelete(ctx context.Context, name string) (*apiv1.Pro
 	panic("implement me")
 }
 
+func (m *MockClient) ProjectKeys(ctx context.Context, name string) (*apiv1.ProjectKeys, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *MockClient) ProjectRotateKey(ctx context.Context, name string) (*apiv1.ProjectKeyRotation, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *MockClient) ProjectRetireKey(ctx context.Context, name, keyID string) (*apiv1.ProjectKeyRetirement, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (m *MockClient) ProjectUpdate(ctx context.Context, project *apiv1.Project, defaultRegion string, supportedRegions []string) (*apiv1.Project, error) {
 	// TODO implement me
 	panic("implement me")
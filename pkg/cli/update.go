@@ -1,10 +1,13 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/imagesource"
 	"github.com/spf13/cobra"
 )
 
@@ -25,7 +28,10 @@ func NewUpdate(c CommandContext) *cobra.Command {
     acorn update --image . my-app
 
   # Enable auto-upgrade on an Acorn called "my-app"
-    acorn update --auto-upgrade my-app`,
+    acorn update --auto-upgrade my-app
+
+  # Show which secrets of an Acorn called "my-app" would change if it was updated to a new image
+    acorn update --dry-run --secrets --image <new image> my-app`,
 	})
 
 	toggleHiddenFlags(cmd, hideUpdateFlags, true)
@@ -41,6 +47,8 @@ type UpdateArgs struct {
 	Link            []string `usage:"Link external app as a service in the current app (format app-name:container-name)"`
 	PublishAll      *bool    `usage:"Publish all (true) or none (false) of the defined ports of application" short:"P"`
 	Publish         []string `usage:"Publish port of application (format [public:]private) (ex 81:80)" short:"p"`
+	Route           []string `usage:"Add a route to a router of the application, replacing its routes with the same path (format router:path,target=service[:port][@weight],header=name=value,query=name=value,pathType=prefix|exact) (ex web:/api,target=api@90,target=api-canary@10)" split:"false"`
+	Egress          []string `usage:"Allow outgoing traffic from a container to a service, CIDR or DNS name (format [container=]destination[:port]) (ex api=db:5432, 10.0.0.0/8, api.example.com:443)"`
 	Profile         []string `usage:"Profile to assign default values"`
 	Env             []string `usage:"Environment variables to set on running containers" short:"e"`
 	Label           []string `usage:"Add labels to the app and the resources it creates (format [type:][name:]key=value) (ex k=v, containers:k=v)" short:"l"`
@@ -63,6 +71,8 @@ type Update struct {
 	Wait           *bool  `usage:"Wait for app to become ready before command exiting (default: true)"`
 	Quiet          bool   `usage:"Do not print status" short:"q"`
 	HelpAdvanced   bool   `usage:"Show verbose help text"`
+	DryRun         bool   `usage:"Show what the update would change without updating the app, must be combined with --secrets"`
+	Secrets        bool   `usage:"With --dry-run, show which secrets would be created, regenerated, rebound, removed or left unchanged and which containers would restart. Secret values are never shown"`
 
 	out    io.Writer
 	client ClientFactory
@@ -88,6 +98,13 @@ func (s *Update) Run(cmd *cobra.Command, args []string) error {
 	name := args[0]
 	args = args[1:]
 
+	if s.DryRun {
+		if !s.Secrets {
+			return fmt.Errorf("--dry-run must be combined with --secrets")
+		}
+		return s.previewSecrets(cmd.Context(), c, name, args)
+	}
+
 	if s.ConfirmUpgrade && s.Pull {
 		return fmt.Errorf("only --confirm-upgrade or --pull can be set at once")
 	}
@@ -127,3 +144,20 @@ func (s *Update) getRunArgs(name string) RunArgs {
 		UpdateArgs: s.UpdateArgs,
 	}
 }
+
+// previewSecrets prints the changes to the secrets of the app that updating it with the given image and args would make.
+func (s *Update) previewSecrets(ctx context.Context, c client.Client, name string, args []string) error {
+	r := Run{
+		RunArgs: s.getRunArgs(name),
+		Update:  true,
+		out:     s.out,
+	}
+
+	opts, err := r.ToOpts()
+	if err != nil {
+		return err
+	}
+
+	imageSource := imagesource.NewImageSource(s.File, append([]string{s.Image}, args...), s.Profile, nil, s.AutoUpgrade != nil && *s.AutoUpgrade)
+	return r.previewSecrets(ctx, c, imageSource, opts)
+}
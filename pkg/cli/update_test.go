@@ -6,11 +6,77 @@ import (
 	"strings"
 	"testing"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// previewApp returns a deployed app whose secrets are previewed against previewImageDetails.
+func previewApp() *apiv1.App {
+	return &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "preview"},
+		Spec: v1.AppInstanceSpec{
+			Image: "preview-image",
+		},
+		Status: v1.AppInstanceStatus{
+			AppImage: v1.AppImage{Name: "preview-image"},
+			AppSpec: v1.AppSpec{
+				Secrets: map[string]v1.Secret{
+					"creds":    {Type: "basic"},
+					"legacy":   {Type: "opaque"},
+					"password": {Type: "opaque", Data: map[string]string{"password": "old"}},
+					"tls":      {Type: "template", Data: map[string]string{"template": "${secret://password/password}"}},
+					"username": {Type: "opaque", Data: map[string]string{"username": "admin"}},
+				},
+			},
+		},
+	}
+}
+
+// previewImageDetails returns the rendered Acornfile the secrets of previewApp are previewed against, it adds the
+// api-key secret, removes the legacy secret and changes the password secret, which the tls secret is rendered from.
+func previewImageDetails() *client.ImageDetails {
+	return &client.ImageDetails{
+		AppImage: v1.AppImage{Name: "preview-image"},
+		AppSpec: &v1.AppSpec{
+			Containers: map[string]v1.Container{
+				"web": {
+					Environment: []v1.EnvVar{
+						{Name: "PASSWORD", Secret: v1.SecretReference{Name: "password", Key: "password", OnChange: v1.ChangeTypeRedeploy}},
+						{Name: "USERNAME", Secret: v1.SecretReference{Name: "username", Key: "username", OnChange: v1.ChangeTypeRedeploy}},
+					},
+					Files: map[string]v1.File{
+						"/etc/tls/cert.pem": {Secret: v1.SecretReference{Name: "tls", Key: "template", OnChange: v1.ChangeTypeRedeploy}},
+					},
+				},
+				"db": {
+					Environment: []v1.EnvVar{
+						{Name: "PASSWORD", Secret: v1.SecretReference{Name: "password", Key: "password", OnChange: v1.ChangeTypeRedeploy}},
+						{Name: "USER", Secret: v1.SecretReference{Name: "creds", Key: "username", OnChange: v1.ChangeTypeRedeploy}},
+						{Name: "API_KEY", Secret: v1.SecretReference{Name: "api-key", Key: "token", OnChange: v1.ChangeTypeRedeploy}},
+					},
+				},
+				"worker": {
+					Environment: []v1.EnvVar{
+						{Name: "PASSWORD", Secret: v1.SecretReference{Name: "password", Key: "password", OnChange: v1.ChangeTypeNoAction}},
+					},
+				},
+			},
+			Secrets: map[string]v1.Secret{
+				"api-key":  {Type: "token"},
+				"creds":    {Type: "basic"},
+				"password": {Type: "opaque", Data: map[string]string{"password": "new"}},
+				"tls":      {Type: "template", Data: map[string]string{"template": "${secret://password/password}"}},
+				"username": {Type: "opaque", Data: map[string]string{"username": "admin"}},
+			},
+		},
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	type fields struct {
 		Quiet  bool
@@ -69,6 +135,53 @@ func TestUpdate(t *testing.T) {
 			wantErr: true,
 			wantOut: "error: app dne does not exist",
 		},
+		{
+			name: "acorn update --dry-run without --secrets", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--dry-run", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "--dry-run must be combined with --secrets",
+		},
+		{
+			name: "acorn update --dry-run --secrets", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{
+					AppItem:          previewApp(),
+					ImageDetailsItem: previewImageDetails(),
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--dry-run", "--secrets", "-s", "other-creds:creds", "preview"},
+				client: &testdata.MockClient{},
+			},
+			wantOut: `NAME       TYPE       ACTION       BOUND TO      RESTARTS
+api-key    token      create                     
+creds      basic      rebind       other-creds   db
+legacy     opaque     remove                     
+password   opaque     regenerate                 db,web
+tls        template   regenerate                 web
+username   opaque     unchanged                  
+`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -57,6 +57,8 @@ func ToApp(namespace, image string, opts *AppRunOptions) *apiv1.App {
 			Secrets:             opts.Secrets,
 			Links:               opts.Links,
 			Publish:             opts.Publish,
+			Routes:              opts.Routes,
+			Egress:              opts.Egress,
 			Profiles:            opts.Profiles,
 			Stop:                opts.Stop,
 			Permissions:         opts.Permissions,
@@ -138,6 +140,8 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 	app.Spec.Secrets = mergeSecrets(app.Spec.Secrets, opts.Secrets)
 	app.Spec.Links = mergeServices(app.Spec.Links, opts.Links)
 	app.Spec.Publish = mergePorts(app.Spec.Publish, opts.Publish)
+	app.Spec.Routes = mergeRoutes(app.Spec.Routes, opts.Routes)
+	app.Spec.Egress = mergeEgress(app.Spec.Egress, opts.Egress)
 	app.Spec.Environment = mergeEnv(app.Spec.Environment, opts.Env)
 	app.Spec.Labels = mergeLabels(app.Spec.Labels, opts.Labels)
 	app.Spec.Annotations = mergeLabels(app.Spec.Annotations, opts.Annotations)
@@ -393,6 +397,44 @@ func mergePorts(appPorts, optsPorts []v1.PortBinding) []v1.PortBinding {
 	return appPorts
 }
 
+// mergeRoutes replaces the routes of the app with the same router and path as a new route, since several routes can
+// share a path they are replaced together
+func mergeRoutes(appRoutes, optsRoutes []v1.RouteBinding) []v1.RouteBinding {
+	type routerPath struct {
+		router, path string
+	}
+
+	replaced := map[routerPath]bool{}
+	for _, newRoute := range optsRoutes {
+		replaced[routerPath{router: newRoute.Router, path: newRoute.Path}] = true
+	}
+
+	var result []v1.RouteBinding
+	for _, existingRoute := range appRoutes {
+		if !replaced[routerPath{router: existingRoute.Router, path: existingRoute.Path}] {
+			result = append(result, existingRoute)
+		}
+	}
+	return append(result, optsRoutes...)
+}
+
+// mergeEgress replaces the egress rules of the app for the containers that new rules are given for, so that rules can
+// be removed again by updating the app
+func mergeEgress(appEgress, optsEgress []v1.EgressBinding) []v1.EgressBinding {
+	replaced := map[string]bool{}
+	for _, newEgress := range optsEgress {
+		replaced[newEgress.Container] = true
+	}
+
+	var result []v1.EgressBinding
+	for _, existingEgress := range appEgress {
+		if !replaced[existingEgress.Container] {
+			result = append(result, existingEgress)
+		}
+	}
+	return append(result, optsEgress...)
+}
+
 func mergeServices(appServices, optsServices []v1.ServiceBinding) []v1.ServiceBinding {
 	for _, newService := range optsServices {
 		found := false
@@ -44,6 +44,17 @@ func (c *DefaultClient) AcornImageBuildList(ctx context.Context) ([]apiv1.AcornI
 	return builders.Items, err
 }
 
+func (c *DefaultClient) AcornImageBuildLogs(ctx context.Context, name string) (*apiv1.AcornImageBuildLogs, error) {
+	result := &apiv1.AcornImageBuildLogs{}
+	err := c.RESTClient.Get().
+		Namespace(c.Namespace).
+		Resource("acornimagebuilds").
+		Name(name).
+		SubResource("logs").
+		Do(ctx).Into(result)
+	return result, err
+}
+
 func BuildClientID(image, file string) string {
 	hashSource := file
 	if hashSource == "" {
@@ -64,12 +75,24 @@ func (c *DefaultClient) AcornImageBuild(ctx context.Context, file string, opts *
 		return nil, err
 	}
 
+	secrets, err := buildclient.ParseBuildSecrets(opts.Secrets, opts.SSH)
+	if err != nil {
+		return nil, err
+	}
+
+	secretIDs, sshIDs := secrets.IDs()
+
 	fileData, err := cue.ReadCUE(file)
 	if err != nil {
 		return nil, err
 	}
 
-	vcs := vcs.VCS(filepath.Dir(file))
+	var buildVCS v1.VCS
+	if opts.VCS != nil {
+		buildVCS = *opts.VCS
+	} else {
+		buildVCS = vcs.VCS(filepath.Dir(file))
+	}
 
 	builder, err := c.getOrCreateBuilder(ctx, opts.BuilderName)
 	if err != nil {
@@ -89,7 +112,13 @@ func (c *DefaultClient) AcornImageBuild(ctx context.Context, file string, opts *
 			Platforms:       opts.Platforms,
 			Args:            opts.Args,
 			Profiles:        opts.Profiles,
-			VCS:             vcs,
+			VCS:             buildVCS,
+			CacheFrom:       opts.CacheFrom,
+			CacheTo:         opts.CacheTo,
+			SBOM:            opts.SBOM,
+			Provenance:      opts.Provenance,
+			Secrets:         secretIDs,
+			SSH:             sshIDs,
 		},
 	}
 
@@ -109,5 +138,5 @@ func (c *DefaultClient) AcornImageBuild(ctx context.Context, file string, opts *
 	}
 
 	logrus.Debugf("Building with URL: %s", build.Status.BuildURL)
-	return buildclient.Stream(ctx, opts.Cwd, opts.Streams, dialer, (buildclient.CredentialLookup)(opts.Credentials), build)
+	return buildclient.Stream(ctx, opts.Cwd, opts.Streams, dialer, (buildclient.CredentialLookup)(opts.Credentials), secrets, build)
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -17,6 +18,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/acorn-io/runtime/pkg/streams"
 	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/sigstore/sigstore/pkg/signature"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/rest"
@@ -90,6 +92,8 @@ type AppUpdateOptions struct {
 	Secrets             []v1.SecretBinding
 	Links               []v1.ServiceBinding
 	Publish             []v1.PortBinding
+	Routes              []v1.RouteBinding
+	Egress              []v1.EgressBinding
 	Env                 []v1.NameValue
 	Profiles            []string
 	Permissions         []v1.Permissions
@@ -119,6 +123,8 @@ type AppRunOptions struct {
 	Secrets             []v1.SecretBinding
 	Links               []v1.ServiceBinding
 	Publish             []v1.PortBinding
+	Routes              []v1.RouteBinding
+	Egress              []v1.EgressBinding
 	Env                 []v1.NameValue
 	Profiles            []string
 	TargetNamespace     string
@@ -141,6 +147,8 @@ func (a AppRunOptions) ToUpdate() AppUpdateOptions {
 		Secrets:             a.Secrets,
 		Links:               a.Links,
 		Publish:             a.Publish,
+		Routes:              a.Routes,
+		Egress:              a.Egress,
 		DeployArgs:          a.DeployArgs,
 		Stop:                a.Stop,
 		Profiles:            a.Profiles,
@@ -165,6 +173,8 @@ func (a AppUpdateOptions) ToRun() AppRunOptions {
 		Secrets:             a.Secrets,
 		Links:               a.Links,
 		Publish:             a.Publish,
+		Routes:              a.Routes,
+		Egress:              a.Egress,
 		DeployArgs:          a.DeployArgs,
 		Profiles:            a.Profiles,
 		Permissions:         a.Permissions,
@@ -186,10 +196,11 @@ type ImageProgress struct {
 }
 
 type ImageDetails struct {
-	AppImage   v1.AppImage   `json:"appImage,omitempty"`
-	AppSpec    *v1.AppSpec   `json:"appSpec,omitempty"`
-	Params     *v1.ParamSpec `json:"params,omitempty"`
-	ParseError string        `json:"parseError,omitempty"`
+	AppImage     v1.AppImage           `json:"appImage,omitempty"`
+	AppSpec      *v1.AppSpec           `json:"appSpec,omitempty"`
+	Params       *v1.ParamSpec         `json:"params,omitempty"`
+	ParseError   string                `json:"parseError,omitempty"`
+	Attestations []v1.ImageAttestation `json:"attestations,omitempty"`
 }
 
 type PortForwardDialer func(ctx context.Context) (net.Conn, error)
@@ -222,6 +233,8 @@ type Client interface {
 	SecretReveal(ctx context.Context, name string) (*apiv1.Secret, error)
 	SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error)
 	SecretDelete(ctx context.Context, name string) (*apiv1.Secret, error)
+	SecretHistory(ctx context.Context, name string) (*apiv1.SecretHistory, error)
+	SecretRollback(ctx context.Context, name string, version int) (*apiv1.Secret, error)
 
 	ContainerReplicaList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error)
 	ContainerReplicaGet(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
@@ -241,10 +254,16 @@ type Client interface {
 	ImageTag(ctx context.Context, image, tag string) error
 	ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (*ImageDetails, error)
 	ImageCopy(ctx context.Context, srcImage, destImage string, opts *ImageCopyOptions) (<-chan ImageProgress, error)
+	ImageSave(ctx context.Context, image string, w io.Writer, opts *ImageSaveOptions) error
+	ImageLoad(ctx context.Context, r io.Reader, opts *ImageLoadOptions) (<-chan ImageProgress, error)
+	ImageSign(ctx context.Context, image string, signer signature.Signer, opts *ImageSignOptions) (*apiv1.ImageSignature, error)
+	ImageVerify(ctx context.Context, image string, opts *ImageVerifyOptions) (*apiv1.ImageVerification, error)
+	ImagePrune(ctx context.Context, opts *ImagePruneOptions) (*apiv1.ImagePrune, error)
 
 	AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error)
 	AcornImageBuildList(ctx context.Context) ([]apiv1.AcornImageBuild, error)
 	AcornImageBuildDelete(ctx context.Context, name string) (*apiv1.AcornImageBuild, error)
+	AcornImageBuildLogs(ctx context.Context, name string) (*apiv1.AcornImageBuildLogs, error)
 	AcornImageBuild(ctx context.Context, file string, opts *AcornImageBuildOptions) (*v1.AppImage, error)
 
 	ProjectGet(ctx context.Context, name string) (*apiv1.Project, error)
@@ -252,6 +271,9 @@ type Client interface {
 	ProjectCreate(ctx context.Context, name, defaultRegion string, supportedRegions []string) (*apiv1.Project, error)
 	ProjectUpdate(ctx context.Context, project *apiv1.Project, defaultRegion string, supportedRegions []string) (*apiv1.Project, error)
 	ProjectDelete(ctx context.Context, name string) (*apiv1.Project, error)
+	ProjectKeys(ctx context.Context, name string) (*apiv1.ProjectKeys, error)
+	ProjectRotateKey(ctx context.Context, name string) (*apiv1.ProjectKeyRotation, error)
+	ProjectRetireKey(ctx context.Context, name, keyID string) (*apiv1.ProjectKeyRetirement, error)
 
 	VolumeClassList(ctx context.Context) ([]apiv1.VolumeClass, error)
 	VolumeClassGet(ctx context.Context, name string) (*apiv1.VolumeClass, error)
@@ -282,6 +304,19 @@ type AcornImageBuildOptions struct {
 	Args        map[string]any
 	Profiles    []string
 	Streams     *streams.Output
+	// Secrets are the secrets the client provides to the builds, in the form [BUILD:]id=ID[,src=FILE|env=VAR]
+	Secrets []string
+	// SSH are the SSH agents the client forwards to the builds, in the form [BUILD:]ID[=SOCKET]
+	SSH []string
+	// CacheFrom and CacheTo are the registry repositories the build cache is imported from and exported to
+	CacheFrom []string
+	CacheTo   []string
+	// SBOM and Provenance attach SBOMs of the container images and the provenance of the Acorn image to the image
+	SBOM       bool
+	Provenance bool
+	// VCS, if set, is the version control information of the build instead of the information of the directory of
+	// the Acornfile
+	VCS *v1.VCS
 }
 
 func (a *AcornImageBuildOptions) complete() (_ *AcornImageBuildOptions, err error) {
@@ -316,6 +351,39 @@ type ImageDetailsOptions struct {
 	Auth         *apiv1.RegistryAuth
 	// NoDefaultRegistry - if true, indicates that no default container registry should be assumed when getting image details
 	NoDefaultRegistry bool
+	// IncludeAttestations - if true, the SBOMs and provenance attached to the image are returned
+	IncludeAttestations bool
+}
+
+type ImageSaveOptions struct {
+	Auth *apiv1.RegistryAuth `json:"auth,omitempty"`
+}
+
+type ImageLoadOptions struct {
+	// Tag is the tag to give the loaded image, by default the name the image was saved with
+	Tag string `json:"tag,omitempty"`
+	// Target is the tag in a registry to push the image to, instead of loading it into the internal registry
+	Target string              `json:"target,omitempty"`
+	Auth   *apiv1.RegistryAuth `json:"auth,omitempty"`
+}
+
+type ImageSignOptions struct {
+	Auth        *apiv1.RegistryAuth `json:"auth,omitempty"`
+	Annotations map[string]string   `json:"annotations,omitempty"`
+}
+
+type ImageVerifyOptions struct {
+	// Key is the public key the image has to be signed with. If empty, the image is checked against the ImageAllowRules.
+	Key         string                  `json:"key,omitempty"`
+	Annotations v1.SignatureAnnotations `json:"annotations,omitempty"`
+}
+
+type ImagePruneOptions struct {
+	// All prunes tagged images too, not only untagged images
+	All bool `json:"all,omitempty"`
+	// OlderThan only prunes the images created longer ago than this duration
+	OlderThan string `json:"olderThan,omitempty"`
+	DryRun    bool   `json:"dryRun,omitempty"`
 }
 
 type ImageDeleteOptions struct {
@@ -2,11 +2,13 @@ package client
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/client/term"
+	"github.com/sigstore/sigstore/pkg/signature"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -192,6 +194,20 @@ func (d *DeferredClient) SecretUpdate(ctx context.Context, name string, data map
 	return d.Client.SecretUpdate(ctx, name, data)
 }
 
+func (d *DeferredClient) SecretHistory(ctx context.Context, name string) (*apiv1.SecretHistory, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretHistory(ctx, name)
+}
+
+func (d *DeferredClient) SecretRollback(ctx context.Context, name string, version int) (*apiv1.Secret, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretRollback(ctx, name, version)
+}
+
 func (d *DeferredClient) SecretDelete(ctx context.Context, name string) (*apiv1.Secret, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -311,6 +327,41 @@ func (d *DeferredClient) ImageCopy(ctx context.Context, srcImage, dstImage strin
 	return d.Client.ImageCopy(ctx, srcImage, dstImage, opts)
 }
 
+func (d *DeferredClient) ImageSave(ctx context.Context, image string, w io.Writer, opts *ImageSaveOptions) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.ImageSave(ctx, image, w, opts)
+}
+
+func (d *DeferredClient) ImageLoad(ctx context.Context, r io.Reader, opts *ImageLoadOptions) (<-chan ImageProgress, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageLoad(ctx, r, opts)
+}
+
+func (d *DeferredClient) ImageSign(ctx context.Context, image string, signer signature.Signer, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageSign(ctx, image, signer, opts)
+}
+
+func (d *DeferredClient) ImageVerify(ctx context.Context, image string, opts *ImageVerifyOptions) (*apiv1.ImageVerification, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageVerify(ctx, image, opts)
+}
+
+func (d *DeferredClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) (*apiv1.ImagePrune, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImagePrune(ctx, opts)
+}
+
 func (d *DeferredClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -332,6 +383,13 @@ func (d *DeferredClient) AcornImageBuildDelete(ctx context.Context, name string)
 	return d.Client.AcornImageBuildDelete(ctx, name)
 }
 
+func (d *DeferredClient) AcornImageBuildLogs(ctx context.Context, name string) (*apiv1.AcornImageBuildLogs, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AcornImageBuildLogs(ctx, name)
+}
+
 func (d *DeferredClient) AcornImageBuild(ctx context.Context, file string, opts *AcornImageBuildOptions) (*v1.AppImage, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -367,6 +425,27 @@ func (d *DeferredClient) ProjectUpdate(ctx context.Context, project *apiv1.Proje
 	return d.Client.ProjectUpdate(ctx, project, defaultRegion, supportedRegions)
 }
 
+func (d *DeferredClient) ProjectKeys(ctx context.Context, name string) (*apiv1.ProjectKeys, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ProjectKeys(ctx, name)
+}
+
+func (d *DeferredClient) ProjectRotateKey(ctx context.Context, name string) (*apiv1.ProjectKeyRotation, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ProjectRotateKey(ctx, name)
+}
+
+func (d *DeferredClient) ProjectRetireKey(ctx context.Context, name, keyID string) (*apiv1.ProjectKeyRetirement, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ProjectRetireKey(ctx, name, keyID)
+}
+
 func (d *DeferredClient) ProjectDelete(ctx context.Context, name string) (*apiv1.Project, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -273,6 +273,14 @@ func (c IgnoreUninstalled) SecretDelete(ctx context.Context, name string) (*apiv
 	return c.Client.SecretDelete(ctx, name)
 }
 
+func (c IgnoreUninstalled) SecretHistory(ctx context.Context, name string) (*apiv1.SecretHistory, error) {
+	return c.Client.SecretHistory(ctx, name)
+}
+
+func (c IgnoreUninstalled) SecretRollback(ctx context.Context, name string, version int) (*apiv1.Secret, error) {
+	return c.Client.SecretRollback(ctx, name, version)
+}
+
 func (c *IgnoreUninstalled) ProjectGet(ctx context.Context, name string) (*apiv1.Project, error) {
 	return c.Client.ProjectGet(ctx, name)
 }
@@ -293,6 +301,20 @@ func (c *IgnoreUninstalled) ProjectUpdate(ctx context.Context, project *apiv1.Pr
 	})
 }
 
+func (c *IgnoreUninstalled) ProjectKeys(ctx context.Context, name string) (*apiv1.ProjectKeys, error) {
+	return ignoreUninstalled(c.Client.ProjectKeys(ctx, name))
+}
+
+func (c *IgnoreUninstalled) ProjectRotateKey(ctx context.Context, name string) (*apiv1.ProjectKeyRotation, error) {
+	return promptInstall(ctx, func() (*apiv1.ProjectKeyRotation, error) {
+		return c.Client.ProjectRotateKey(ctx, name)
+	})
+}
+
+func (c *IgnoreUninstalled) ProjectRetireKey(ctx context.Context, name, keyID string) (*apiv1.ProjectKeyRetirement, error) {
+	return ignoreUninstalled(c.Client.ProjectRetireKey(ctx, name, keyID))
+}
+
 func (c *IgnoreUninstalled) ProjectDelete(ctx context.Context, name string) (*apiv1.Project, error) {
 	return ignoreUninstalled(c.Client.ProjectDelete(ctx, name))
 }
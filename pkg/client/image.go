@@ -1,9 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/acorn-io/baaah/pkg/router"
@@ -12,6 +16,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/images"
 	kclient "github.com/acorn-io/runtime/pkg/k8sclient"
 	"github.com/gorilla/websocket"
+	"github.com/sigstore/sigstore/pkg/signature"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/strings/slices"
@@ -45,6 +50,7 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 		detailsResult.NestedDigest = opts.NestedDigest
 		detailsResult.Auth = opts.Auth
 		detailsResult.NoDefaultRegistry = opts.NoDefaultRegistry
+		detailsResult.IncludeAttestations = opts.IncludeAttestations
 	}
 
 	err := c.RESTClient.Post().
@@ -59,13 +65,207 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 	}
 
 	return &ImageDetails{
-		AppImage:   detailsResult.AppImage,
-		AppSpec:    detailsResult.AppSpec,
-		Params:     detailsResult.Params,
-		ParseError: detailsResult.ParseError,
+		AppImage:     detailsResult.AppImage,
+		AppSpec:      detailsResult.AppSpec,
+		Params:       detailsResult.Params,
+		ParseError:   detailsResult.ParseError,
+		Attestations: detailsResult.Attestations,
 	}, nil
 }
 
+func (c *DefaultClient) ImageSave(ctx context.Context, imageName string, w io.Writer, opts *ImageSaveOptions) error {
+	body := &apiv1.ImageSave{}
+	if opts != nil {
+		body.Auth = opts.Auth
+	}
+
+	url := c.RESTClient.Get().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(imageName, "/", "+")).
+		SubResource("save").
+		URL()
+
+	conn, _, err := c.Dialer.DialWebsocket(ctx, url.String(), nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(body); err != nil {
+		return err
+	}
+
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if messageType != websocket.BinaryMessage {
+			continue
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *DefaultClient) ImageLoad(ctx context.Context, r io.Reader, opts *ImageLoadOptions) (<-chan ImageProgress, error) {
+	body := &apiv1.ImageLoad{}
+	if opts != nil {
+		body.Tag = opts.Tag
+		body.Target = opts.Target
+		body.Auth = opts.Auth
+	}
+
+	url := c.RESTClient.Get().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name("archive").
+		SubResource("load").
+		URL()
+
+	conn, _, err := c.Dialer.DialWebsocket(ctx, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.WriteJSON(body); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	// The archive is sent in binary messages, ended by an empty one
+	buf := make([]byte, 1<<20)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if err := conn.WriteMessage(websocket.BinaryMessage, buf[:n]); err != nil {
+				conn.Close()
+				return nil, err
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, []byte{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	result := make(chan ImageProgress, 1000)
+	go func() {
+		defer close(result)
+		defer conn.Close()
+		for {
+			_, data, err := conn.ReadMessage()
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				break
+			} else if err != nil {
+				result <- ImageProgress{
+					Error: err.Error(),
+				}
+				break
+			}
+
+			progress := ImageProgress{}
+			if err := json.Unmarshal(data, &progress); err == nil {
+				result <- progress
+			} else {
+				result <- ImageProgress{
+					Error: err.Error(),
+				}
+			}
+		}
+	}()
+
+	return result, nil
+}
+
+func (c *DefaultClient) ImageSign(ctx context.Context, imageName string, signer signature.Signer, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
+	body := &apiv1.ImageSignature{}
+	if opts != nil {
+		body.Auth = opts.Auth
+		body.Annotations = opts.Annotations
+	}
+
+	// The server returns the payloads of the image and the images in it, they are signed here so that the key
+	// never leaves the client
+	payloads := &apiv1.ImageSignature{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(imageName, "/", "+")).
+		SubResource("sign").
+		Body(body).
+		Do(ctx).Into(payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, payload := range payloads.Signatures {
+		sig, err := signer.SignMessage(bytes.NewReader(payload.Payload))
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign %s: %w", payload.Digest, err)
+		}
+		payload.Signature = base64.StdEncoding.EncodeToString(sig)
+		body.Signatures = append(body.Signatures, payload)
+	}
+
+	result := &apiv1.ImageSignature{}
+	err = c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(imageName, "/", "+")).
+		SubResource("sign").
+		Body(body).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+func (c *DefaultClient) ImageVerify(ctx context.Context, imageName string, opts *ImageVerifyOptions) (*apiv1.ImageVerification, error) {
+	body := &apiv1.ImageVerification{}
+	if opts != nil {
+		body.Key = opts.Key
+		body.Annotations = opts.Annotations
+	}
+
+	result := &apiv1.ImageVerification{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(imageName, "/", "+")).
+		SubResource("verify").
+		Body(body).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+func (c *DefaultClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) (*apiv1.ImagePrune, error) {
+	body := &apiv1.ImagePrune{}
+	if opts != nil {
+		body.All = opts.All
+		body.OlderThan = opts.OlderThan
+		body.DryRun = opts.DryRun
+	}
+
+	result := &apiv1.ImagePrune{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name("project").
+		SubResource("prune").
+		Body(body).
+		Do(ctx).Into(result)
+	return result, err
+}
+
 func (c *DefaultClient) ImageCopy(ctx context.Context, srcImage, dstImage string, opts *ImageCopyOptions) (<-chan ImageProgress, error) {
 	body := &apiv1.ImageCopy{
 		Source: srcImage,
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"reflect"
 	"sort"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/channels"
 	"github.com/acorn-io/runtime/pkg/client/term"
 	"github.com/acorn-io/runtime/pkg/streams"
+	"github.com/sigstore/sigstore/pkg/signature"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -293,6 +295,18 @@ func (m *MultiClient) SecretUpdate(ctx context.Context, name string, data map[st
 	})
 }
 
+func (m *MultiClient) SecretHistory(ctx context.Context, name string) (*apiv1.SecretHistory, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.SecretHistory, error) {
+		return c.SecretHistory(ctx, name)
+	})
+}
+
+func (m *MultiClient) SecretRollback(ctx context.Context, name string, version int) (*apiv1.Secret, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.Secret, error) {
+		return c.SecretRollback(ctx, name, version)
+	})
+}
+
 func (m *MultiClient) SecretDelete(ctx context.Context, name string) (*apiv1.Secret, error) {
 	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.Secret, error) {
 		return c.SecretDelete(ctx, name)
@@ -421,6 +435,46 @@ func (m *MultiClient) ImageCopy(ctx context.Context, srcImage, dstImage string,
 	return c.ImageCopy(ctx, srcImage, dstImage, opts)
 }
 
+func (m *MultiClient) ImageSave(ctx context.Context, image string, w io.Writer, opts *ImageSaveOptions) error {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return err
+	}
+	return c.ImageSave(ctx, image, w, opts)
+}
+
+func (m *MultiClient) ImageLoad(ctx context.Context, r io.Reader, opts *ImageLoadOptions) (<-chan ImageProgress, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageLoad(ctx, r, opts)
+}
+
+func (m *MultiClient) ImageSign(ctx context.Context, image string, signer signature.Signer, opts *ImageSignOptions) (*apiv1.ImageSignature, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageSign(ctx, image, signer, opts)
+}
+
+func (m *MultiClient) ImageVerify(ctx context.Context, image string, opts *ImageVerifyOptions) (*apiv1.ImageVerification, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageVerify(ctx, image, opts)
+}
+
+func (m *MultiClient) ImagePrune(ctx context.Context, opts *ImagePruneOptions) (*apiv1.ImagePrune, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImagePrune(ctx, opts)
+}
+
 func (m *MultiClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -445,6 +499,14 @@ func (m *MultiClient) AcornImageBuildDelete(ctx context.Context, name string) (*
 	return c.AcornImageBuildDelete(ctx, name)
 }
 
+func (m *MultiClient) AcornImageBuildLogs(ctx context.Context, name string) (*apiv1.AcornImageBuildLogs, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.AcornImageBuildLogs(ctx, name)
+}
+
 func (m *MultiClient) AcornImageBuild(ctx context.Context, file string, opts *AcornImageBuildOptions) (result *v1.AppImage, err error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -479,6 +541,30 @@ func (m *MultiClient) ProjectDelete(ctx context.Context, name string) (*apiv1.Pr
 	return c.ProjectDelete(ctx, name)
 }
 
+func (m *MultiClient) ProjectKeys(ctx context.Context, name string) (*apiv1.ProjectKeys, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ProjectKeys(ctx, name)
+}
+
+func (m *MultiClient) ProjectRotateKey(ctx context.Context, name string) (*apiv1.ProjectKeyRotation, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ProjectRotateKey(ctx, name)
+}
+
+func (m *MultiClient) ProjectRetireKey(ctx context.Context, name, keyID string) (*apiv1.ProjectKeyRetirement, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ProjectRetireKey(ctx, name, keyID)
+}
+
 func (m *MultiClient) ProjectCreate(ctx context.Context, name, defaultRegion string, supportedRegions []string) (*apiv1.Project, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
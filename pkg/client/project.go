@@ -78,3 +78,36 @@ func (c *DefaultClient) ProjectDelete(ctx context.Context, name string) (*apiv1.
 	}
 	return project, c.Client.Delete(ctx, project)
 }
+
+func (c *DefaultClient) ProjectKeys(ctx context.Context, name string) (*apiv1.ProjectKeys, error) {
+	result := &apiv1.ProjectKeys{}
+	err := c.RESTClient.Get().
+		Resource("projects").
+		Name(name).
+		SubResource("keys").
+		Do(ctx).Into(result)
+	return result, err
+}
+
+func (c *DefaultClient) ProjectRotateKey(ctx context.Context, name string) (*apiv1.ProjectKeyRotation, error) {
+	result := &apiv1.ProjectKeyRotation{}
+	err := c.RESTClient.Post().
+		Resource("projects").
+		Name(name).
+		SubResource("rotatekey").
+		Body(&apiv1.ProjectKeyRotation{}).
+		Do(ctx).Into(result)
+	return result, err
+}
+
+func (c *DefaultClient) ProjectRetireKey(ctx context.Context, name, keyID string) (*apiv1.ProjectKeyRetirement, error) {
+	result := &apiv1.ProjectKeyRetirement{}
+	err := c.RESTClient.Post().
+		Resource("projects").
+		Name(name).
+		SubResource("retirekey").
+		Body(&apiv1.ProjectKeyRetirement{
+			KeyID: keyID,
+		}).Do(ctx).Into(result)
+	return result, err
+}
@@ -60,6 +60,32 @@ func (c *DefaultClient) SecretUpdate(ctx context.Context, name string, data map[
 	return secret, c.Client.Update(ctx, secret)
 }
 
+func (c *DefaultClient) SecretHistory(ctx context.Context, name string) (*apiv1.SecretHistory, error) {
+	result := &apiv1.SecretHistory{}
+	err := c.RESTClient.Get().
+		Namespace(c.Namespace).
+		Resource("secrets").
+		Name(name).
+		SubResource("history").
+		Do(ctx).Into(result)
+	return result, err
+}
+
+func (c *DefaultClient) SecretRollback(ctx context.Context, name string, version int) (*apiv1.Secret, error) {
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("secrets").
+		Name(name).
+		SubResource("rollback").
+		Body(&apiv1.SecretRollback{
+			Version: version,
+		}).Do(ctx).Error()
+	if err != nil {
+		return nil, err
+	}
+	return c.SecretGet(ctx, name)
+}
+
 func (c *DefaultClient) SecretList(ctx context.Context) ([]apiv1.Secret, error) {
 	result := &apiv1.SecretList{}
 	err := c.Client.List(ctx, result, &kclient.ListOptions{
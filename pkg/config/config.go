@@ -75,6 +75,9 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader, inclu
 	if c.PublishBuilders == nil {
 		c.PublishBuilders = profile.PublishBuilders
 	}
+	if c.SecretHistoryLimit == nil {
+		c.SecretHistoryLimit = profile.SecretHistoryLimit
+	}
 	if c.BuilderPerProject == nil {
 		c.BuilderPerProject = profile.BuilderPerProject
 	}
@@ -87,6 +90,9 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader, inclu
 	if c.WorkloadMemoryMaximum == nil {
 		c.WorkloadMemoryMaximum = profile.WorkloadMemoryMaximum
 	}
+	if c.ImageLoadMaximumSize == nil {
+		c.ImageLoadMaximumSize = profile.ImageLoadMaximumSize
+	}
 	if c.InternalRegistryPrefix == nil {
 		c.InternalRegistryPrefix = profile.InternalRegistryPrefix
 	}
@@ -105,6 +111,12 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader, inclu
 	if c.IngressControllerNamespace == nil {
 		c.IngressControllerNamespace = profile.IngressControllerNamespace
 	}
+	if c.DenyEgressByDefault == nil {
+		c.DenyEgressByDefault = profile.DenyEgressByDefault
+	}
+	if c.ACMEDirectoryURL == nil {
+		c.ACMEDirectoryURL = profile.ACMEDirectoryURL
+	}
 	if c.AWSIdentityProviderARN == nil {
 		c.AWSIdentityProviderARN = profile.AWSIdentityProviderARN
 	}
@@ -150,6 +162,18 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader, inclu
 	if c.CertManagerIssuer == nil {
 		c.CertManagerIssuer = profile.CertManagerIssuer
 	}
+	if c.Gateway == nil {
+		c.Gateway = profile.Gateway
+	}
+	if c.ServiceLBMode == nil || *c.ServiceLBMode == "" {
+		c.ServiceLBMode = profile.ServiceLBMode
+	}
+	if c.SharedLBPortRange == nil || *c.SharedLBPortRange == "" {
+		c.SharedLBPortRange = profile.SharedLBPortRange
+	}
+	if c.SharedLBAddress == nil {
+		c.SharedLBAddress = profile.SharedLBAddress
+	}
 	return nil
 }
 
@@ -351,6 +375,9 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 	if newConfig.PublishBuilders != nil {
 		mergedConfig.PublishBuilders = newConfig.PublishBuilders
 	}
+	if newConfig.SecretHistoryLimit != nil {
+		mergedConfig.SecretHistoryLimit = newConfig.SecretHistoryLimit
+	}
 	if newConfig.BuilderPerProject != nil {
 		mergedConfig.BuilderPerProject = newConfig.BuilderPerProject
 	}
@@ -363,6 +390,9 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 	if newConfig.WorkloadMemoryMaximum != nil {
 		mergedConfig.WorkloadMemoryMaximum = newConfig.WorkloadMemoryMaximum
 	}
+	if newConfig.ImageLoadMaximumSize != nil {
+		mergedConfig.ImageLoadMaximumSize = newConfig.ImageLoadMaximumSize
+	}
 	if newConfig.UseCustomCABundle != nil {
 		mergedConfig.UseCustomCABundle = newConfig.UseCustomCABundle
 	}
@@ -372,15 +402,36 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 	if newConfig.IngressControllerNamespace != nil {
 		mergedConfig.IngressControllerNamespace = newConfig.IngressControllerNamespace
 	}
+	if newConfig.DenyEgressByDefault != nil {
+		mergedConfig.DenyEgressByDefault = newConfig.DenyEgressByDefault
+	}
+	if newConfig.ACMEDirectoryURL != nil {
+		mergedConfig.ACMEDirectoryURL = newConfig.ACMEDirectoryURL
+	}
 	if newConfig.AWSIdentityProviderARN != nil {
 		mergedConfig.AWSIdentityProviderARN = newConfig.AWSIdentityProviderARN
 	}
 	if newConfig.EventTTL != nil {
 		mergedConfig.EventTTL = newConfig.EventTTL
 	}
+	if newConfig.AuditEventTTL != nil {
+		mergedConfig.AuditEventTTL = newConfig.AuditEventTTL
+	}
 	if newConfig.CertManagerIssuer != nil {
 		mergedConfig.CertManagerIssuer = newConfig.CertManagerIssuer
 	}
+	if newConfig.Gateway != nil {
+		mergedConfig.Gateway = newConfig.Gateway
+	}
+	if newConfig.ServiceLBMode != nil {
+		mergedConfig.ServiceLBMode = newConfig.ServiceLBMode
+	}
+	if newConfig.SharedLBPortRange != nil {
+		mergedConfig.SharedLBPortRange = newConfig.SharedLBPortRange
+	}
+	if newConfig.SharedLBAddress != nil {
+		mergedConfig.SharedLBAddress = newConfig.SharedLBAddress
+	}
 	if newConfig.Features != nil {
 		mergedConfig.Features = newConfig.Features
 	}
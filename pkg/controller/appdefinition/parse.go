@@ -34,6 +34,16 @@ func ParseAppImage(req router.Request, resp router.Response) error {
 		return nil
 	}
 
+	if err := appSpec.BindRoutes(appInstance.Spec.Routes); err != nil {
+		status.Error(err)
+		return nil
+	}
+
+	if err := appSpec.BindEgress(appInstance.Spec.Egress); err != nil {
+		status.Error(err)
+		return nil
+	}
+
 	appInstance.Status.AppSpec = *appSpec
 	status.Success()
 	return nil
@@ -3,6 +3,8 @@ package appdefinition
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -49,7 +51,10 @@ func toRouter(appInstance *v1.AppInstance, routerName string, router v1.Router)
 		return nil, nil
 	}
 
-	conf, confName := toNginxConf(routerName, router)
+	conf, confName, err := toNginxConf(routerName, router)
+	if err != nil {
+		return nil, fmt.Errorf("router [%s]: %w", routerName, err)
+	}
 
 	podLabels := routerLabels(appInstance, router, routerName, labels.AcornAppPublicName, publicname.Get(appInstance))
 	deploymentLabels := routerLabels(appInstance, router, routerName)
@@ -164,51 +169,140 @@ func toRouter(appInstance *v1.AppInstance, routerName string, router v1.Router)
 	}, nil
 }
 
-func toNginxConf(routerName string, router v1.Router) (string, string) {
+var (
+	headerNameRegexp = regexp.MustCompile("^[A-Za-z0-9-]+$")
+	queryNameRegexp  = regexp.MustCompile("^[A-Za-z0-9_]+$")
+	// Values are written as quoted nginx strings, these characters can't be escaped in them
+	invalidMatchValueChars = "\"$\\"
+)
+
+func toNginxConf(routerName string, router v1.Router) (string, string, error) {
 	buf := &strings.Builder{}
-	buf.WriteString("server {\nlisten 8080;\n")
-	for _, route := range router.Routes {
-		if route.TargetServiceName == "" || route.Path == "" {
+
+	var (
+		advanced = map[string]bool{}
+		groups   = map[string][]int{}
+		keys     []string
+	)
+	for i, route := range router.Routes {
+		if route.Path == "" || len(route.WeightedTargets()) == 0 {
+			continue
+		}
+		key := string(route.PathType) + " " + route.Path
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], i)
+		advanced[key] = advanced[key] || route.HasAdvancedRouting() || len(groups[key]) > 1
+	}
+
+	for _, key := range keys {
+		if !advanced[key] {
 			continue
 		}
-		port := 80
-		if route.TargetPort != 0 {
-			port = route.TargetPort
+		for _, i := range groups[key] {
+			buf.WriteString("upstream route_")
+			buf.WriteString(strconv.Itoa(i))
+			buf.WriteString(" {\n")
+			for _, target := range router.Routes[i].WeightedTargets() {
+				buf.WriteString("  server ")
+				buf.WriteString(target.TargetServiceName)
+				buf.WriteString(":")
+				buf.WriteString(strconv.Itoa(targetPort(target.TargetPort)))
+				buf.WriteString(" weight=")
+				buf.WriteString(strconv.Itoa(target.Weight))
+				buf.WriteString(";\n")
+			}
+			buf.WriteString("}\n")
 		}
-		buf.WriteString("location ")
-		buf.WriteString("= ")
-		buf.WriteString(route.Path)
-		buf.WriteString(" {\n  proxy_pass ")
-		buf.WriteString("http://")
-		buf.WriteString(route.TargetServiceName)
-		buf.WriteString(":")
-		buf.WriteString(strconv.Itoa(port))
-		buf.WriteString(";\n}\n")
+	}
+
+	buf.WriteString("server {\nlisten 8080;\n")
+	for _, key := range keys {
+		route := router.Routes[groups[key][0]]
+		body := ""
+		if advanced[key] {
+			var err error
+			body, err = matchRoutes(router.Routes, groups[key])
+			if err != nil {
+				return "", "", fmt.Errorf("invalid route %s: %w", route.Path, err)
+			}
+		} else {
+			target := route.WeightedTargets()[0]
+			body = "  proxy_pass http://" + target.TargetServiceName + ":" + strconv.Itoa(targetPort(target.TargetPort)) + ";\n"
+		}
+
+		writeLocation(buf, "= "+route.Path, body)
 		if route.PathType == v1.PathTypePrefix && !strings.HasSuffix(route.Path, "/") {
-			buf.WriteString("location ")
-			buf.WriteString(route.Path)
-			buf.WriteString("/")
-			buf.WriteString(" {\n  proxy_pass ")
-			buf.WriteString("http://")
-			buf.WriteString(route.TargetServiceName)
-			buf.WriteString(":")
-			buf.WriteString(strconv.Itoa(port))
-			buf.WriteString(";\n}\n")
+			writeLocation(buf, route.Path+"/", body)
 		}
 		if route.PathType == v1.PathTypePrefix && route.Path == "/" {
-			buf.WriteString("location ")
-			buf.WriteString("/")
-			buf.WriteString(" {\n  proxy_pass ")
-			buf.WriteString("http://")
-			buf.WriteString(route.TargetServiceName)
-			buf.WriteString(":")
-			buf.WriteString(strconv.Itoa(port))
-			buf.WriteString(";\n}\n")
+			writeLocation(buf, "/", body)
 		}
 	}
 	buf.WriteString("}\n")
 
 	conf := buf.String()
 	hash := sha256.Sum256([]byte(conf))
-	return conf, name2.SafeConcatName(routerName, hex.EncodeToString(hash[:])[:8])
+	return conf, name2.SafeConcatName(routerName, hex.EncodeToString(hash[:])[:8]), nil
+}
+
+func writeLocation(buf *strings.Builder, location, body string) {
+	buf.WriteString("location ")
+	buf.WriteString(location)
+	buf.WriteString(" {\n")
+	buf.WriteString(body)
+	buf.WriteString("}\n")
+}
+
+// matchRoutes returns the body of a location that selects the upstream of the first route matching the request.
+// The routes are evaluated in reverse order, so the upstream of an earlier route overrides the later ones. A route
+// matches if all of its conditions match, which is counted in $route_match because nginx can't combine conditions.
+func matchRoutes(routes []v1.Route, indexes []int) (string, error) {
+	buf := &strings.Builder{}
+	buf.WriteString("  set $route_upstream \"\";\n")
+	for i := len(indexes) - 1; i >= 0; i-- {
+		route := routes[indexes[i]]
+		upstream := "route_" + strconv.Itoa(indexes[i])
+
+		var conditions []string
+		for _, header := range route.Headers {
+			if !headerNameRegexp.MatchString(header.Name) {
+				return "", fmt.Errorf("invalid header name %q", header.Name)
+			}
+			conditions = append(conditions, "$http_"+strings.ReplaceAll(strings.ToLower(header.Name), "-", "_")+" = \""+header.Value+"\"")
+		}
+		for _, param := range route.QueryParams {
+			if !queryNameRegexp.MatchString(param.Name) {
+				return "", fmt.Errorf("invalid query parameter name %q", param.Name)
+			}
+			conditions = append(conditions, "$arg_"+param.Name+" = \""+param.Value+"\"")
+		}
+		for _, match := range append(route.Headers, route.QueryParams...) {
+			if strings.ContainsAny(match.Value, invalidMatchValueChars) {
+				return "", fmt.Errorf("value of %s must not contain any of %s", match.Name, invalidMatchValueChars)
+			}
+		}
+
+		if len(conditions) == 0 {
+			buf.WriteString("  set $route_upstream \"" + upstream + "\";\n")
+			continue
+		}
+
+		buf.WriteString("  set $route_match \"\";\n")
+		for _, condition := range conditions {
+			buf.WriteString("  if (" + condition + ") {\n    set $route_match \"${route_match}x\";\n  }\n")
+		}
+		buf.WriteString("  if ($route_match = \"" + strings.Repeat("x", len(conditions)) + "\") {\n    set $route_upstream \"" + upstream + "\";\n  }\n")
+	}
+	buf.WriteString("  if ($route_upstream = \"\") {\n    return 404;\n  }\n")
+	buf.WriteString("  proxy_pass http://$route_upstream;\n")
+	return buf.String(), nil
+}
+
+func targetPort(port int) int {
+	if port == 0 {
+		return 80
+	}
+	return port
 }
@@ -172,6 +172,11 @@ func endpoints(req router.Request, app *v1.AppInstance) (string, error) {
 			} else {
 				buf.WriteString(endpoint.Address)
 			}
+			if message := endpointMessage(endpoint); message != "" {
+				buf.WriteString(" (")
+				buf.WriteString(message)
+				buf.WriteString(")")
+			}
 			publicStrings = append(publicStrings, buf.String())
 		}
 
@@ -181,3 +186,17 @@ func endpoints(req router.Request, app *v1.AppInstance) (string, error) {
 
 	return strings.Join(endpointStrings, ", "), nil
 }
+
+func endpointMessage(endpoint v1.Endpoint) string {
+	switch {
+	case endpoint.Message != "":
+		return endpoint.Message
+	case endpoint.Health == nil, endpoint.Health.Skipped:
+		return ""
+	case !endpoint.Health.Healthy():
+		return endpoint.Health.Error
+	case endpoint.Health.CertificateNotAfter != nil && endpoint.Health.CertificateDaysRemaining <= certificateExpiryWarningDays:
+		return fmt.Sprintf("certificate expires in %d days", endpoint.Health.CertificateDaysRemaining)
+	}
+	return ""
+}
@@ -0,0 +1,350 @@
+package appstatus
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	EndpointUnhealthyEventType           = "EndpointUnhealthy"
+	EndpointCertificateExpiringEventType = "EndpointCertificateExpiring"
+
+	// endpointCheckInterval is how often each published HTTP endpoint is checked
+	endpointCheckInterval = 5 * time.Minute
+	endpointCheckTimeout  = 10 * time.Second
+	// endpointCheckPollInterval is how often the app is requeued to write back the results of checks in progress
+	endpointCheckPollInterval = 5 * time.Second
+	// endpointCheckWorkers is how many endpoints are checked at the same time
+	endpointCheckWorkers = 10
+	// certificateExpiryWarningDays is how many days before its certificate expires an event is recorded for an endpoint
+	certificateExpiryWarningDays = 14
+)
+
+// EndpointHealthEventDetails captures the result of the check of an endpoint that degraded.
+type EndpointHealthEventDetails struct {
+	// Address is the address of the endpoint.
+	Address string `json:"address"`
+
+	// Health is the result of the check of the endpoint.
+	Health v1.EndpointHealth `json:"health"`
+}
+
+type endpointChecker struct {
+	recorder    event.Recorder
+	lookupHost  func(ctx context.Context, host string) ([]string, error)
+	dialContext func(ctx context.Context, network, address string) (net.Conn, error)
+	// roots are the CAs certificates are verified with, nil means the CAs of the system
+	roots *x509.CertPool
+	now   func() time.Time
+
+	// workers limits the number of endpoints checked at the same time
+	workers chan struct{}
+	// running tracks the checks in progress, so that tests can wait for them
+	running sync.WaitGroup
+	lock    sync.Mutex
+	// checks are the checks in progress and the results not written to the status of the app yet, by endpoint
+	checks map[string]*endpointCheck
+}
+
+type endpointCheck struct {
+	started time.Time
+	done    bool
+	health  *v1.EndpointHealth
+}
+
+func newEndpointChecker(recorder event.Recorder) *endpointChecker {
+	dialer := &net.Dialer{Timeout: endpointCheckTimeout}
+	return &endpointChecker{
+		recorder:    recorder,
+		lookupHost:  net.DefaultResolver.LookupHost,
+		dialContext: dialer.DialContext,
+		now:         time.Now,
+		workers:     make(chan struct{}, endpointCheckWorkers),
+		checks:      map[string]*endpointCheck{},
+	}
+}
+
+// CheckEndpointHealth periodically checks that the addresses of the published HTTP endpoints of the app resolve,
+// that the endpoints respond and that their certificates are valid. Events are recorded when an endpoint becomes
+// unhealthy or its certificate is about to expire. The endpoints are checked in the background, the handler
+// requeues the app until the results are in and writes them to the status of the app.
+func CheckEndpointHealth(recorder event.Recorder) router.HandlerFunc {
+	return checkEndpointHealth(newEndpointChecker(recorder))
+}
+
+func checkEndpointHealth(c *endpointChecker) router.HandlerFunc {
+	return func(req router.Request, resp router.Response) error {
+		app := req.Object.(*v1.AppInstance)
+		if app.Status.AppStatus.Stopped {
+			return nil
+		}
+
+		var (
+			now        = c.now()
+			nextCheck  = endpointCheckInterval
+			anyChecked bool
+			inProgress bool
+		)
+		for i, ep := range app.Status.AppStatus.Endpoints {
+			if ep.Protocol != v1.ProtocolHTTP || ep.Pending {
+				continue
+			}
+			anyChecked = true
+
+			if ep.Health != nil {
+				if untilCheck := endpointCheckInterval - now.Sub(ep.Health.LastChecked.Time); untilCheck > 0 {
+					if untilCheck < nextCheck {
+						nextCheck = untilCheck
+					}
+					continue
+				}
+			}
+
+			health, done := c.result(app, ep, now)
+			if !done {
+				inProgress = true
+				continue
+			}
+			c.recordEvents(req.Ctx, app, ep.Address, ep.Health, health)
+			app.Status.AppStatus.Endpoints[i].Health = health
+		}
+
+		if inProgress {
+			resp.RetryAfter(endpointCheckPollInterval)
+		} else if anyChecked {
+			resp.RetryAfter(nextCheck)
+		}
+		return nil
+	}
+}
+
+// result returns the result of the check of the endpoint if it is done, and otherwise starts the check if it is not
+// in progress yet. A result is only returned once.
+func (c *endpointChecker) result(app *v1.AppInstance, ep v1.Endpoint, now time.Time) (*v1.EndpointHealth, bool) {
+	key := app.Namespace + "/" + app.Name + "/" + ep.Address
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if check, ok := c.checks[key]; ok {
+		if check.done {
+			delete(c.checks, key)
+		}
+		return check.health, check.done
+	}
+
+	// Drop the results nobody picked up, like those of deleted apps
+	for key, check := range c.checks {
+		if check.done && now.Sub(check.started) > endpointCheckInterval {
+			delete(c.checks, key)
+		}
+	}
+
+	check := &endpointCheck{started: now}
+	c.checks[key] = check
+	c.running.Add(1)
+	go func() {
+		defer c.running.Done()
+
+		c.workers <- struct{}{}
+		defer func() { <-c.workers }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*endpointCheckTimeout)
+		defer cancel()
+		health := c.check(ctx, ep, now)
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		check.health = health
+		check.done = true
+	}()
+
+	return nil, false
+}
+
+// check checks the endpoint. Endpoints whose address resolves to a loopback, private or link-local address, like the
+// endpoints of local clusters or domains pointing at cluster internal addresses, aren't requested and are marked skipped.
+func (c *endpointChecker) check(ctx context.Context, ep v1.Endpoint, now time.Time) *v1.EndpointHealth {
+	health := &v1.EndpointHealth{
+		LastChecked: metav1.NewTime(now),
+	}
+
+	u, err := endpointURL(ep)
+	if err != nil {
+		health.Error = err.Error()
+		return health
+	}
+
+	addresses, err := c.lookupHost(ctx, u.Hostname())
+	if err != nil {
+		health.Error = fmt.Sprintf("failed to resolve %s: %v", u.Hostname(), err)
+		return health
+	}
+	if internalAddress(addresses) {
+		health.Skipped = true
+		return health
+	}
+	health.DNSResolved = true
+
+	ctx, cancel := context.WithTimeout(ctx, endpointCheckTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		health.Error = err.Error()
+		return health
+	}
+
+	// The certificate is verified below, so that the expiry of invalid certificates is known too
+	transport := &http.Transport{
+		DialContext:       c.dialResolved(addresses),
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+		DisableKeepAlives: true,
+	}
+	defer transport.CloseIdleConnections()
+
+	client := &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		health.Error = fmt.Sprintf("failed to reach %s: %v", u, err)
+		return health
+	}
+	defer httpResp.Body.Close()
+
+	var errs []string
+	if httpResp.TLS != nil && len(httpResp.TLS.PeerCertificates) > 0 {
+		cert := httpResp.TLS.PeerCertificates[0]
+		health.CertificateNotAfter = &metav1.Time{Time: cert.NotAfter}
+		health.CertificateDaysRemaining = int32(cert.NotAfter.Sub(now).Hours() / 24)
+
+		intermediates := x509.NewCertPool()
+		for _, intermediate := range httpResp.TLS.PeerCertificates[1:] {
+			intermediates.AddCert(intermediate)
+		}
+		if _, err := cert.Verify(x509.VerifyOptions{
+			DNSName:       u.Hostname(),
+			Roots:         c.roots,
+			Intermediates: intermediates,
+			CurrentTime:   now,
+		}); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid certificate: %v", err))
+		} else {
+			health.CertificateValid = true
+		}
+	}
+
+	// Errors of the app are fine, but errors of the ingress controller mean the app can't be reached
+	if httpResp.StatusCode >= http.StatusInternalServerError {
+		errs = append(errs, fmt.Sprintf("responded with %s", httpResp.Status))
+	} else {
+		health.Reachable = true
+	}
+
+	health.Error = strings.Join(errs, ", ")
+	return health
+}
+
+// recordEvents records an event if the endpoint became unhealthy or the expiry of its certificate got close
+func (c *endpointChecker) recordEvents(ctx context.Context, app *v1.AppInstance, address string, previous, current *v1.EndpointHealth) {
+	if current == nil || current.Skipped {
+		return
+	}
+
+	if !current.Healthy() && (previous == nil || previous.Skipped || previous.Healthy()) {
+		c.recordEvent(ctx, app, address, *current, EndpointUnhealthyEventType, v1.EventSeverityError,
+			fmt.Sprintf("Endpoint %s is unhealthy: %s", address, current.Error))
+	}
+
+	if current.CertificateNotAfter != nil && current.CertificateDaysRemaining <= certificateExpiryWarningDays &&
+		(previous == nil || previous.CertificateNotAfter == nil || previous.CertificateDaysRemaining > certificateExpiryWarningDays) {
+		c.recordEvent(ctx, app, address, *current, EndpointCertificateExpiringEventType, v1.EventSeverityInfo,
+			fmt.Sprintf("Certificate of endpoint %s expires in %d days", address, current.CertificateDaysRemaining))
+	}
+}
+
+func (c *endpointChecker) recordEvent(ctx context.Context, app *v1.AppInstance, address string, health v1.EndpointHealth, eventType string, severity v1.EventSeverity, description string) {
+	e := apiv1.Event{
+		Type:        eventType,
+		Severity:    severity,
+		Description: description,
+		AppName:     app.GetName(),
+		Resource:    event.Resource(app),
+		Observed:    v1.MicroTime(metav1.NewMicroTime(c.now())),
+	}
+	e.SetNamespace(app.GetNamespace())
+
+	var err error
+	if e.Details, err = v1.Mapify(EndpointHealthEventDetails{
+		Address: address,
+		Health:  health,
+	}); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := c.recorder.Record(ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
+
+func endpointURL(ep v1.Endpoint) (*url.URL, error) {
+	address := ep.Address
+	if !strings.Contains(address, "://") {
+		scheme := "http"
+		if ep.PublishProtocol == v1.PublishProtocolHTTPS {
+			scheme = "https"
+		}
+		address = scheme + "://" + address
+	}
+	return url.Parse(address)
+}
+
+// dialResolved dials the addresses that were checked by internalAddress instead of resolving the host again, so that
+// the request can't be sent to a different address
+func (c *endpointChecker) dialResolved(addresses []string) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		_, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		return c.dialContext(ctx, network, net.JoinHostPort(addresses[0], port))
+	}
+}
+
+// sharedAddressSpace is the range reserved for carrier-grade NAT, which some clusters use for their pod and service networks
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// internalAddress returns true if any of the addresses isn't a public address
+func internalAddress(addresses []string) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+			ip.IsUnspecified() || ip.IsMulticast() || sharedAddressSpace.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,270 @@
+package appstatus
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testChecker returns a checker that resolves every host to a public address and sends all requests to the server
+func testChecker(t *testing.T, server *httptest.Server, now time.Time, events *[]apiv1.Event) *endpointChecker {
+	t.Helper()
+
+	roots := x509.NewCertPool()
+	if server.Certificate() != nil {
+		roots.AddCert(server.Certificate())
+	}
+
+	dialer := &net.Dialer{}
+	c := newEndpointChecker(event.RecorderFunc(func(_ context.Context, e *apiv1.Event) error {
+		*events = append(*events, *e)
+		return nil
+	}))
+	c.lookupHost = func(_ context.Context, host string) ([]string, error) {
+		switch host {
+		case "local.example.com":
+			return []string{"127.0.0.1"}, nil
+		case "metadata.example.com":
+			return []string{"169.254.169.254"}, nil
+		case "internal.example.com":
+			return []string{"203.0.113.1", "10.43.0.10"}, nil
+		case "missing.example.com":
+			return nil, errors.New("no such host")
+		}
+		return []string{"203.0.113.1"}, nil
+	}
+	c.dialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	c.roots = roots
+	c.now = func() time.Time {
+		return now
+	}
+	return c
+}
+
+func endpointsApp(endpoints ...v1.Endpoint) *v1.AppInstance {
+	return &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "app-namespace",
+		},
+		Status: v1.AppInstanceStatus{
+			AppStatus: v1.AppStatus{
+				Endpoints: endpoints,
+			},
+		},
+	}
+}
+
+// runCheck runs the handler, and if it started checks, waits for them and runs the handler again to write the results
+func runCheck(t *testing.T, checker *endpointChecker, app *v1.AppInstance) *tester.Response {
+	t.Helper()
+	handler := checkEndpointHealth(checker)
+	resp := &tester.Response{}
+	require.NoError(t, handler(router.Request{Ctx: context.Background(), Object: app}, resp))
+	if resp.Delay == endpointCheckPollInterval {
+		checker.running.Wait()
+		resp = &tester.Response{}
+		require.NoError(t, handler(router.Request{Ctx: context.Background(), Object: app}, resp))
+	}
+	return resp
+}
+
+func TestCheckEndpointHealth(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	var events []apiv1.Event
+	now := time.Now()
+	app := endpointsApp(
+		v1.Endpoint{Address: "example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTPS},
+		v1.Endpoint{Address: "local.example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTPS},
+		v1.Endpoint{Address: "pending.example.com", Protocol: v1.ProtocolHTTP, Pending: true},
+		v1.Endpoint{Address: "example.com:5432", Protocol: v1.ProtocolTCP},
+	)
+
+	resp := runCheck(t, testChecker(t, server, now, &events), app)
+	assert.Equal(t, endpointCheckInterval, resp.Delay)
+
+	health := app.Status.AppStatus.Endpoints[0].Health
+	require.NotNil(t, health)
+	assert.True(t, health.Healthy(), health.Error)
+	assert.True(t, health.DNSResolved)
+	assert.True(t, health.Reachable)
+	assert.True(t, health.CertificateValid)
+	assert.Equal(t, server.Certificate().NotAfter.Unix(), health.CertificateNotAfter.Unix())
+	assert.Equal(t, int32(server.Certificate().NotAfter.Sub(now).Hours()/24), health.CertificateDaysRemaining)
+
+	health = app.Status.AppStatus.Endpoints[1].Health
+	require.NotNil(t, health)
+	assert.True(t, health.Skipped)
+	assert.Equal(t, now.Unix(), health.LastChecked.Unix())
+	assert.Empty(t, endpointMessage(app.Status.AppStatus.Endpoints[1]))
+
+	for _, ep := range app.Status.AppStatus.Endpoints[2:] {
+		assert.Nil(t, ep.Health, ep.Address)
+	}
+	assert.Empty(t, events)
+
+	// The endpoints aren't checked again until the interval passed
+	later := now.Add(time.Minute)
+	app.Status.AppStatus.Endpoints[0].Health.Reachable = false
+	resp = runCheck(t, testChecker(t, server, later, &events), app)
+	assert.Equal(t, endpointCheckInterval-time.Minute, resp.Delay)
+	assert.False(t, app.Status.AppStatus.Endpoints[0].Health.Reachable)
+	assert.Equal(t, now.Unix(), app.Status.AppStatus.Endpoints[1].Health.LastChecked.Unix())
+}
+
+func TestCheckEndpointHealthInternalAddress(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	var events []apiv1.Event
+	app := endpointsApp(
+		v1.Endpoint{Address: "metadata.example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTP},
+		v1.Endpoint{Address: "internal.example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTP},
+	)
+
+	runCheck(t, testChecker(t, server, time.Now(), &events), app)
+
+	for _, ep := range app.Status.AppStatus.Endpoints {
+		require.NotNil(t, ep.Health, ep.Address)
+		assert.True(t, ep.Health.Skipped, ep.Address)
+	}
+	assert.Zero(t, requests)
+	assert.Empty(t, events)
+}
+
+func TestCheckEndpointHealthDegraded(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var events []apiv1.Event
+	now := time.Now()
+	app := endpointsApp(
+		v1.Endpoint{Address: "example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTPS, Health: &v1.EndpointHealth{
+			LastChecked: metav1.NewTime(now.Add(-endpointCheckInterval)),
+			DNSResolved: true,
+			Reachable:   true,
+		}},
+		v1.Endpoint{Address: "missing.example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTP},
+	)
+
+	runCheck(t, testChecker(t, server, now, &events), app)
+
+	health := app.Status.AppStatus.Endpoints[0].Health
+	assert.False(t, health.Healthy())
+	assert.True(t, health.CertificateValid)
+	assert.Equal(t, "responded with 503 Service Unavailable", health.Error)
+
+	health = app.Status.AppStatus.Endpoints[1].Health
+	assert.False(t, health.DNSResolved)
+	assert.Equal(t, "failed to resolve missing.example.com: no such host", health.Error)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, EndpointUnhealthyEventType, events[0].Type)
+	assert.Equal(t, v1.EventSeverityError, events[0].Severity)
+	assert.Equal(t, "Endpoint example.com is unhealthy: responded with 503 Service Unavailable", events[0].Description)
+	assert.Equal(t, "example.com", events[0].Details["address"])
+	assert.Equal(t, EndpointUnhealthyEventType, events[1].Type)
+
+	// No new events while the endpoints stay unhealthy
+	events = nil
+	runCheck(t, testChecker(t, server, now.Add(endpointCheckInterval), &events), app)
+	assert.Empty(t, events)
+}
+
+func TestCheckEndpointHealthCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+
+	var events []apiv1.Event
+	app := endpointsApp(
+		v1.Endpoint{Address: "example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTPS},
+		v1.Endpoint{Address: "example.net", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTPS},
+	)
+
+	// Check the endpoints shortly before the certificate of the server expires
+	now := server.Certificate().NotAfter.Add(-10 * 24 * time.Hour)
+	runCheck(t, testChecker(t, server, now, &events), app)
+
+	health := app.Status.AppStatus.Endpoints[0].Health
+	assert.True(t, health.Healthy(), health.Error)
+	assert.Equal(t, int32(10), health.CertificateDaysRemaining)
+
+	// The certificate of the server isn't valid for example.net
+	health = app.Status.AppStatus.Endpoints[1].Health
+	assert.False(t, health.Healthy())
+	assert.False(t, health.CertificateValid)
+	assert.Contains(t, health.Error, "invalid certificate")
+
+	require.Len(t, events, 3)
+	assert.Equal(t, EndpointCertificateExpiringEventType, events[0].Type)
+	assert.Equal(t, v1.EventSeverityInfo, events[0].Severity)
+	assert.Equal(t, "Certificate of endpoint example.com expires in 10 days", events[0].Description)
+	assert.Equal(t, EndpointUnhealthyEventType, events[1].Type)
+	assert.Equal(t, EndpointCertificateExpiringEventType, events[2].Type)
+
+	assert.Equal(t, "certificate expires in 10 days", endpointMessage(app.Status.AppStatus.Endpoints[0]))
+	assert.Contains(t, endpointMessage(app.Status.AppStatus.Endpoints[1]), "invalid certificate")
+}
+
+// TestCheckEndpointHealthInBackground tests that the handler doesn't wait for the checks and picks up their results
+// when the app is requeued
+func TestCheckEndpointHealthInBackground(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+
+	var events []apiv1.Event
+	checker := testChecker(t, server, time.Now(), &events)
+	handler := checkEndpointHealth(checker)
+	app := endpointsApp(v1.Endpoint{Address: "example.com", Protocol: v1.ProtocolHTTP, PublishProtocol: v1.PublishProtocolHTTP})
+
+	// The endpoint doesn't respond yet, so the handler requeues the app without a result
+	resp := &tester.Response{}
+	require.NoError(t, handler(router.Request{Ctx: context.Background(), Object: app}, resp))
+	assert.Equal(t, endpointCheckPollInterval, resp.Delay)
+	assert.Nil(t, app.Status.AppStatus.Endpoints[0].Health)
+
+	// The check is still in progress, so no second check is started
+	resp = &tester.Response{}
+	require.NoError(t, handler(router.Request{Ctx: context.Background(), Object: app}, resp))
+	assert.Equal(t, endpointCheckPollInterval, resp.Delay)
+	assert.Len(t, checker.checks, 1)
+
+	close(release)
+	checker.running.Wait()
+
+	resp = &tester.Response{}
+	require.NoError(t, handler(router.Request{Ctx: context.Background(), Object: app}, resp))
+	assert.Equal(t, endpointCheckInterval, resp.Delay)
+	health := app.Status.AppStatus.Endpoints[0].Health
+	require.NotNil(t, health)
+	assert.True(t, health.Healthy(), health.Error)
+	assert.Empty(t, checker.checks)
+}
@@ -4,15 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"sort"
 	"strings"
 
+	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/gatewayapi"
+	"github.com/acorn-io/runtime/pkg/ipfamily"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publish"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	klabels "k8s.io/apimachinery/pkg/labels"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -30,6 +37,11 @@ func serviceEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance
 		return nil, err
 	}
 
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, service := range serviceList.Items {
 		containerName := service.Labels[labels.AcornContainerName]
 		if containerName == "" {
@@ -48,19 +60,35 @@ func serviceEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance
 				continue
 			}
 
+			if service.Spec.Type == corev1.ServiceTypeNodePort {
+				// Ports of the shared load balancer are allocated as NodePorts, see publish.ServiceLoadBalancer
+				address := *cfg.SharedLBAddress
+				if address == "" {
+					address = "<Pending Ingress>"
+				}
+				endpoints = append(endpoints, v1.Endpoint{
+					Target:     containerName,
+					TargetPort: port.TargetPort.IntVal,
+					Address:    ipfamily.JoinHostPort(address, port.NodePort),
+					Protocol:   protocol,
+					Pending:    *cfg.SharedLBAddress == "" || port.NodePort == 0,
+				})
+				continue
+			}
+
 			for _, ingress := range service.Status.LoadBalancer.Ingress {
 				if ingress.Hostname != "" {
 					endpoints = append(endpoints, v1.Endpoint{
 						Target:     containerName,
 						TargetPort: port.TargetPort.IntVal,
-						Address:    fmt.Sprintf("%s:%d", ingress.Hostname, port.Port),
+						Address:    ipfamily.JoinHostPort(ingress.Hostname, port.Port),
 						Protocol:   protocol,
 					})
 				} else if ingress.IP != "" {
 					endpoints = append(endpoints, v1.Endpoint{
 						Target:     containerName,
 						TargetPort: port.TargetPort.IntVal,
-						Address:    fmt.Sprintf("%s:%d", ingress.IP, port.Port),
+						Address:    ipfamily.JoinHostPort(ingress.IP, port.Port),
 						Protocol:   protocol,
 					})
 				}
@@ -93,10 +121,18 @@ func ingressEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance
 		return nil, err
 	}
 
+	certMessages := map[string]string{}
+	for _, ingress := range ingressList.Items {
+		if err := acmeCertMessages(ctx, c, &ingress, certMessages); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, ingress := range ingressList.Items {
 		targetStr := ingress.Annotations[labels.AcornTargets]
 		if targetStr == "" {
-			return nil, err
+			// Ingresses that don't route to the app, like the ones of the ACME solvers
+			continue
 		}
 
 		targets := map[string]publish.Target{}
@@ -117,6 +153,7 @@ func ingressEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance
 				Address:    hostname,
 				Protocol:   v1.ProtocolHTTP,
 				Pending:    len(ingress.Status.LoadBalancer.Ingress) == 0,
+				Message:    certMessages[hostname],
 			})
 		}
 	}
@@ -124,8 +161,185 @@ func ingressEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance
 	return
 }
 
+// acmeCertMessages adds a message for each host of the ACME solver Ingress whose certificate is still being requested
+// from the ACME server, see publish.ACMEHosts
+func acmeCertMessages(ctx context.Context, c kclient.Client, ingress *networkingv1.Ingress, messages map[string]string) error {
+	hosts := publish.ACMEHosts(ingress)
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	status, err := publish.ACMEStatus(ingress)
+	if err != nil {
+		return err
+	}
+
+	for host, secretName := range hosts {
+		if err := c.Get(ctx, router.Key(ingress.Namespace, secretName), &corev1.Secret{}); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if status[host] != "" {
+			messages[host] = "failed to request certificate: " + status[host]
+		} else {
+			messages[host] = "waiting for certificate"
+		}
+	}
+	return nil
+}
+
+// gatewayEndpoints returns the endpoints of the routes attached to the configured Gateway and the hostnames that
+// the Gateway serves with TLS.
+func gatewayEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance) (endpoints []v1.Endpoint, tlsHosts map[string]interface{}, _ error) {
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gatewayNamespace, gatewayName, err := publish.GatewayRef(cfg)
+	if err != nil || gatewayName == "" {
+		return nil, nil, err
+	}
+
+	gateway := &gatewayapi.Gateway{}
+	if err := c.Get(ctx, router.Key(gatewayNamespace, gatewayName), gateway); apierrors.IsNotFound(err) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	listOpts := &kclient.ListOptions{
+		Namespace: app.Status.Namespace,
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged: "true",
+		}),
+	}
+
+	httpRoutes := &gatewayapi.HTTPRouteList{}
+	if err := c.List(ctx, httpRoutes, listOpts); err != nil {
+		return nil, nil, err
+	}
+
+	tlsHosts = map[string]interface{}{}
+	for _, route := range httpRoutes.Items {
+		targets, err := routeTargets(route.Annotations)
+		if err != nil {
+			return nil, nil, err
+		}
+		certs := map[string]string{}
+		if certsStr := route.Annotations[labels.AcornGatewayCerts]; certsStr != "" {
+			if err := json.Unmarshal([]byte(certsStr), &certs); err != nil {
+				return nil, nil, err
+			}
+		}
+		for _, entry := range typed.Sorted(targets) {
+			hostname, target := entry.Key, entry.Value
+			if _, ok := certs[strings.Split(hostname, ":")[0]]; ok || publish.GatewayServesHTTPS(gateway, hostname) {
+				tlsHosts[strings.Split(hostname, ":")[0]] = nil
+			}
+			endpoints = append(endpoints, v1.Endpoint{
+				Target:     target.Service,
+				TargetPort: target.Port,
+				Address:    hostname,
+				Protocol:   v1.ProtocolHTTP,
+				Pending:    !routeAccepted(route.Status),
+			})
+		}
+	}
+
+	tcpRoutes := &gatewayapi.TCPRouteList{}
+	if err := c.List(ctx, tcpRoutes, listOpts); err != nil {
+		return nil, nil, err
+	}
+	udpRoutes := &gatewayapi.UDPRouteList{}
+	if err := c.List(ctx, udpRoutes, listOpts); err != nil {
+		return nil, nil, err
+	}
+	tlsRoutes := &gatewayapi.TLSRouteList{}
+	if err := c.List(ctx, tlsRoutes, listOpts); err != nil {
+		return nil, nil, err
+	}
+
+	address := "<Pending Ingress>"
+	if len(gateway.Status.Addresses) > 0 {
+		address = gateway.Status.Addresses[0].Value
+	}
+
+	addPortEndpoints := func(annotations map[string]string, status gatewayapi.RouteStatus, protocol v1.Protocol) error {
+		targets, err := routeTargets(annotations)
+		if err != nil {
+			return err
+		}
+		for _, entry := range typed.Sorted(targets) {
+			port, target := entry.Key, entry.Value
+			endpoints = append(endpoints, v1.Endpoint{
+				Target:     target.Service,
+				TargetPort: target.Port,
+				Address:    net.JoinHostPort(address, port),
+				Protocol:   protocol,
+				Pending:    len(gateway.Status.Addresses) == 0 || !routeAccepted(status),
+			})
+		}
+		return nil
+	}
+	for _, route := range tcpRoutes.Items {
+		if err := addPortEndpoints(route.Annotations, route.Status, v1.ProtocolTCP); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, route := range udpRoutes.Items {
+		if err := addPortEndpoints(route.Annotations, route.Status, v1.ProtocolUDP); err != nil {
+			return nil, nil, err
+		}
+	}
+	// TLS routes are reached by the hostname they route, so their targets are keyed by the address and not the port
+	for _, route := range tlsRoutes.Items {
+		targets, err := routeTargets(route.Annotations)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, entry := range typed.Sorted(targets) {
+			address, target := entry.Key, entry.Value
+			endpoints = append(endpoints, v1.Endpoint{
+				Target:     target.Service,
+				TargetPort: target.Port,
+				Address:    address,
+				Protocol:   v1.ProtocolTCP,
+				Pending:    !routeAccepted(route.Status),
+			})
+		}
+	}
+
+	return endpoints, tlsHosts, nil
+}
+
+func routeTargets(annotations map[string]string) (map[string]publish.Target, error) {
+	targets := map[string]publish.Target{}
+	if targetStr := annotations[labels.AcornTargets]; targetStr != "" {
+		if err := json.Unmarshal([]byte(targetStr), &targets); err != nil {
+			return nil, err
+		}
+	}
+	return targets, nil
+}
+
+func routeAccepted(status gatewayapi.RouteStatus) bool {
+	for _, parent := range status.Parents {
+		if meta.IsStatusConditionTrue(parent.Conditions, gatewayapi.RouteConditionAccepted) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *appStatusRenderer) readEndpoints() error {
-	// reset state
+	// reset state, but keep the results of the last health checks, see CheckEndpointHealth
+	health := map[string]*v1.EndpointHealth{}
+	for _, ep := range a.app.Status.AppStatus.Endpoints {
+		health[string(ep.Protocol)+"/"+ep.Address] = ep.Health
+	}
 	a.app.Status.AppStatus.Endpoints = nil
 
 	ingressEndpoints, err := ingressEndpoints(a.ctx, a.c, a.app)
@@ -138,12 +352,21 @@ func (a *appStatusRenderer) readEndpoints() error {
 		return err
 	}
 
+	gatewayEndpoints, gatewayTLSHosts, err := gatewayEndpoints(a.ctx, a.c, a.app)
+	if err != nil {
+		return err
+	}
+
 	eps := append(ingressEndpoints, serviceEndpoints...)
+	eps = append(eps, gatewayEndpoints...)
 
 	ingressTLSHosts, err := ingressTLSHosts(a.ctx, a.c, a.app)
 	if err != nil {
 		return err
 	}
+	for host := range gatewayTLSHosts {
+		ingressTLSHosts[host] = nil
+	}
 
 	for i, ep := range eps {
 		if ep.Protocol == v1.ProtocolHTTP {
@@ -154,6 +377,7 @@ func (a *appStatusRenderer) readEndpoints() error {
 		} else {
 			ep.PublishProtocol = v1.PublishProtocol(ep.Protocol)
 		}
+		ep.Health = health[string(ep.Protocol)+"/"+ep.Address]
 		eps[i] = ep
 	}
 
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/gatewayapi"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/publish"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SyncGatewayListeners adds an HTTPS listener to the configured Gateway for each hostname of an app that has a TLS
+// certificate, the Gateway equivalent of the TLS section of an Ingress. Listing the HTTPRoutes triggers this handler
+// again when routes change, so listeners of removed hostnames are removed as well.
+func SyncGatewayListeners(req router.Request, _ router.Response) error {
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return err
+	}
+
+	gatewayNamespace, gatewayName, err := publish.GatewayRef(cfg)
+	if err != nil || gatewayName == "" {
+		return err
+	}
+
+	gateway := &gatewayapi.Gateway{}
+	if err := req.Get(gateway, gatewayNamespace, gatewayName); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	routes := &gatewayapi.HTTPRouteList{}
+	if err := req.List(routes, &client.ListOptions{
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged: "true",
+		}),
+	}); err != nil {
+		return err
+	}
+
+	listeners, err := publish.GatewayListeners(gateway, routes.Items)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(listeners, gateway.Spec.Listeners) {
+		return nil
+	}
+
+	gateway.Spec.Listeners = listeners
+	return req.Client.Update(req.Ctx, gateway)
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/acorn-io/runtime/pkg/gatewayapi"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func gatewayConfig() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "acorn-config",
+			Namespace: "acorn-system",
+		},
+		Data: map[string]string{
+			"config": `{"gateway": "gateway-system/shared"}`,
+		},
+	}
+}
+
+func gatewayWithListeners(listeners ...gatewayapi.Listener) *gatewayapi.Gateway {
+	return &gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shared",
+			Namespace: "gateway-system",
+		},
+		Spec: gatewayapi.GatewaySpec{
+			Listeners: listeners,
+		},
+	}
+}
+
+// TestSyncGatewayListenersRemovesStale tests that listeners added for hostnames that no longer have a route are removed
+func TestSyncGatewayListenersRemovesStale(t *testing.T) {
+	h := tester.Harness{
+		Scheme: scheme.Scheme,
+		Existing: []client.Object{
+			gatewayWithListeners(
+				gatewayapi.Listener{Name: "http", Port: 80, Protocol: gatewayapi.ProtocolHTTP},
+				gatewayapi.Listener{Name: "acorn-tls-removed.acorn.not", Port: 443, Protocol: gatewayapi.ProtocolHTTPS},
+			),
+		},
+	}
+
+	resp, err := h.InvokeFunc(t, gatewayConfig(), SyncGatewayListeners)
+	require.NoError(t, err)
+	require.Len(t, resp.Client.Updated, 1)
+	assert.Equal(t, []gatewayapi.Listener{
+		{Name: "http", Port: 80, Protocol: gatewayapi.ProtocolHTTP},
+	}, resp.Client.Updated[0].(*gatewayapi.Gateway).Spec.Listeners)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/z"
 	"golang.org/x/sync/semaphore"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,47 +18,66 @@ import (
 const defaultTTL = 7 * 24 * time.Hour
 
 func GCExpired() router.HandlerFunc {
-	sem := semaphore.NewWeighted(1)
+	return handler{
+		getTTL: newTTLGetter().getTTL,
+	}.gcExpired
+}
+
+// parsedTTL is a TTL of the configuration along with its parsed duration.
+type parsedTTL struct {
+	raw string
+	ttl time.Duration
+}
 
-	// parsed stores pre-parsed TTLs from the configuration.
-	var parsed struct {
-		raw string
-		ttl time.Duration
+// ttlGetter returns the configured TTLs of events.
+type ttlGetter struct {
+	sem *semaphore.Weighted
+
+	// parsed stores pre-parsed TTLs from the configuration, keyed by whether they apply to audit events.
+	parsed map[bool]parsedTTL
+}
+
+func newTTLGetter() *ttlGetter {
+	return &ttlGetter{
+		sem:    semaphore.NewWeighted(1),
+		parsed: map[bool]parsedTTL{},
 	}
+}
 
-	return handler{
-		getTTL: func(
-			ctx context.Context,
-			getter kclient.Reader,
-		) (time.Duration, error) {
-			cfg, err := config.Get(ctx, getter)
-			if err != nil {
-				return 0, err
-			}
-
-			cfgTTL := cfg.EventTTL
-			if cfgTTL == nil || *cfgTTL == "" {
-				return defaultTTL, nil
-			}
-
-			if err := sem.Acquire(ctx, 1); err != nil {
-				return 0, fmt.Errorf("failed to acquire ttl semaphore: %w", err)
-			}
-			defer sem.Release(1)
-
-			if parsed.raw != *cfgTTL {
-				// This is a new TTL, parse and memoize
-				ttl, err := time.ParseDuration(*cfgTTL)
-				if err != nil {
-					return 0, err
-				}
-
-				parsed.raw, parsed.ttl = *cfgTTL, ttl
-			}
-
-			return parsed.ttl, nil
-		},
-	}.gcExpired
+func (g *ttlGetter) getTTL(ctx context.Context, getter kclient.Reader, audit bool) (time.Duration, error) {
+	cfg, err := config.Get(ctx, getter)
+	if err != nil {
+		return 0, err
+	}
+
+	cfgTTL := cfg.EventTTL
+	if audit && cfg.AuditEventTTL != nil && *cfg.AuditEventTTL != "" {
+		// Audit events have their own TTL so they can be kept longer than other events
+		cfgTTL = cfg.AuditEventTTL
+	}
+	if cfgTTL == nil || *cfgTTL == "" {
+		return defaultTTL, nil
+	}
+
+	if err := g.sem.Acquire(ctx, 1); err != nil {
+		return 0, fmt.Errorf("failed to acquire ttl semaphore: %w", err)
+	}
+	defer g.sem.Release(1)
+
+	if p := g.parsed[audit]; p.raw != *cfgTTL {
+		// This is a new TTL, parse and memoize
+		ttl, err := time.ParseDuration(*cfgTTL)
+		if err != nil {
+			return 0, err
+		}
+
+		g.parsed[audit] = parsedTTL{
+			raw: *cfgTTL,
+			ttl: ttl,
+		}
+	}
+
+	return g.parsed[audit].ttl, nil
 }
 
 // GCable describes types that can be GCed by the router.HandlerFunc returned by GCExpired.
@@ -67,10 +87,11 @@ type GCable interface {
 }
 
 type handler struct {
-	// getTTL returns the TTL to use for event expiration.
+	// getTTL returns the TTL to use for event expiration, audit is true for audit events.
 	getTTL func(
 		context.Context,
 		kclient.Reader,
+		bool,
 	) (time.Duration, error)
 }
 
@@ -81,7 +102,7 @@ func (h handler) gcExpired(
 	e := req.Object
 
 	// Get the currently configured TTL
-	ttl, err := h.getTTL(req.Ctx, req.Client)
+	ttl, err := h.getTTL(req.Ctx, req.Client, e.GetLabels()[labels.AcornAuditEvent] == "true")
 	if err != nil {
 		return fmt.Errorf("failed to get event ttl: %w", err)
 	}
@@ -0,0 +1,131 @@
+package eventinstance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func setTTLConfig(t *testing.T, c kclient.Client, config string) {
+	t.Helper()
+	ctx := context.Background()
+	cm := &corev1.ConfigMap{}
+	err := c.Get(ctx, router.Key(system.Namespace, system.ConfigName), cm)
+	if err == nil {
+		cm.Data = map[string]string{"config": config}
+		require.NoError(t, c.Update(ctx, cm))
+		return
+	}
+
+	require.NoError(t, c.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      system.ConfigName,
+			Namespace: system.Namespace,
+		},
+		Data: map[string]string{"config": config},
+	}))
+}
+
+func TestGetTTL(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        string
+		expectedTTL   time.Duration
+		expectedAudit time.Duration
+	}{
+		{
+			name:          "defaults",
+			config:        `{}`,
+			expectedTTL:   defaultTTL,
+			expectedAudit: defaultTTL,
+		},
+		{
+			name:          "audit events use the event ttl by default",
+			config:        `{"eventTTL": "1h"}`,
+			expectedTTL:   time.Hour,
+			expectedAudit: time.Hour,
+		},
+		{
+			name:          "empty audit event ttl",
+			config:        `{"eventTTL": "1h", "auditEventTTL": ""}`,
+			expectedTTL:   time.Hour,
+			expectedAudit: time.Hour,
+		},
+		{
+			name:          "audit event ttl",
+			config:        `{"eventTTL": "1h", "auditEventTTL": "720h"}`,
+			expectedTTL:   time.Hour,
+			expectedAudit: 720 * time.Hour,
+		},
+		{
+			name:          "audit event ttl without event ttl",
+			config:        `{"auditEventTTL": "720h"}`,
+			expectedTTL:   defaultTTL,
+			expectedAudit: 720 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+			setTTLConfig(t, c, tt.config)
+
+			g := newTTLGetter()
+			ttl, err := g.getTTL(ctx, c, false)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTTL, ttl)
+
+			ttl, err = g.getTTL(ctx, c, true)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAudit, ttl)
+		})
+	}
+}
+
+// TestGetTTLMemo tests that the TTLs of audit and other events are memoized separately and reparsed when changed
+func TestGetTTLMemo(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	g := newTTLGetter()
+
+	setTTLConfig(t, c, `{"eventTTL": "1h", "auditEventTTL": "2h"}`)
+	ttl, err := g.getTTL(ctx, c, false)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, ttl)
+	ttl, err = g.getTTL(ctx, c, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, ttl)
+	assert.Equal(t, map[bool]parsedTTL{
+		false: {raw: "1h", ttl: time.Hour},
+		true:  {raw: "2h", ttl: 2 * time.Hour},
+	}, g.parsed)
+
+	setTTLConfig(t, c, `{"eventTTL": "1h", "auditEventTTL": "3h"}`)
+	ttl, err = g.getTTL(ctx, c, true)
+	require.NoError(t, err)
+	assert.Equal(t, 3*time.Hour, ttl)
+	ttl, err = g.getTTL(ctx, c, false)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, ttl)
+	assert.Equal(t, map[bool]parsedTTL{
+		false: {raw: "1h", ttl: time.Hour},
+		true:  {raw: "3h", ttl: 3 * time.Hour},
+	}, g.parsed)
+
+	// An invalid TTL is not memoized
+	setTTLConfig(t, c, `{"eventTTL": "1h", "auditEventTTL": "forever"}`)
+	_, err = g.getTTL(ctx, c, true)
+	assert.Error(t, err)
+	assert.Equal(t, "3h", g.parsed[true].raw)
+}
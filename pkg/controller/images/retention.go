@@ -0,0 +1,84 @@
+package images
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// retentionInterval is how often the image retention of a project is enforced
+const retentionInterval = time.Hour
+
+// EnforceImageRetention periodically prunes the images of the project that no app uses and that are older than the
+// image retention of the project allows, and records an event with the space that was reclaimed.
+func EnforceImageRetention(transport http.RoundTripper, recorder event.Recorder) router.HandlerFunc {
+	var (
+		lock    sync.Mutex
+		lastRun = map[string]time.Time{}
+	)
+
+	return func(req router.Request, resp router.Response) error {
+		project := req.Object.(*v1.ProjectInstance)
+		retention := project.Spec.ImageRetention
+		if retention == nil || (retention.Untagged == "" && retention.Tagged == "") {
+			return nil
+		}
+
+		lock.Lock()
+		next := lastRun[project.Name].Add(retentionInterval)
+		lock.Unlock()
+		if wait := time.Until(next); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+
+		untagged, err := parseMaxAge(retention.Untagged)
+		if err != nil {
+			return err
+		}
+		tagged, err := parseMaxAge(retention.Tagged)
+		if err != nil {
+			return err
+		}
+		opts := images.PruneOptions{
+			Untagged: untagged,
+			Tagged:   tagged,
+		}
+
+		remoteOpts, err := images.GetAuthenticationRemoteOptions(req.Ctx, req.Client, project.Name, remote.WithTransport(transport))
+		if err != nil {
+			return err
+		}
+
+		result, err := images.Prune(req.Ctx, req.Client, project.Name, opts, remoteOpts...)
+		if err != nil {
+			return err
+		}
+		images.RecordPruneEvent(req.Ctx, recorder, project.Name, project, result)
+
+		lock.Lock()
+		lastRun[project.Name] = time.Now()
+		lock.Unlock()
+
+		resp.RetryAfter(retentionInterval)
+		return nil
+	}
+}
+
+// parseMaxAge parses the duration images are kept for, nil meaning forever
+func parseMaxAge(value string) (*time.Duration, error) {
+	if value == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
@@ -0,0 +1,267 @@
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// egressRefreshInterval is how often DNS names in egress rules are resolved again and missing services are looked up
+const egressRefreshInterval = 5 * time.Minute
+
+// lookupHost resolves DNS names of egress rules, it is a variable so that tests don't depend on DNS
+var lookupHost = func(ctx context.Context, host string) ([]string, error) {
+	return net.DefaultResolver.LookupHost(ctx, host)
+}
+
+// ForAppEgress creates a Kubernetes NetworkPolicy per container or job that declares egress rules, so that the
+// container can only reach the declared services, CIDRs and DNS names. If DenyEgressByDefault is set, containers
+// that declare no egress rules can't reach anything. DNS is always allowed.
+func ForAppEgress(req router.Request, resp router.Response) error {
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return err
+	} else if !*cfg.NetworkPolicies {
+		return nil
+	}
+
+	app := req.Object.(*v1.AppInstance)
+	refresh := false
+
+	for _, entry := range typed.Sorted(typed.Concat(app.Status.AppSpec.Containers, app.Status.AppSpec.Jobs)) {
+		containerName, container := entry.Key, entry.Value
+
+		// The pods of jobs are labeled with the job name and have no container name
+		selector := labels.Managed(app, labels.AcornContainerName, containerName)
+		if _, ok := app.Status.AppSpec.Jobs[containerName]; ok {
+			selector = labels.Managed(app, labels.AcornJobName, containerName)
+		}
+
+		// sidecars run in the same pod, so their rules apply to the whole pod
+		rules := append(v1.EgressRules{}, container.Egress...)
+		for _, sidecar := range typed.Sorted(container.Sidecars) {
+			rules = append(rules, sidecar.Value.Egress...)
+		}
+		if len(rules) == 0 && !*cfg.DenyEgressByDefault {
+			continue
+		}
+
+		egress := []networkingv1.NetworkPolicyEgressRule{dnsEgressRule()}
+		for _, rule := range rules {
+			egressRule, retry, err := toEgressRule(req, app.Status.Namespace, rule)
+			if err != nil {
+				return fmt.Errorf("invalid egress rule of container %s: %w", containerName, err)
+			}
+			refresh = refresh || retry
+			if egressRule != nil {
+				egress = append(egress, *egressRule)
+			}
+		}
+
+		resp.Objects(&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.SafeConcatName(app.Name, containerName, "egress"),
+				Namespace: app.Status.Namespace,
+				Labels: map[string]string{
+					labels.AcornManaged: "true",
+				},
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: selector,
+				},
+				Egress:      egress,
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			},
+		})
+	}
+
+	if refresh {
+		resp.RetryAfter(egressRefreshInterval)
+	}
+	return nil
+}
+
+// dnsEgressRule allows traffic to CoreDNS, without it no names could be resolved
+func dnsEgressRule() networkingv1.NetworkPolicyEgressRule {
+	return networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"kubernetes.io/metadata.name": "kube-system",
+				},
+			},
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"k8s-app": "kube-dns",
+				},
+			},
+		}},
+		Ports: []networkingv1.NetworkPolicyPort{
+			{
+				Protocol: z.Pointer(corev1.ProtocolUDP),
+				Port:     z.Pointer(intstr.FromInt(53)),
+			},
+			{
+				Protocol: z.Pointer(corev1.ProtocolTCP),
+				Port:     z.Pointer(intstr.FromInt(53)),
+			},
+		},
+	}
+}
+
+// toEgressRule converts the rule to a NetworkPolicy egress rule. Retry is true if the rule needs to be computed
+// again later, because it depends on DNS or a service that doesn't exist yet. A nil rule is returned if nothing
+// can be allowed yet.
+func toEgressRule(req router.Request, namespace string, rule v1.EgressRule) (_ *networkingv1.NetworkPolicyEgressRule, retry bool, _ error) {
+	if err := rule.Validate(); err != nil {
+		return nil, false, err
+	}
+
+	switch {
+	case rule.CIDR != "":
+		return &networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{{
+				IPBlock: &networkingv1.IPBlock{CIDR: rule.CIDR},
+			}},
+			Ports: tcpPorts(rule.Ports),
+		}, false, nil
+	case rule.DNSName != "":
+		return dnsNameEgressRule(req.Ctx, rule.DNSName, rule.Ports), true, nil
+	}
+
+	return serviceEgressRule(req, namespace, rule.Service, rule.Ports)
+}
+
+// serviceEgressRule allows traffic to the pods of the service. Linked services are ExternalName services that point
+// to the service in the namespace of the other app.
+func serviceEgressRule(req router.Request, namespace, serviceName string, servicePorts []int32) (_ *networkingv1.NetworkPolicyEgressRule, retry bool, _ error) {
+	svc := &corev1.Service{}
+	if err := req.Get(svc, namespace, serviceName); apierror.IsNotFound(err) {
+		return nil, true, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	if svc.Spec.Type == corev1.ServiceTypeExternalName {
+		targetName, targetNamespace, ok := parseExternalName(svc.Spec.ExternalName)
+		if !ok {
+			// an external service outside the cluster, so treat it like a DNS name
+			return dnsNameEgressRule(req.Ctx, svc.Spec.ExternalName, servicePorts), true, nil
+		}
+		svc = &corev1.Service{}
+		if err := req.Get(svc, targetNamespace, targetName); apierror.IsNotFound(err) {
+			return nil, true, nil
+		} else if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if len(svc.Spec.Selector) == 0 {
+		return nil, false, fmt.Errorf("service %s has no pods to allow egress traffic to", serviceName)
+	}
+
+	// NetworkPolicies apply to the ports of the pods, not the ports of the service
+	var ports []networkingv1.NetworkPolicyPort
+	for _, port := range servicePorts {
+		for _, svcPort := range svc.Spec.Ports {
+			if svcPort.Port == port {
+				ports = append(ports, networkingv1.NetworkPolicyPort{
+					Protocol: z.Pointer(svcPort.Protocol),
+					Port:     z.Pointer(svcPort.TargetPort),
+				})
+			}
+		}
+	}
+	if len(servicePorts) > 0 && len(ports) == 0 {
+		return nil, false, fmt.Errorf("service %s has none of the ports %v", serviceName, servicePorts)
+	}
+
+	return &networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"kubernetes.io/metadata.name": svc.Namespace,
+				},
+			},
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: svc.Spec.Selector,
+			},
+		}},
+		Ports: ports,
+	}, false, nil
+}
+
+// dnsNameEgressRule allows traffic to the IP addresses the DNS name currently resolves to. Nil is returned if the
+// name can't be resolved.
+func dnsNameEgressRule(ctx context.Context, dnsName string, ports []int32) *networkingv1.NetworkPolicyEgressRule {
+	addresses, err := lookupHost(ctx, dnsName)
+	if err != nil {
+		logrus.Warnf("failed to resolve egress DNS name %s: %v", dnsName, err)
+		return nil
+	}
+
+	sort.Strings(addresses)
+	var peers []networkingv1.NetworkPolicyPeer
+	for i, address := range addresses {
+		if i > 0 && addresses[i-1] == address {
+			continue
+		}
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: hostCIDR(address)},
+		})
+	}
+	if len(peers) == 0 {
+		return nil
+	}
+
+	return &networkingv1.NetworkPolicyEgressRule{
+		To:    peers,
+		Ports: tcpPorts(ports),
+	}
+}
+
+func tcpPorts(ports []int32) (result []networkingv1.NetworkPolicyPort) {
+	for _, port := range ports {
+		result = append(result, networkingv1.NetworkPolicyPort{
+			Protocol: z.Pointer(corev1.ProtocolTCP),
+			Port:     z.Pointer(intstr.FromInt(int(port))),
+		})
+	}
+	return result
+}
+
+// parseExternalName returns the service and namespace of an ExternalName in the format
+// <service name>.<namespace>.svc.<cluster domain>
+func parseExternalName(externalName string) (serviceName, namespace string, ok bool) {
+	parts := strings.Split(externalName, ".")
+	if len(parts) < 3 || parts[2] != "svc" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// hostCIDR returns the CIDR matching only the IP address
+func hostCIDR(address string) string {
+	if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+		return address + "/128"
+	}
+	return address + "/32"
+}
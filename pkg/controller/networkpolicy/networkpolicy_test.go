@@ -1,10 +1,14 @@
 package networkpolicy
 
 import (
+	"context"
+	"net"
 	"testing"
 
+	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/router/tester"
 	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNetworkPolicyForApp(t *testing.T) {
@@ -26,3 +30,26 @@ func TestNetworkPolicyForService(t *testing.T) {
 func TestNetworkPolicyForBuilder(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/networkpolicy/builder", ForBuilder)
 }
+
+func TestNetworkPolicyForServiceExport(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/networkpolicy/serviceexport", ForServiceExport)
+}
+
+func TestNetworkPolicyForAppEgress(t *testing.T) {
+	lookupHost = func(_ context.Context, host string) ([]string, error) {
+		return []string{"203.0.113.20", "203.0.113.10", "2001:db8::10"}, nil
+	}
+	defer func() { lookupHost = net.DefaultResolver.LookupHost }()
+
+	harness, input, err := tester.FromDir(scheme.Scheme, "testdata/networkpolicy/egress")
+	require.NoError(t, err)
+	// DNS names are resolved again and the missing service is looked up later
+	harness.ExpectedDelay = egressRefreshInterval
+
+	_, err = harness.Invoke(t, input, router.HandlerFunc(ForAppEgress))
+	require.NoError(t, err)
+}
+
+func TestNetworkPolicyForAppEgressDenyByDefault(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/networkpolicy/egress-deny-by-default", ForAppEgress)
+}
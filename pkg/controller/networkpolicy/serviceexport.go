@@ -0,0 +1,118 @@
+package networkpolicy
+
+import (
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/ref"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ForServiceExport creates a Kubernetes NetworkPolicy that allows traffic to an exported service from the projects
+// that the service is exported to and that link to it. Without it the NetworkPolicy created by ForApp would only allow
+// traffic from within the project of the service.
+func ForServiceExport(req router.Request, resp router.Response) error {
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return err
+	} else if !*cfg.NetworkPolicies {
+		return nil
+	}
+
+	export := req.Object.(*v1.ServiceExportInstance)
+
+	// Only the projects with apps that link to the service are allowed, not all the projects it is exported to
+	var projects []string
+	for _, project := range export.Spec.Projects {
+		apps := &v1.AppInstanceList{}
+		if err := req.List(apps, &kclient.ListOptions{
+			Namespace: project,
+		}); err != nil {
+			return err
+		}
+		if slices.ContainsFunc(apps.Items, func(app v1.AppInstance) bool {
+			return linksTo(&app, export)
+		}) {
+			projects = append(projects, project)
+		}
+	}
+	if len(projects) == 0 {
+		return nil
+	}
+
+	svcInstance := &v1.ServiceInstance{}
+	if err := ref.Lookup(req.Ctx, req.Client, svcInstance, export.Namespace, strings.Split(export.Spec.Service, ".")...); apierror.IsNotFound(err) {
+		// service doesn't exist yet, this handler will get re-called once it does
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	svc := &corev1.Service{}
+	if err := req.Get(svc, svcInstance.Namespace, svcInstance.Name); apierror.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	} else if len(svc.Spec.Selector) == 0 {
+		// the service doesn't run in the cluster, so there is nothing to allow traffic to
+		return nil
+	}
+
+	var netPolPorts []networkingv1.NetworkPolicyPort
+	for _, port := range svc.Spec.Ports {
+		proto := port.Protocol
+		targetPort := port.TargetPort
+		netPolPorts = append(netPolPorts, networkingv1.NetworkPolicyPort{
+			Protocol: &proto,
+			Port:     &targetPort,
+		})
+	}
+
+	resp.Objects(&networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.SafeConcatName("export", export.Namespace, export.Name),
+			Namespace: svc.Namespace,
+			Labels: map[string]string{
+				labels.AcornManaged: "true",
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: svc.Spec.Selector, // the NetPol will target the same pods that the service targets
+			},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      labels.AcornAppNamespace,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   projects,
+						}},
+					},
+				}},
+				Ports: netPolPorts,
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	})
+
+	return nil
+}
+
+func linksTo(app *v1.AppInstance, export *v1.ServiceExportInstance) bool {
+	for _, link := range app.Spec.Links {
+		if link.Service == export.Namespace+"/"+export.Spec.Service {
+			return true
+		}
+	}
+	return false
+}
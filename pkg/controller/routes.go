@@ -24,6 +24,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/controller/quota"
 	"github.com/acorn-io/runtime/pkg/controller/scheduling"
 	"github.com/acorn-io/runtime/pkg/controller/secrets"
+	"github.com/acorn-io/runtime/pkg/controller/secretshare"
 	"github.com/acorn-io/runtime/pkg/controller/service"
 	"github.com/acorn-io/runtime/pkg/controller/tls"
 	"github.com/acorn-io/runtime/pkg/event"
@@ -79,7 +80,9 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(secrets.CreateSecrets)
 	appMeetsPreconditions.HandlerFunc(appstatus.SetStatus)
 	appMeetsPreconditions.HandlerFunc(appstatus.ReadyStatus)
+	appMeetsPreconditions.HandlerFunc(appstatus.CheckEndpointHealth(recorder))
 	appMeetsPreconditions.HandlerFunc(networkpolicy.ForApp)
+	appMeetsPreconditions.HandlerFunc(networkpolicy.ForAppEgress)
 	appMeetsPreconditions.HandlerFunc(appdefinition.AddAcornProjectLabel)
 	appMeetsPreconditions.HandlerFunc(appdefinition.UpdateObservedFields)
 
@@ -87,6 +90,7 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 
 	projectRouter := router.Type(&v1.ProjectInstance{})
 	projectRouter.HandlerFunc(project.SetProjectSupportedRegions)
+	projectRouter.HandlerFunc(images.EnforceImageRetention(registryTransport, recorder))
 	// Don't delete the namespace until the project instance is deleted.
 	projectRouter.IncludeFinalizing().HandlerFunc(project.CreateNamespace)
 	projectRouter.FinalizeFunc(labels.Prefix+"project-app-delete", project.EnsureAllAppsRemoved)
@@ -105,6 +109,8 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 
 	router.Type(&v1.EventInstance{}).HandlerFunc(eventinstance.GCExpired())
 
+	router.Type(&v1.SecretShareInstance{}).HandlerFunc(secretshare.SyncSharedSecret)
+
 	router.Type(&batchv1.Job{}).Selector(managedSelector).HandlerFunc(jobs.JobCleanup)
 	router.Type(&rbacv1.ClusterRole{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
 	router.Type(&rbacv1.ClusterRoleBinding{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
@@ -123,11 +129,15 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	router.Type(&netv1.Ingress{}).Selector(managedSelector).Namespace(system.ImagesNamespace).HandlerFunc(gc.GCOrphans)
 	router.Type(&corev1.Secret{}).Selector(managedSelector).Name(system.DNSSecretName).Namespace(system.Namespace).HandlerFunc(secrets.HandleDNSSecret)
 	router.Type(&netv1.Ingress{}).Selector(managedSelector).Name(system.DNSIngressName).Namespace(system.Namespace).Middleware(ingress.RequireLBs).Handler(ingress.NewDNSHandler())
+	// Revoke the copies of shared secrets when the share is deleted
+	router.Type(&corev1.Secret{}).Selector(managedSelector).Middleware(secretshare.RequireSharedSecret).HandlerFunc(gc.GCOrphans)
 	router.Type(&corev1.Secret{}).Selector(managedSelector).Middleware(tls.RequireSecretTypeTLS).HandlerFunc(tls.RenewCert) // renew (expired) TLS certificates, including the oss-acorn.io wildcard cert
+	router.Type(&netv1.Ingress{}).Selector(managedSelector).HandlerFunc(tls.ProvisionCustomDomainCerts)
 	router.Type(&storagev1.StorageClass{}).HandlerFunc(volume.SyncVolumeClasses)
 	router.Type(&corev1.Service{}).Selector(managedSelector).HandlerFunc(networkpolicy.ForService)
 	router.Type(&netv1.Ingress{}).Selector(managedSelector).HandlerFunc(networkpolicy.ForIngress)
 	router.Type(&appsv1.Deployment{}).Namespace(system.ImagesNamespace).HandlerFunc(networkpolicy.ForBuilder)
+	router.Type(&v1.ServiceExportInstance{}).HandlerFunc(networkpolicy.ForServiceExport)
 	router.Type(&netv1.NetworkPolicy{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
 
 	configRouter := router.Type(&corev1.ConfigMap{}).Namespace(system.Namespace).Name(system.ConfigName)
@@ -135,6 +145,7 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	configRouter.HandlerFunc(builder.DeployRegistry)
 	configRouter.HandlerFunc(config.HandleAutoUpgradeInterval)
 	configRouter.HandlerFunc(volume.CreateEphemeralVolumeClass)
+	configRouter.HandlerFunc(config.SyncGatewayListeners)
 
 	devConfigRouter := router.Type(&corev1.ConfigMap{}).Namespace(system.Namespace).Name(system.DevConfigName)
 	devConfigRouter.HandlerFunc(config.PurgeDevConfig)
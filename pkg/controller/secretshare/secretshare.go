@@ -0,0 +1,193 @@
+package secretshare
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/secrets"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SyncSharedSecret copies the shared secret into each project listed in the share that accepts it with a
+// SecretImportInstance. Copies are owned by the share, so they are updated when the source secret changes and removed
+// when a project is removed from the share, the import is removed, or the share is deleted.
+func SyncSharedSecret(req router.Request, resp router.Response) error {
+	share := req.Object.(*v1.SecretShareInstance)
+	share.Status.ObservedGeneration = share.Generation
+	share.Status.Projects = nil
+
+	source := &corev1.Secret{}
+	if err := req.Get(source, share.Namespace, share.Spec.SecretName); apierrors.IsNotFound(err) {
+		for _, project := range share.Spec.Projects {
+			share.Status.Projects = append(share.Status.Projects, v1.SecretShareProjectStatus{
+				Project: project,
+				Message: fmt.Sprintf("secret %s not found", share.Spec.SecretName),
+			})
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// Internal secrets of the project, such as encryption keys and pull secrets, are never shared
+	if !secrets.IsAcornSecret(source) {
+		for _, project := range share.Spec.Projects {
+			share.Status.Projects = append(share.Status.Projects, v1.SecretShareProjectStatus{
+				Project: project,
+				Message: fmt.Sprintf("secret %s can not be shared, it is not an acorn secret", share.Spec.SecretName),
+			})
+		}
+		return nil
+	}
+
+	// Values encrypted with the keys of the source project can't be decrypted in the target projects, they are
+	// re-encrypted with the keys of each target project
+	data, err := nacl.DecryptNamespacedDataMap(req.Ctx, req.Client, source.Data, share.Namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, project := range share.Spec.Projects {
+		status := v1.SecretShareProjectStatus{
+			Project: project,
+		}
+
+		copied, err := sharedCopy(req, share, source, data, project)
+		if err != nil {
+			status.Message = err.Error()
+		} else {
+			status.Synced = true
+			resp.Objects(copied)
+		}
+
+		share.Status.Projects = append(share.Status.Projects, status)
+	}
+
+	return nil
+}
+
+func sharedCopy(req router.Request, share *v1.SecretShareInstance, source *corev1.Secret, data map[string][]byte, project string) (*corev1.Secret, error) {
+	if project == share.Namespace {
+		return nil, fmt.Errorf("secret can not be shared with its own project")
+	}
+
+	if err := req.Get(&v1.ProjectInstance{}, "", project); apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("project %s not found", project)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := checkSecretImport(req, share, project); err != nil {
+		return nil, err
+	}
+
+	existing := &corev1.Secret{}
+	if err := req.Get(existing, project, share.TargetName()); err == nil {
+		if existing.Labels[labels.AcornSecretShareName] != share.Name || existing.Labels[labels.AcornSecretShareProject] != share.Namespace {
+			return nil, fmt.Errorf("secret %s already exists in project %s", share.TargetName(), project)
+		}
+	} else if apierrors.IsNotFound(err) {
+		existing = nil
+	} else {
+		return nil, err
+	}
+
+	data, err := encryptForProject(req, source, data, existing, project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      share.TargetName(),
+			Namespace: project,
+			Labels: map[string]string{
+				labels.AcornManaged:            "true",
+				labels.AcornSecretShareName:    share.Name,
+				labels.AcornSecretShareProject: share.Namespace,
+			},
+		},
+		Type: source.Type,
+		Data: data,
+	}, nil
+}
+
+// checkSecretImport returns an error unless the project accepts the copy of the shared secret with a
+// SecretImportInstance. Listing the imports triggers the share again when they change.
+func checkSecretImport(req router.Request, share *v1.SecretShareInstance, project string) error {
+	imports := &v1.SecretImportInstanceList{}
+	if err := req.List(imports, &kclient.ListOptions{
+		Namespace: project,
+	}); err != nil {
+		return err
+	}
+
+	for _, secretImport := range imports.Items {
+		if secretImport.Imports(share.Namespace, share.TargetName()) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("project %s does not import secret %s from project %s", project, share.TargetName(), share.Namespace)
+}
+
+// encryptForProject encrypts the values of the shared secret that are encrypted in the source project with the
+// primary key of the target project. Encryption isn't deterministic, so values of the existing copy that still decrypt
+// to the same data are kept to not update the copy on every sync.
+func encryptForProject(req router.Request, source *corev1.Secret, data map[string][]byte, existing *corev1.Secret, project string) (map[string][]byte, error) {
+	var (
+		result    = make(map[string][]byte, len(data))
+		publicKey string
+	)
+	for k, v := range data {
+		if !nacl.IsAcornEncryptedData(source.Data[k]) {
+			result[k] = v
+			continue
+		}
+
+		if existing != nil && nacl.IsAcornEncryptedData(existing.Data[k]) {
+			if decrypted, err := nacl.DecryptNamespacedData(req.Ctx, req.Client, existing.Data[k], project); err == nil && bytes.Equal(decrypted, v) {
+				result[k] = existing.Data[k]
+				continue
+			}
+		}
+
+		if publicKey == "" {
+			key, err := nacl.GetOrCreatePrimaryNaclKey(req.Ctx, req.Client, project)
+			if err != nil {
+				return nil, err
+			}
+			publicKey = nacl.KeyBytesToB64String(key.PublicKey)
+		}
+
+		encrypted, err := nacl.Encrypt(string(v), publicKey)
+		if err != nil {
+			return nil, err
+		}
+		marshaled, err := encrypted.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		result[k] = []byte(marshaled)
+	}
+
+	return result, nil
+}
+
+// RequireSharedSecret only passes secrets that are copies of shared secrets to the next handler.
+func RequireSharedSecret(h router.Handler) router.Handler {
+	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
+		if req.Object.GetLabels()[labels.AcornSecretShareName] == "" {
+			return nil
+		}
+
+		return h.Handle(req, resp)
+	})
+}
@@ -0,0 +1,202 @@
+package secretshare
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func secretImport(project, from, secretName string) *v1.SecretImportInstance {
+	return &v1.SecretImportInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName + "-from-" + from, Namespace: project},
+		Spec: v1.SecretImportInstanceSpec{
+			Project:    from,
+			SecretName: secretName,
+		},
+	}
+}
+
+func TestSyncSharedSecret(t *testing.T) {
+	h := tester.Harness{
+		Scheme: scheme.Scheme,
+		Existing: []kclient.Object{
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "source"},
+				Type:       v1.SecretTypePrefix + "token",
+				Data:       map[string][]byte{"token": []byte("value")},
+			},
+			&v1.ProjectInstance{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+			&v1.ProjectInstance{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+			&v1.ProjectInstance{ObjectMeta: metav1.ObjectMeta{Name: "team-c"}},
+			secretImport("team-a", "source", "token"),
+			secretImport("team-b", "source", "token"),
+			// Imports of another secret or from another project don't accept the share
+			secretImport("team-c", "source", "other"),
+			secretImport("team-c", "other", "token"),
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "team-b"},
+				Type:       v1.SecretTypePrefix + "opaque",
+			},
+		},
+	}
+
+	share := &v1.SecretShareInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "share", Namespace: "source"},
+		Spec: v1.SecretShareInstanceSpec{
+			SecretName: "token",
+			Projects:   []string{"team-a", "team-b", "team-c", "missing"},
+		},
+	}
+	resp, err := h.InvokeFunc(t, share, SyncSharedSecret)
+	require.NoError(t, err)
+
+	require.Len(t, resp.Collected, 1)
+	copied := resp.Collected[0].(*corev1.Secret)
+	assert.Equal(t, "team-a", copied.Namespace)
+	assert.Equal(t, "token", copied.Name)
+	assert.Equal(t, corev1.SecretType(v1.SecretTypePrefix+"token"), copied.Type)
+	assert.Equal(t, "value", string(copied.Data["token"]))
+	assert.Equal(t, "share", copied.Labels[labels.AcornSecretShareName])
+	assert.Equal(t, "source", copied.Labels[labels.AcornSecretShareProject])
+
+	assert.Equal(t, []v1.SecretShareProjectStatus{
+		{Project: "team-a", Synced: true},
+		{Project: "team-b", Message: "secret token already exists in project team-b"},
+		{Project: "team-c", Message: "project team-c does not import secret token from project source"},
+		{Project: "missing", Message: "project missing not found"},
+	}, share.Status.Projects)
+}
+
+func TestSyncSharedSecretMissingSource(t *testing.T) {
+	h := tester.Harness{
+		Scheme: scheme.Scheme,
+	}
+
+	share := &v1.SecretShareInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "share", Namespace: "source"},
+		Spec: v1.SecretShareInstanceSpec{
+			SecretName: "token",
+			Projects:   []string{"team-a"},
+		},
+	}
+	resp, err := h.InvokeFunc(t, share, SyncSharedSecret)
+	require.NoError(t, err)
+
+	assert.Empty(t, resp.Collected)
+	assert.Equal(t, []v1.SecretShareProjectStatus{
+		{Project: "team-a", Message: "secret token not found"},
+	}, share.Status.Projects)
+}
+
+// TestSyncSharedSecretEncrypted tests that values encrypted in the source project are re-encrypted with the key of the
+// target project, and that an unchanged copy is kept as is
+func TestSyncSharedSecretEncrypted(t *testing.T) {
+	ctx := context.Background()
+	namespaces := []kclient.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "source", UID: "source-uid-1234"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", UID: "team-a-uid-1234"}},
+	}
+
+	// Generate the keys of both projects
+	keys := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(namespaces...).Build()
+	sourceKey, err := nacl.GetOrCreatePrimaryNaclKey(ctx, keys, "source")
+	require.NoError(t, err)
+	targetKey, err := nacl.GetOrCreatePrimaryNaclKey(ctx, keys, "team-a")
+	require.NoError(t, err)
+	keySecrets := &corev1.SecretList{}
+	require.NoError(t, keys.List(ctx, keySecrets))
+
+	encrypted, err := nacl.Encrypt("value", nacl.KeyBytesToB64String(sourceKey.PublicKey))
+	require.NoError(t, err)
+	encryptedValue, err := encrypted.Marshal()
+	require.NoError(t, err)
+
+	existing := append(namespaces,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "source"},
+			Type:       v1.SecretTypePrefix + "token",
+			Data: map[string][]byte{
+				"token": []byte(encryptedValue),
+				"user":  []byte("plain"),
+			},
+		},
+		&v1.ProjectInstance{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		secretImport("team-a", "source", "token"),
+	)
+	for i := range keySecrets.Items {
+		existing = append(existing, &keySecrets.Items[i])
+	}
+
+	share := &v1.SecretShareInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "share", Namespace: "source"},
+		Spec: v1.SecretShareInstanceSpec{
+			SecretName: "token",
+			Projects:   []string{"team-a"},
+		},
+	}
+	resp, err := (&tester.Harness{Scheme: scheme.Scheme, Existing: existing}).InvokeFunc(t, share.DeepCopy(), SyncSharedSecret)
+	require.NoError(t, err)
+
+	require.Len(t, resp.Collected, 1)
+	copied := resp.Collected[0].(*corev1.Secret)
+	assert.Equal(t, "plain", string(copied.Data["user"]))
+	assert.NotContains(t, string(copied.Data["token"]), "value")
+
+	keyIDs, err := nacl.KeyIDs(copied.Data["token"])
+	require.NoError(t, err)
+	assert.Equal(t, []string{nacl.KeyBytesToB64String(targetKey.PublicKey)}, keyIDs)
+
+	decrypted, err := targetKey.Decrypt(copied.Data["token"])
+	require.NoError(t, err)
+	assert.Equal(t, "value", string(decrypted))
+
+	// Syncing again keeps the encrypted value of the copy
+	resp, err = (&tester.Harness{Scheme: scheme.Scheme, Existing: append(existing, copied)}).InvokeFunc(t, share.DeepCopy(), SyncSharedSecret)
+	require.NoError(t, err)
+	require.Len(t, resp.Collected, 1)
+	assert.Equal(t, copied.Data, resp.Collected[0].(*corev1.Secret).Data)
+}
+
+// TestSyncSharedSecretInternal tests that secrets that are not acorn secrets, such as encryption keys, are not shared
+func TestSyncSharedSecretInternal(t *testing.T) {
+	ctx := context.Background()
+	keys := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "source", UID: "source-uid-1234"}},
+	).Build()
+	_, err := nacl.GetOrCreatePrimaryNaclKey(ctx, keys, "source")
+	require.NoError(t, err)
+	keySecrets := &corev1.SecretList{}
+	require.NoError(t, keys.List(ctx, keySecrets))
+	require.Len(t, keySecrets.Items, 1)
+	keySecret := keySecrets.Items[0]
+
+	share := &v1.SecretShareInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "share", Namespace: keySecret.Namespace},
+		Spec: v1.SecretShareInstanceSpec{
+			SecretName: keySecret.Name,
+			Projects:   []string{"team-a"},
+		},
+	}
+	resp, err := (&tester.Harness{Scheme: scheme.Scheme, Existing: []kclient.Object{
+		&keySecret,
+		&v1.ProjectInstance{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		secretImport("team-a", keySecret.Namespace, keySecret.Name),
+	}}).InvokeFunc(t, share, SyncSharedSecret)
+	require.NoError(t, err)
+
+	assert.Empty(t, resp.Collected)
+	assert.Equal(t, []v1.SecretShareProjectStatus{
+		{Project: "team-a", Message: "secret " + keySecret.Name + " can not be shared, it is not an acorn secret"},
+	}, share.Status.Projects)
+}
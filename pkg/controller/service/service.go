@@ -3,6 +3,7 @@ package service
 import (
 	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/publish"
 	"github.com/acorn-io/runtime/pkg/services"
 )
@@ -20,6 +21,24 @@ func RenderServices(req router.Request, resp router.Response) error {
 	resp.Objects(objs...)
 	svcInstance.Status.HasService = len(objs) > 0
 
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return err
+	}
+
+	if _, gateway, err := publish.GatewayRef(cfg); err != nil {
+		return err
+	} else if gateway != "" {
+		// The Gateway handles all published ports, so no LoadBalancer service or Ingress is needed
+		objs, err = publish.GatewayRoutes(req, svcInstance)
+		if err != nil {
+			return err
+		}
+		resp.Objects(objs...)
+		resp.Objects(svcInstance)
+		return nil
+	}
+
 	objs, err = publish.ServiceLoadBalancer(req, svcInstance)
 	if err != nil {
 		return err
@@ -86,6 +86,10 @@ func TestIngressLabelsNamespace(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/labels-namespace", namespace.AddNamespace)
 }
 
+func TestIngressAuth(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/auth", RenderServices)
+}
+
 func TestLetsEncrypt(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/letsencrypt", RenderServices)
 }
@@ -98,6 +102,10 @@ func TestServiceOverlay(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/service/tcp-http-overlap", RenderServices)
 }
 
+func TestServiceSharedLB(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/service/shared-lb", RenderServices)
+}
+
 func TestBindNoProtocol(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/service/bind-no-protocol", RenderServices)
 }
@@ -106,6 +114,20 @@ func TestRouter(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/router", RenderServices)
 }
 
+func TestRouterAdvanced(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/router-advanced", RenderServices)
+}
+
+func TestGateway(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/gateway", RenderServices)
+}
+
+// TestGatewayTLS tests that TCP ports on a TLS listener get a TLSRoute and that matching TLS secrets are copied for
+// the Gateway like they are for an Ingress
+func TestGatewayTLS(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/gateway-tls", RenderServices)
+}
+
 func TestSecret(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/secret", RenderServices)
 }
@@ -124,3 +146,23 @@ func TestCustomCertsShouldNotSetCertManager(t *testing.T) {
 func TestCustomCertsWithAnnonationsShouldNotSetCertManagerDefaultIssuer(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/customdomainwithannotations", RenderServices)
 }
+
+func TestIngressACME(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/acme", RenderServices)
+}
+
+func TestIngressHTTPOptionsNginx(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/http-options-nginx", RenderServices)
+}
+
+func TestIngressHTTPOptionsTraefik(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/http-options-traefik", RenderServices)
+}
+
+func TestServiceDualStack(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/service/dual-stack", RenderServices)
+}
+
+func TestServiceIPv6Address(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/service/ipv6-address", RenderServices)
+}
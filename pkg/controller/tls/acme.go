@@ -0,0 +1,158 @@
+package tls
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/publish"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// acmeRetryInterval is how long to wait before requesting a certificate for a domain again after the request failed
+const acmeRetryInterval = 5 * time.Minute
+
+var (
+	acmeFailuresLock = &sync.Mutex{}
+	acmeFailures     = map[string]time.Time{}
+)
+
+// ProvisionCustomDomainCerts requests certificates from the ACME server for the custom domains of an Ingress that
+// routes the HTTP-01 challenges to a solver, see publish.ACMEHosts. Failures are recorded on the Ingress, so they
+// show up in the endpoints of the app. Issued certificates are renewed by RenewCert.
+func ProvisionCustomDomainCerts(req router.Request, resp router.Response) error {
+	ingress := req.Object.(*networkingv1.Ingress)
+
+	hosts := publish.ACMEHosts(ingress)
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	// Ensure that we have an ACME account ready, the solver answers the challenges of this account
+	leUser, err := ensureLEUser(req.Ctx, req.Client)
+	if err != nil {
+		logrus.Errorf("failed to get/create lets-encrypt account in ProvisionCustomDomainCerts: %v", err)
+		resp.RetryAfter(15 * time.Second)
+		return nil
+	}
+
+	// Challenges can only succeed once the solver is running
+	solver := &appsv1.Deployment{}
+	if err := req.Get(solver, ingress.Namespace, ingress.Annotations[labels.AcornACMEHTTP01]); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	} else if solver.Status.ReadyReplicas == 0 {
+		resp.RetryAfter(15 * time.Second)
+		return nil
+	}
+
+	for domain, secretName := range hosts {
+		if err := req.Get(&corev1.Secret{}, ingress.Namespace, secretName); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if retryAt := acmeRetryAt(domain); time.Now().Before(retryAt) {
+			resp.RetryAfter(time.Until(retryAt))
+			continue
+		}
+
+		go leUser.provisionCustomDomainCert(req.Ctx, req.Client, ingress.Namespace, ingress.Name, domain, secretName)
+	}
+
+	return nil
+}
+
+func (u *LEUser) provisionCustomDomainCert(ctx context.Context, client kclient.Client, namespace, ingressName, domain, secretName string) {
+	// Do not start a new challenge if we already have one in progress
+	if !lockDomain(domain) {
+		logrus.Debugf("not starting certificate request: %v: %s", ErrCertificateRequestInProgress, domain)
+		return
+	}
+	defer unlockDomain(domain)
+
+	logrus.Infof("Provisioning TLS cert for %s in secret %s/%s", domain, namespace, secretName)
+	cert, err := u.getCert(ctx, domain)
+	if err == nil {
+		var sec *corev1.Secret
+		sec, err = u.certToSecret(cert, domain, namespace, secretName)
+		if err == nil {
+			err = client.Create(ctx, sec)
+		}
+	}
+
+	if err != nil {
+		logrus.Errorf("Error provisioning cert for %s: %v", domain, err)
+		setACMERetryAt(domain, time.Now().Add(acmeRetryInterval))
+	} else {
+		logrus.Infof("TLS secret %s/%s created for domain %s", namespace, secretName, domain)
+		setACMERetryAt(domain, time.Time{})
+	}
+
+	if err := setACMEStatus(ctx, client, namespace, ingressName, domain, err); err != nil {
+		logrus.Errorf("Error recording certificate status of %s on ingress %s/%s: %v", domain, namespace, ingressName, err)
+	}
+}
+
+// setACMEStatus records the error of the last certificate request for the domain on the Ingress, or removes it if
+// the request succeeded.
+func setACMEStatus(ctx context.Context, client kclient.Client, namespace, ingressName, domain string, certErr error) error {
+	ingress := &networkingv1.Ingress{}
+	if err := client.Get(ctx, router.Key(namespace, ingressName), ingress); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	status, err := publish.ACMEStatus(ingress)
+	if err != nil {
+		return err
+	}
+
+	if certErr != nil {
+		status[domain] = certErr.Error()
+	} else if _, ok := status[domain]; ok {
+		delete(status, domain)
+	} else {
+		return nil
+	}
+
+	patch := kclient.MergeFrom(ingress.DeepCopy())
+	if len(status) == 0 {
+		delete(ingress.Annotations, labels.AcornACMEStatus)
+	} else {
+		statusJSON, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+		if ingress.Annotations == nil {
+			ingress.Annotations = map[string]string{}
+		}
+		ingress.Annotations[labels.AcornACMEStatus] = string(statusJSON)
+	}
+	return client.Patch(ctx, ingress, patch)
+}
+
+func acmeRetryAt(domain string) time.Time {
+	acmeFailuresLock.Lock()
+	defer acmeFailuresLock.Unlock()
+	return acmeFailures[domain]
+}
+
+func setACMERetryAt(domain string, retryAt time.Time) {
+	acmeFailuresLock.Lock()
+	defer acmeFailuresLock.Unlock()
+	if retryAt.IsZero() {
+		delete(acmeFailures, domain)
+	} else {
+		acmeFailures[domain] = retryAt
+	}
+}
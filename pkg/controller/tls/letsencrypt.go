@@ -170,6 +170,9 @@ func matchLeURLToEnv(url string) string {
 		return "staging"
 	} else if url == LetsEncryptURLProduction {
 		return "enabled"
+	} else if url != "" {
+		// a custom ACME directory
+		return url
 	} else {
 		return "disabled"
 	}
@@ -206,6 +209,9 @@ func ensureLEUser(ctx context.Context, client kclient.Client) (*LEUser, error) {
 	if cfg.LetsEncryptEmail != "" {
 		email = cfg.LetsEncryptEmail
 	}
+	if *cfg.ACMEDirectoryURL != "" {
+		url = *cfg.ACMEDirectoryURL
+	}
 
 	newLEUser := &LEUser{
 		email: email,
@@ -299,7 +305,7 @@ func (u *LEUser) getCert(ctx context.Context, domain string) (*certificate.Resou
 	if strings.HasPrefix(domain, "*.") {
 		return u.dnsChallenge(ctx, domain)
 	}
-	return nil, nil
+	return u.httpChallenge(domain)
 }
 
 func lockDomain(domain string) bool {
@@ -399,3 +405,21 @@ func (u *LEUser) dnsChallenge(ctx context.Context, domain string) (*certificate.
 
 	return client.Certificate.Obtain(request)
 }
+
+// httpChallenge obtains a certificate for the domain through the HTTP-01 challenge. The challenges are answered by the
+// solver the Ingress of the domain routes them to, see publish.ACMEHosts.
+func (u *LEUser) httpChallenge(domain string) (*certificate.Resource, error) {
+	client, err := u.leClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Challenge.SetHTTP01Provider(ACMEHTTP01ChallengeProvider{}); err != nil {
+		return nil, err
+	}
+
+	return client.Certificate.Obtain(certificate.ObtainRequest{
+		Domains: []string{domain},
+		Bundle:  true,
+	})
+}
@@ -43,6 +43,22 @@ func (d *ACMEDNS01ChallengeProvider) Timeout() (timeout, interval time.Duration)
 	return 3 * time.Minute, 1 * time.Minute
 }
 
+/*
+ * HTTP01 Challenge Solver (Lego Interface)
+ */
+
+// ACMEHTTP01ChallengeProvider doesn't need to present the challenges, since the acme-solver answers every challenge
+// of the ACME account from the token of the challenge and the thumbprint of the account key
+type ACMEHTTP01ChallengeProvider struct{}
+
+func (ACMEHTTP01ChallengeProvider) Present(_, _, _ string) error {
+	return nil
+}
+
+func (ACMEHTTP01ChallengeProvider) CleanUp(_, _, _ string) error {
+	return nil
+}
+
 /*
  * AcornDNS Helper
  */
@@ -0,0 +1,93 @@
+package cosign
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+)
+
+// Digests returns the digest of the image and, if it is an index, the digests of the images in it, which includes the
+// containers and nested Acorns of an Acorn image
+func Digests(d name.Digest, opts ...remote.Option) ([]name.Digest, error) {
+	result := []name.Digest{d}
+
+	descriptor, err := remote.Head(d, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !descriptor.MediaType.IsIndex() {
+		return result, nil
+	}
+
+	index, err := remote.Index(d, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range manifest.Manifests {
+		digests, err := Digests(d.Context().Digest(child.Digest.String()), opts...)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, digests...)
+	}
+
+	return result, nil
+}
+
+// NewPayload returns the payload to sign to sign the image
+func NewPayload(d name.Digest, annotations map[string]string) ([]byte, error) {
+	var optional map[string]interface{}
+	for k, v := range annotations {
+		if optional == nil {
+			optional = map[string]interface{}{}
+		}
+		optional[k] = v
+	}
+
+	return json.Marshal(payload.Cosign{
+		Image:       d,
+		Annotations: optional,
+	})
+}
+
+// AttachSignature stores the signature of the payload as a cosign signature of the image the payload is about
+func AttachSignature(d name.Digest, signedPayload []byte, b64sig string, opts ...remote.Option) error {
+	sci := payload.SimpleContainerImage{}
+	if err := json.Unmarshal(signedPayload, &sci); err != nil {
+		return fmt.Errorf("error decoding the payload: %w", err)
+	}
+	if sci.Critical.Image.DockerManifestDigest != d.DigestStr() {
+		return fmt.Errorf("payload is about image %s, not %s", sci.Critical.Image.DockerManifestDigest, d.DigestStr())
+	}
+
+	sig, err := static.NewSignature(signedPayload, b64sig)
+	if err != nil {
+		return err
+	}
+
+	ociRemoteOpts := []ociremote.Option{ociremote.WithRemoteOptions(opts...)}
+
+	se, err := ociremote.SignedEntity(d, ociRemoteOpts...)
+	if err != nil {
+		return err
+	}
+
+	se, err = mutate.AttachSignatureToEntity(se, sig)
+	if err != nil {
+		return err
+	}
+
+	return ociremote.WriteSignatures(d.Repository, se, ociRemoteOpts...)
+}
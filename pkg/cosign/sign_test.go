@@ -0,0 +1,121 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+func TestSignAllDigests(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An Acorn image is an index with the images of its containers and nested Acorns in it
+	img, err := random.Image(64, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested, err := random.Index(64, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{Add: img}, mutate.IndexAddendum{Add: nested})
+	indexDigest, err := index.Digest()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := name.NewDigest(fmt.Sprintf("%s/acorn/app@%s", u.Host, indexDigest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.WriteIndex(d, index); err != nil {
+		t.Fatal(err)
+	}
+
+	digests, err := Digests(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the index, the image, the nested index and its two images
+	if len(digests) != 5 {
+		t.Fatalf("expected 5 digests, got %d: %v", len(digests), digests)
+	}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := signature.LoadECDSASignerVerifier(privateKey, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := cryptoutils.MarshalPublicKeyToPEM(privateKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, digest := range digests {
+		payload, err := NewPayload(digest, map[string]string{"tag": "ok"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := signer.SignMessage(bytes.NewReader(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := AttachSignature(digest, payload, base64.StdEncoding.EncodeToString(sig)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, digest := range digests {
+		opts := VerifyOpts{
+			Key:                string(publicKey),
+			SignatureAlgorithm: "sha256",
+			NoCache:            true,
+			AnnotationRules: v1.SignatureAnnotations{
+				Match: map[string]string{"tag": "ok"},
+			},
+		}
+		if err := EnsureReferences(context.Background(), nil, digest.String(), &opts); err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifySignature(context.Background(), opts); err != nil {
+			t.Fatalf("%s: %v", digest, err)
+		}
+	}
+
+	// A payload can only be attached to the image it is about
+	payload, err := NewPayload(digests[0], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := AttachSignature(digests[1], payload, ""); err == nil {
+		t.Fatal("expected error attaching the payload of another image")
+	}
+}
@@ -121,9 +121,8 @@ func generateNewKeys(ctx context.Context, c kclient.Client, namespace string, ex
 	}
 
 	naclKey.acornNamespaceUID = string(ns.UID)
-	if existing == nil {
-		naclKey.Primary = z.Pointer(true)
-	}
+	// A newly generated key is always the primary key, toSecretData demotes the existing keys.
+	naclKey.Primary = z.Pointer(true)
 
 	return naclKey, createOrUpdateNaclKeySecret(ctx, c, naclKey, existing)
 }
@@ -221,7 +220,7 @@ func secretToNaclKeys(secret *corev1.Secret, namespace string) (NaclKeys, error)
 			privateKey:        keyInfo.PrivateKey,
 			acornNamespaceUID: string(uid),
 		}
-		if *keyInfo.Primary {
+		if z.Dereference(keyInfo.Primary) {
 			to["primary"] = to[pubKeyString]
 		}
 	}
@@ -244,6 +243,14 @@ func (k *NaclKey) toSecretData(existingData map[string][]byte) (map[string][]byt
 			}
 		}
 	}
+	// A namespace has a single primary key
+	if z.Dereference(k.Primary) {
+		for pubKey, key := range store {
+			key.Primary = z.Pointer(false)
+			store[pubKey] = key
+		}
+	}
+
 	stringKey := KeyBytesToB64String(k.PublicKey)
 	store[stringKey] = naclStoredKey{
 		AcornNamespace:    k.AcornNamespace,
@@ -0,0 +1,140 @@
+package nacl
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/acorn-io/z"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RotatePrimaryNaclKey generates a new primary key for the namespace. The previous keys are kept, so data
+// encrypted with them can still be decrypted until they are retired.
+func RotatePrimaryNaclKey(ctx context.Context, c kclient.Client, namespace string) (*NaclKey, error) {
+	existing, err := getExistingSecret(ctx, c, namespace)
+	if apierrors.IsNotFound(err) {
+		return generateNewKeys(ctx, c, namespace, nil)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return generateNewKeys(ctx, c, namespace, existing)
+}
+
+// RetireNaclKey removes the key from the namespace. Data encrypted only with the key can no longer be
+// decrypted afterward. The primary key can not be retired.
+func RetireNaclKey(ctx context.Context, c kclient.Client, namespace, publicKey string) error {
+	existing, err := getExistingSecret(ctx, c, namespace)
+	if apierrors.IsNotFound(err) {
+		return &ErrKeyNotFound{}
+	} else if err != nil {
+		return err
+	}
+
+	store, err := secretToKeyStore(existing)
+	if err != nil {
+		return err
+	}
+
+	key, ok := store[publicKey]
+	if !ok {
+		return &ErrKeyNotFound{}
+	}
+	if z.Dereference(key.Primary) {
+		return fmt.Errorf("key %s is the primary key of namespace %s and can not be retired", publicKey, namespace)
+	}
+
+	delete(store, publicKey)
+
+	updated := existing.DeepCopy()
+	updated.Data[naclStoreKey], err = json.Marshal(store)
+	if err != nil {
+		return err
+	}
+
+	return c.Update(ctx, updated)
+}
+
+// KeyIDs returns the public keys the data is encrypted for.
+func KeyIDs(data []byte) ([]string, error) {
+	preppedData, err := unwrapForDecryption(data)
+	if err != nil {
+		return nil, err
+	}
+
+	keyIDs := make([]string, 0, len(preppedData))
+	for keyID := range preppedData {
+		keyIDs = append(keyIDs, keyID)
+	}
+	sort.Strings(keyIDs)
+	return keyIDs, nil
+}
+
+// ReEncryptNamespacedData replaces the parts of the data that are encrypted with non-primary keys of the namespace
+// with data encrypted with the primary key. Parts encrypted with keys that don't belong to the namespace, like the
+// keys of other clusters, are left untouched. The boolean result reports whether the data was changed.
+func ReEncryptNamespacedData(ctx context.Context, c kclient.Reader, data []byte, namespace string) ([]byte, bool, error) {
+	keys, err := GetAllNaclKeys(ctx, c, namespace)
+	if err != nil {
+		return nil, false, err
+	}
+
+	primary, ok := keys["primary"]
+	if !ok {
+		return nil, false, NewErrKeyNotFound(true)
+	}
+	primaryKeyID := KeyBytesToB64String(primary.PublicKey)
+
+	preppedData, err := unwrapForDecryption(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var decrypted []byte
+	for keyID := range preppedData {
+		key, ok := keys[keyID]
+		if !ok || keyID == primaryKeyID {
+			continue
+		}
+		if decrypted == nil {
+			if decrypted, err = key.Decrypt(data); err != nil {
+				return nil, false, err
+			}
+		}
+		delete(preppedData, keyID)
+	}
+
+	if decrypted == nil {
+		return data, false, nil
+	}
+
+	multiData := MultiEncryptedData{}
+	for keyID, encrypted := range preppedData {
+		multiData[keyID] = base64.RawURLEncoding.EncodeToString(encrypted)
+	}
+
+	if _, ok := multiData[primaryKeyID]; !ok {
+		encData, err := Encrypt(string(decrypted), primaryKeyID)
+		if err != nil {
+			return nil, false, err
+		}
+		multiData[primaryKeyID] = encData.EncryptedContent
+	}
+
+	result, err := multiData.Marshal()
+	return []byte(result), true, err
+}
+
+func secretToKeyStore(secret *corev1.Secret) (naclKeyStore, error) {
+	store := naclKeyStore{}
+	keystore, ok := secret.Data[naclStoreKey]
+	if !ok {
+		return nil, NewErrKeyNotFound(true)
+	}
+	return store, json.Unmarshal(keystore, &store)
+}
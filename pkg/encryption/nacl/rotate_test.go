@@ -0,0 +1,179 @@
+package nacl
+
+import (
+	"context"
+	crypto_rand "crypto/rand"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func rotateTestClient() kclient.Client {
+	return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "acorn",
+			UID:  "namespace-uid-1234",
+		},
+	}).Build()
+}
+
+func encryptTestData(t *testing.T, msg string, keys ...string) []byte {
+	t.Helper()
+	data, err := MultipleKeyEncrypt(msg, keys)
+	require.NoError(t, err)
+	result, err := data.Marshal()
+	require.NoError(t, err)
+	return []byte(result)
+}
+
+func primaryKeyIDs(t *testing.T, c kclient.Reader) []string {
+	t.Helper()
+	keys, err := GetAllNaclKeys(context.Background(), c, "acorn")
+	require.NoError(t, err)
+
+	var result []string
+	for keyID, key := range keys {
+		if keyID != "primary" && z.Dereference(key.Primary) {
+			result = append(result, keyID)
+		}
+	}
+	return result
+}
+
+func TestRotatePrimaryNaclKey(t *testing.T) {
+	ctx := context.Background()
+	c := rotateTestClient()
+
+	oldKey, err := GetOrCreatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	oldKeyID := KeyBytesToB64String(oldKey.PublicKey)
+	encrypted := encryptTestData(t, "secret", oldKeyID)
+
+	newKey, err := RotatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	newKeyID := KeyBytesToB64String(newKey.PublicKey)
+	assert.NotEqual(t, oldKeyID, newKeyID)
+	assert.Equal(t, []string{newKeyID}, primaryKeyIDs(t, c))
+
+	publicKey, err := GetPublicKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.Equal(t, newKeyID, publicKey)
+
+	// Data encrypted with the previous key can still be decrypted
+	decrypted, err := DecryptNamespacedData(ctx, c, encrypted, "acorn")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(decrypted))
+
+	// Rotating again keeps a single primary key
+	newestKey, err := RotatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.Equal(t, []string{KeyBytesToB64String(newestKey.PublicKey)}, primaryKeyIDs(t, c))
+
+	keys, err := GetAllNaclKeys(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.Len(t, keys, 4, "expected three keys and the primary alias")
+}
+
+func TestRotatePrimaryNaclKeyWithoutKeys(t *testing.T) {
+	c := rotateTestClient()
+
+	key, err := RotatePrimaryNaclKey(context.Background(), c, "acorn")
+	require.NoError(t, err)
+	assert.Equal(t, []string{KeyBytesToB64String(key.PublicKey)}, primaryKeyIDs(t, c))
+}
+
+func TestReEncryptNamespacedData(t *testing.T) {
+	ctx := context.Background()
+	c := rotateTestClient()
+
+	oldKey, err := GetOrCreatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	oldKeyID := KeyBytesToB64String(oldKey.PublicKey)
+
+	// The key of another cluster, which can not be re-encrypted
+	foreignKey, _, err := box.GenerateKey(crypto_rand.Reader)
+	require.NoError(t, err)
+	foreignKeyID := KeyBytesToB64String(foreignKey)
+
+	encrypted := encryptTestData(t, "secret", oldKeyID, foreignKeyID)
+
+	// Data already encrypted with the primary key is unchanged
+	reEncrypted, changed, err := ReEncryptNamespacedData(ctx, c, encrypted, "acorn")
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, encrypted, reEncrypted)
+
+	newKey, err := RotatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	newKeyID := KeyBytesToB64String(newKey.PublicKey)
+
+	reEncrypted, changed, err = ReEncryptNamespacedData(ctx, c, encrypted, "acorn")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	keyIDs, err := KeyIDs(reEncrypted)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{newKeyID, foreignKeyID}, keyIDs)
+
+	decrypted, err := newKey.Decrypt(reEncrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(decrypted))
+
+	_, changed, err = ReEncryptNamespacedData(ctx, c, reEncrypted, "acorn")
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestRetireNaclKey(t *testing.T) {
+	ctx := context.Background()
+	c := rotateTestClient()
+
+	oldKey, err := GetOrCreatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	oldKeyID := KeyBytesToB64String(oldKey.PublicKey)
+	encrypted := encryptTestData(t, "secret", oldKeyID)
+
+	err = RetireNaclKey(ctx, c, "acorn", oldKeyID)
+	assert.ErrorContains(t, err, "is the primary key of namespace acorn and can not be retired")
+
+	newKey, err := RotatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	newKeyID := KeyBytesToB64String(newKey.PublicKey)
+
+	require.NoError(t, RetireNaclKey(ctx, c, "acorn", oldKeyID))
+
+	keys, err := GetAllNaclKeys(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.NotContains(t, keys, oldKeyID)
+	assert.Equal(t, []string{newKeyID}, primaryKeyIDs(t, c))
+
+	_, err = DecryptNamespacedData(ctx, c, encrypted, "acorn")
+	assert.Error(t, err)
+
+	err = RetireNaclKey(ctx, c, "acorn", oldKeyID)
+	assert.IsType(t, &ErrKeyNotFound{}, err)
+}
+
+// TestGenerateNewKeysSinglePrimary tests that adding a key to an existing key store demotes the previous primary key
+func TestGenerateNewKeysSinglePrimary(t *testing.T) {
+	ctx := context.Background()
+	c := rotateTestClient()
+
+	_, err := GetOrCreatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+
+	existing, err := getExistingSecret(ctx, c, "acorn")
+	require.NoError(t, err)
+
+	key, err := generateNewKeys(ctx, c, "acorn", existing)
+	require.NoError(t, err)
+	assert.Equal(t, []string{KeyBytesToB64String(key.PublicKey)}, primaryKeyIDs(t, c))
+}
@@ -0,0 +1,283 @@
+package encryption
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/acorn-io/aml/pkg/replace"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/z"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetProjectKeys returns the encryption keys of the namespace, the primary key first, along with the objects
+// in the namespace that hold data encrypted with each key. The images of the namespace are pulled with opts to
+// find the encrypted data embedded in their Acornfiles.
+func GetProjectKeys(ctx context.Context, c kclient.Reader, namespace string, opts ...remote.Option) ([]apiv1.ProjectKey, error) {
+	keys, err := nacl.GetAllNaclKeys(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := keyReferences(ctx, c, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]apiv1.ProjectKey, 0, len(keys))
+	for keyID, key := range keys {
+		if keyID == "primary" {
+			continue
+		}
+		result = append(result, apiv1.ProjectKey{
+			KeyID:      keyID,
+			Primary:    z.Dereference(key.Primary),
+			References: references[keyID],
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Primary != result[j].Primary {
+			return result[i].Primary
+		}
+		return result[i].KeyID < result[j].KeyID
+	})
+	return result, nil
+}
+
+// RotateKey generates a new primary key for the namespace and re-encrypts the secrets and app deploy args that hold
+// data encrypted with the previous keys. Data embedded in images can not be re-encrypted, the images referencing
+// old keys are reported by GetProjectKeys until they are rebuilt.
+func RotateKey(ctx context.Context, c kclient.Client, namespace string) (*apiv1.ProjectKeyRotation, error) {
+	key, err := nacl.RotatePrimaryNaclKey(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &apiv1.ProjectKeyRotation{
+		PrimaryKey: nacl.KeyBytesToB64String(key.PublicKey),
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := c.List(ctx, secrets, kclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets.Items {
+		var changed bool
+		for k, v := range secret.Data {
+			if !nacl.IsAcornEncryptedData(v) {
+				continue
+			}
+			reEncrypted, ok, err := nacl.ReEncryptNamespacedData(ctx, c, v, namespace)
+			if err != nil {
+				return nil, fmt.Errorf("failed to re-encrypt key %s of secret %s: %w", k, secret.Name, err)
+			}
+			if ok {
+				secret.Data[k] = reEncrypted
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := c.Update(ctx, &secret); err != nil {
+			return nil, err
+		}
+		result.ReEncrypted = append(result.ReEncrypted, apiv1.ProjectKeyReference{
+			Kind: apiv1.ProjectKeyReferenceKindSecret,
+			Name: secret.Name,
+		})
+	}
+
+	apps := &v1.AppInstanceList{}
+	if err := c.List(ctx, apps, kclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps.Items {
+		if len(app.Spec.DeployArgs) == 0 {
+			continue
+		}
+
+		deployArgs, err := json.Marshal(app.Spec.DeployArgs)
+		if err != nil {
+			return nil, err
+		}
+
+		var changed bool
+		content, err := replace.Replace(string(deployArgs), nacl.EncPrefix, nacl.EncSuffix, func(s string) (string, bool, error) {
+			reEncrypted, ok, err := nacl.ReEncryptNamespacedData(ctx, c, []byte(nacl.EncPrefix+s+nacl.EncSuffix), namespace)
+			changed = changed || ok
+			return string(reEncrypted), ok, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to re-encrypt deploy args of app %s: %w", app.Name, err)
+		}
+		if !changed {
+			continue
+		}
+
+		app.Spec.DeployArgs = v1.GenericMap{}
+		if err := json.Unmarshal([]byte(content), &app.Spec.DeployArgs); err != nil {
+			return nil, err
+		}
+		if err := c.Update(ctx, &app); err != nil {
+			return nil, err
+		}
+		result.ReEncrypted = append(result.ReEncrypted, apiv1.ProjectKeyReference{
+			Kind: apiv1.ProjectKeyReferenceKindApp,
+			Name: app.Name,
+		})
+	}
+
+	return result, nil
+}
+
+// RetireKey removes the key from the namespace. It fails if the key is the primary key or if any object in the
+// namespace still holds data encrypted with the key, including images that no app runs. The images are pulled with
+// opts, and the key is not retired if any of them can not be read.
+func RetireKey(ctx context.Context, c kclient.Client, namespace, keyID string, opts ...remote.Option) error {
+	references, err := keyReferences(ctx, c, namespace, opts)
+	if err != nil {
+		return err
+	}
+
+	if refs := references[keyID]; len(refs) > 0 {
+		names := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			names = append(names, ref.Kind+"/"+ref.Name)
+		}
+		return fmt.Errorf("key %s can not be retired, it is still referenced by %s", keyID, strings.Join(names, ", "))
+	}
+
+	return nacl.RetireNaclKey(ctx, c, namespace, keyID)
+}
+
+// keyReferences returns the objects in the namespace holding encrypted data, keyed by the IDs of the keys the data
+// is encrypted with.
+func keyReferences(ctx context.Context, c kclient.Reader, namespace string, opts []remote.Option) (map[string][]apiv1.ProjectKeyReference, error) {
+	result := map[string][]apiv1.ProjectKeyReference{}
+	add := func(keyIDs map[string]struct{}, kind, name string) {
+		for keyID := range keyIDs {
+			result[keyID] = append(result[keyID], apiv1.ProjectKeyReference{
+				Kind: kind,
+				Name: name,
+			})
+		}
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := c.List(ctx, secrets, kclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets.Items {
+		keyIDs := map[string]struct{}{}
+		for _, v := range secret.Data {
+			if !nacl.IsAcornEncryptedData(v) {
+				continue
+			}
+			if err := addKeyIDs(keyIDs, string(v)); err != nil {
+				return nil, fmt.Errorf("failed to read encrypted data of secret %s: %w", secret.Name, err)
+			}
+		}
+		add(keyIDs, apiv1.ProjectKeyReferenceKindSecret, secret.Name)
+	}
+
+	apps := &v1.AppInstanceList{}
+	if err := c.List(ctx, apps, kclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	seenImages, seenDigests := map[string]struct{}{}, map[string]struct{}{}
+	for _, app := range apps.Items {
+		deployArgs, err := json.Marshal(app.Spec.DeployArgs)
+		if err != nil {
+			return nil, err
+		}
+
+		keyIDs := map[string]struct{}{}
+		if err := addKeyIDs(keyIDs, string(deployArgs)); err != nil {
+			return nil, fmt.Errorf("failed to read encrypted deploy args of app %s: %w", app.Name, err)
+		}
+		add(keyIDs, apiv1.ProjectKeyReferenceKindApp, app.Name)
+
+		image := app.Status.AppImage.Name
+		if image == "" {
+			image = app.Status.AppImage.ID
+		}
+		if _, seen := seenImages[image]; seen || image == "" {
+			continue
+		}
+		seenImages[image] = struct{}{}
+		if app.Status.AppImage.Digest != "" {
+			seenDigests[app.Status.AppImage.Digest] = struct{}{}
+		}
+
+		keyIDs = map[string]struct{}{}
+		if err := addKeyIDs(keyIDs, app.Status.AppImage.Acornfile); err != nil {
+			return nil, fmt.Errorf("failed to read encrypted data of image %s: %w", image, err)
+		}
+		add(keyIDs, apiv1.ProjectKeyReferenceKindImage, image)
+	}
+
+	// Images that were built or pulled but are not run by any app can hold encrypted data as well
+	imageList := &v1.ImageInstanceList{}
+	if err := c.List(ctx, imageList, kclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, image := range imageList.Items {
+		if _, seen := seenDigests[image.Digest]; seen {
+			continue
+		}
+
+		appImage, err := images.PullAppImage(ctx, c, namespace, image.Name, "", opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read image %s: %w", image.Name, err)
+		}
+
+		name := image.Name
+		if len(image.Tags) > 0 {
+			name = image.Tags[0]
+		}
+
+		keyIDs := map[string]struct{}{}
+		if err := addKeyIDs(keyIDs, appImage.Acornfile); err != nil {
+			return nil, fmt.Errorf("failed to read encrypted data of image %s: %w", name, err)
+		}
+		add(keyIDs, apiv1.ProjectKeyReferenceKindImage, name)
+	}
+
+	for _, refs := range result {
+		sort.Slice(refs, func(i, j int) bool {
+			if refs[i].Kind != refs[j].Kind {
+				return refs[i].Kind < refs[j].Kind
+			}
+			return refs[i].Name < refs[j].Name
+		})
+	}
+
+	return result, nil
+}
+
+// addKeyIDs adds the IDs of the keys of all encrypted values embedded in content to keyIDs.
+func addKeyIDs(keyIDs map[string]struct{}, content string) error {
+	_, err := replace.Replace(content, nacl.EncPrefix, nacl.EncSuffix, func(s string) (string, bool, error) {
+		ids, err := nacl.KeyIDs([]byte(nacl.EncPrefix + s + nacl.EncSuffix))
+		for _, id := range ids {
+			keyIDs[id] = struct{}{}
+		}
+		return "", false, err
+	})
+	return err
+}
@@ -0,0 +1,235 @@
+package encryption
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appdefinition"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func encryptForTest(t *testing.T, msg, keyID string) string {
+	t.Helper()
+	data, err := nacl.Encrypt(msg, keyID)
+	require.NoError(t, err)
+	result, err := data.Marshal()
+	require.NoError(t, err)
+	return result
+}
+
+// newTestProject returns a client for a project named acorn with a primary key, and the ID of the key
+func newTestProject(t *testing.T, objs func(keyID string) []client.Object) (client.Client, string) {
+	t.Helper()
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "acorn",
+			UID:  "namespace-uid-1234",
+		},
+	}).Build()
+
+	key, err := nacl.GetOrCreatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	keyID := nacl.KeyBytesToB64String(key.PublicKey)
+
+	for _, obj := range objs(keyID) {
+		require.NoError(t, c.Create(ctx, obj))
+	}
+	return c, keyID
+}
+
+func TestRotateKey(t *testing.T) {
+	ctx := context.Background()
+	var encryptedSecret, encryptedArg string
+	c, oldKeyID := newTestProject(t, func(keyID string) []client.Object {
+		encryptedSecret = encryptForTest(t, "password", keyID)
+		encryptedArg = encryptForTest(t, "arg", keyID)
+		return []client.Object{
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "encrypted",
+					Namespace: "acorn",
+				},
+				Data: map[string][]byte{
+					"password": []byte(encryptedSecret),
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "plain",
+					Namespace: "acorn",
+				},
+				Data: map[string][]byte{
+					"password": []byte("plain"),
+				},
+			},
+			&v1.AppInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "app",
+					Namespace: "acorn",
+				},
+				Spec: v1.AppInstanceSpec{
+					DeployArgs: v1.GenericMap{
+						"password": encryptedArg,
+						"replicas": int64(1),
+					},
+				},
+			},
+		}
+	})
+
+	rotation, err := RotateKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.NotEqual(t, oldKeyID, rotation.PrimaryKey)
+	assert.Equal(t, []apiv1.ProjectKeyReference{
+		{Kind: apiv1.ProjectKeyReferenceKindSecret, Name: "encrypted"},
+		{Kind: apiv1.ProjectKeyReferenceKindApp, Name: "app"},
+	}, rotation.ReEncrypted)
+
+	// The old ciphertext is still readable until the old key is retired
+	decrypted, err := nacl.DecryptNamespacedData(ctx, c, []byte(encryptedSecret), "acorn")
+	require.NoError(t, err)
+	assert.Equal(t, "password", string(decrypted))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "acorn", Name: "encrypted"}, secret))
+	keyIDs, err := nacl.KeyIDs(secret.Data["password"])
+	require.NoError(t, err)
+	assert.Equal(t, []string{rotation.PrimaryKey}, keyIDs)
+
+	app := &v1.AppInstance{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "acorn", Name: "app"}, app))
+	assert.EqualValues(t, 1, app.Spec.DeployArgs["replicas"])
+	keyIDs, err = nacl.KeyIDs([]byte(app.Spec.DeployArgs["password"].(string)))
+	require.NoError(t, err)
+	assert.Equal(t, []string{rotation.PrimaryKey}, keyIDs)
+
+	keys, err := GetProjectKeys(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.Equal(t, []apiv1.ProjectKey{
+		{
+			KeyID:   rotation.PrimaryKey,
+			Primary: true,
+			References: []apiv1.ProjectKeyReference{
+				{Kind: apiv1.ProjectKeyReferenceKindApp, Name: "app"},
+				{Kind: apiv1.ProjectKeyReferenceKindSecret, Name: "encrypted"},
+			},
+		},
+		{
+			KeyID: oldKeyID,
+		},
+	}, keys)
+
+	// Nothing references the old key anymore, so it can be retired
+	require.NoError(t, RetireKey(ctx, c, "acorn", oldKeyID))
+
+	decrypted, err = nacl.DecryptNamespacedData(ctx, c, secret.Data["password"], "acorn")
+	require.NoError(t, err)
+	assert.Equal(t, "password", string(decrypted))
+
+	_, err = nacl.DecryptNamespacedData(ctx, c, []byte(encryptedSecret), "acorn")
+	assert.Error(t, err)
+
+	// The primary key can not be retired
+	assert.Error(t, RetireKey(ctx, c, "acorn", rotation.PrimaryKey))
+}
+
+// TestRetireKeyReferenced tests that a key still referenced by data that can not be re-encrypted is not retired
+func TestRetireKeyReferenced(t *testing.T) {
+	ctx := context.Background()
+	c, oldKeyID := newTestProject(t, func(keyID string) []client.Object {
+		return []client.Object{
+			&v1.AppInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "app",
+					Namespace: "acorn",
+				},
+				Status: v1.AppInstanceStatus{
+					AppImage: v1.AppImage{
+						Name:      "ghcr.io/acorn-io/app:v1",
+						Acornfile: `args: password: "` + encryptForTest(t, "password", keyID) + `"`,
+					},
+				},
+			},
+		}
+	})
+
+	rotation, err := RotateKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.Empty(t, rotation.ReEncrypted)
+
+	err = RetireKey(ctx, c, "acorn", oldKeyID)
+	assert.EqualError(t, err, "key "+oldKeyID+" can not be retired, it is still referenced by image/ghcr.io/acorn-io/app:v1")
+
+	keys, err := nacl.GetAllNaclKeys(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.Contains(t, keys, oldKeyID)
+}
+
+// TestRetireKeyReferencedByImage tests that a key still referenced by an image that no app runs is not retired
+func TestRetireKeyReferencedByImage(t *testing.T) {
+	ctx := context.Background()
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	repo, err := name.NewRepository(u.Host + "/acorn/acorn")
+	require.NoError(t, err)
+
+	c, oldKeyID := newTestProject(t, func(keyID string) []client.Object {
+		layer, err := crane.Layer(map[string][]byte{
+			appdefinition.AcornCueFile: []byte(`args: password: "` + encryptForTest(t, "password", keyID) + `"`),
+		})
+		require.NoError(t, err)
+		img, err := mutate.AppendLayers(empty.Image, layer)
+		require.NoError(t, err)
+		index := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{Add: img})
+		digest, err := index.Digest()
+		require.NoError(t, err)
+		require.NoError(t, remote.WriteIndex(repo.Digest(digest.String()), index))
+
+		return []client.Object{
+			&v1.ImageInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      digest.Hex,
+					Namespace: "acorn",
+				},
+				Repo:   repo.String(),
+				Digest: digest.String(),
+				Tags:   []string{"acorn/app:v1"},
+			},
+		}
+	})
+
+	_, err = RotateKey(ctx, c, "acorn")
+	require.NoError(t, err)
+
+	keys, err := GetProjectKeys(ctx, c, "acorn")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, []apiv1.ProjectKeyReference{
+		{Kind: apiv1.ProjectKeyReferenceKindImage, Name: "acorn/app:v1"},
+	}, keys[1].References)
+
+	err = RetireKey(ctx, c, "acorn", oldKeyID)
+	assert.EqualError(t, err, "key "+oldKeyID+" can not be retired, it is still referenced by image/acorn/app:v1")
+}
@@ -6,7 +6,9 @@ import (
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/z"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,6 +52,7 @@ func init() {
 	z.Must(
 		apiv1.AddToScheme(scheme),
 		internalv1.AddToScheme(scheme),
+		corev1.AddToScheme(scheme),
 	)
 }
 
@@ -59,6 +62,12 @@ func publicKind(obj runtime.Object) string {
 		switch k.Kind {
 		case "App", "AppInstance":
 			return "app"
+		case "Secret":
+			return "secret"
+		case "ContainerReplica":
+			return "container"
+		case "Project", "ProjectInstance":
+			return "project"
 		}
 	}
 	return ""
@@ -72,3 +81,11 @@ func Resource(obj kclient.Object) *internalv1.EventResource {
 		UID:  obj.GetUID(),
 	}
 }
+
+// AuditLabels returns the labels that mark an event as an audit event.
+// Audit events are stored for the configured AuditEventTTL instead of the EventTTL.
+func AuditLabels() map[string]string {
+	return map[string]string{
+		labels.AcornAuditEvent: "true",
+	}
+}
@@ -0,0 +1,6 @@
+// Package gatewayapi contains the subset of the Kubernetes Gateway API (gateway.networking.k8s.io) types that
+// Acorn creates or reads when publishing endpoints through a Gateway. Fields that Acorn doesn't use are omitted,
+// unknown fields of existing objects are preserved by the server on update.
+// +k8s:deepcopy-gen=package
+
+package gatewayapi
@@ -0,0 +1,43 @@
+package gatewayapi
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const Group = "gateway.networking.k8s.io"
+
+var (
+	V1Beta1SchemeGroupVersion = schema.GroupVersion{
+		Group:   Group,
+		Version: "v1beta1",
+	}
+	V1Alpha2SchemeGroupVersion = schema.GroupVersion{
+		Group:   Group,
+		Version: "v1alpha2",
+	}
+)
+
+func AddToScheme(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(V1Beta1SchemeGroupVersion,
+		&Gateway{},
+		&GatewayList{},
+		&HTTPRoute{},
+		&HTTPRouteList{},
+		&ReferenceGrant{},
+		&ReferenceGrantList{},
+	)
+	scheme.AddKnownTypes(V1Alpha2SchemeGroupVersion,
+		&TCPRoute{},
+		&TCPRouteList{},
+		&UDPRoute{},
+		&UDPRouteList{},
+		&TLSRoute{},
+		&TLSRouteList{},
+	)
+
+	metav1.AddToGroupVersion(scheme, V1Beta1SchemeGroupVersion)
+	metav1.AddToGroupVersion(scheme, V1Alpha2SchemeGroupVersion)
+	return nil
+}
@@ -0,0 +1,123 @@
+package imageallowrules
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/attestation"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// listAttestations returns the attestations attached to the image with the digest and to the images in it
+func listAttestations(ctx context.Context, c client.Reader, namespace, image, digest string, opts ...remote.Option) ([]v1.ImageAttestation, error) {
+	ref, err := images.GetImageReference(ctx, c, namespace, image)
+	if err != nil {
+		return nil, err
+	}
+
+	if tags.SHAPattern.MatchString(digest) {
+		digest = "sha256:" + digest
+	}
+
+	return attestation.List(ref.Context().Digest(digest), opts...)
+}
+
+// checkAttestations returns why the attestations don't satisfy the rules, or nil if they do
+func checkAttestations(rules []v1.AttestationRules, attestations []v1.ImageAttestation) error {
+	for i, rule := range rules {
+		if err := checkAttestationRule(rule, attestations); err != nil {
+			return fmt.Errorf("attestations.rules.%d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func checkAttestationRule(rule v1.AttestationRules, attestations []v1.ImageAttestation) error {
+	for _, predicateType := range rule.PredicateTypes {
+		if len(withPredicateType(attestations, predicateType)) == 0 {
+			return fmt.Errorf("no attestation with predicate type %s", predicateType)
+		}
+	}
+
+	if rule.Provenance != nil {
+		provenances := withPredicateType(attestations, attestation.PredicateTypeProvenance)
+		if len(provenances) == 0 {
+			return fmt.Errorf("no provenance attestation")
+		}
+		if len(rule.Provenance.BuilderIDs) > 0 {
+			for _, provenance := range provenances {
+				builderID := nestedString(provenance.Predicate, "builder", "id")
+				if !slices.Contains(rule.Provenance.BuilderIDs, builderID) {
+					return fmt.Errorf("provenance of %s names builder [%s], which is not an allowed builder", provenance.Subject, builderID)
+				}
+			}
+		}
+	}
+
+	if rule.SBOM != nil {
+		sboms := withPredicateType(attestations, attestation.PredicateTypeSPDX)
+		if len(sboms) == 0 {
+			return fmt.Errorf("no SBOM attestation")
+		}
+		for _, sbom := range sboms {
+			if pkg, pattern, denied := deniedPackage(sbom.Predicate, rule.SBOM.DenyPackages); denied {
+				return fmt.Errorf("SBOM of %s contains package [%s], which is denied by [%s]", sbom.Subject, pkg, pattern)
+			}
+		}
+	}
+
+	return nil
+}
+
+func withPredicateType(attestations []v1.ImageAttestation, predicateType string) (result []v1.ImageAttestation) {
+	for _, attestation := range attestations {
+		if attestation.PredicateType == predicateType {
+			result = append(result, attestation)
+		}
+	}
+	return
+}
+
+// deniedPackage returns the first package of the SPDX document that matches a pattern. Patterns with an @ are matched
+// against NAME@VERSION, others against the name of the package.
+func deniedPackage(spdx v1.GenericMap, patterns []string) (string, string, bool) {
+	if len(patterns) == 0 {
+		return "", "", false
+	}
+
+	packages, _ := spdx["packages"].([]interface{})
+	for _, pkg := range packages {
+		pkgMap, _ := pkg.(map[string]interface{})
+		name := nestedString(pkgMap, "name")
+		version := nestedString(pkgMap, "versionInfo")
+		for _, pattern := range patterns {
+			target := name
+			if strings.Contains(pattern, "@") {
+				target = name + "@" + version
+			}
+			if ok, _ := path.Match(pattern, target); ok {
+				return target, pattern, true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+func nestedString(data map[string]interface{}, fields ...string) string {
+	for i, field := range fields {
+		if i == len(fields)-1 {
+			s, _ := data[field].(string)
+			return s
+		}
+		data, _ = data[field].(map[string]interface{})
+	}
+	return ""
+}
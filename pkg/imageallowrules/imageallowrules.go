@@ -25,12 +25,18 @@ import (
 
 type ErrImageNotAllowed struct {
 	Image string
+	// Reasons are why the ImageAllowRules covering the image didn't allow it
+	Reasons []string
 }
 
 const ErrImageNotAllowedIdentifier = "not allowed by any ImageAllowRule"
 
 func (e *ErrImageNotAllowed) Error() string {
-	return fmt.Sprintf("image <%s> is %s in this project", e.Image, ErrImageNotAllowedIdentifier)
+	msg := fmt.Sprintf("image <%s> is %s in this project", e.Image, ErrImageNotAllowedIdentifier)
+	if len(e.Reasons) > 0 {
+		msg += ": " + strings.Join(e.Reasons, "; ")
+	}
+	return msg
 }
 
 func (e *ErrImageNotAllowed) Is(target error) bool {
@@ -75,6 +81,25 @@ func CheckImageAgainstRules(ctx context.Context, c client.Reader, namespace stri
 		return nil
 	}
 
+	return checkImageAgainstRules(ctx, c, namespace, image, digest, imageAllowRules, keychain, opts...)
+}
+
+// VerifyImage checks if the image is allowed by the given ImageAllowRules, even if ImageAllowRules are not enabled
+func VerifyImage(ctx context.Context, c client.Reader, namespace, image, digest string, imageAllowRules []v1.ImageAllowRuleInstance, opts ...remote.Option) error {
+	opts, err := images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return err
+	}
+
+	keychain, err := images.GetAuthenticationRemoteKeychainWithLocalAuth(ctx, nil, nil, c, namespace)
+	if err != nil {
+		return err
+	}
+
+	return checkImageAgainstRules(ctx, c, namespace, image, digest, imageAllowRules, keychain, opts...)
+}
+
+func checkImageAgainstRules(ctx context.Context, c client.Reader, namespace string, image string, digest string, imageAllowRules []v1.ImageAllowRuleInstance, keychain authn.Keychain, opts ...remote.Option) error {
 	// No rules? Deny all images.
 	if len(imageAllowRules) == 0 {
 		return &ErrImageNotAllowed{Image: image}
@@ -82,6 +107,13 @@ func CheckImageAgainstRules(ctx context.Context, c client.Reader, namespace stri
 
 	logrus.Debugf("Checking image %s (%s) against %d rules", image, digest, len(imageAllowRules))
 
+	var (
+		imageDigest  = digest
+		attestations []v1.ImageAttestation
+		listed       bool
+		reasons      []string
+	)
+
 	// Check if the image is allowed
 	verifyOpts := cosign.VerifyOpts{
 		Namespace:          namespace,
@@ -131,6 +163,7 @@ iarLoop:
 						if _, ok := err.(*ocosign.VerificationError); !ok {
 							logrus.Errorf("error verifying image %s against %s/%s.signatures.allOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex, err)
 						}
+						reasons = append(reasons, fmt.Sprintf("%s/%s: signature verification failed for signatures.allOf.%d", imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex))
 						continue iarLoop // failed or errored in allOf, try next IAR
 					}
 				}
@@ -162,14 +195,31 @@ iarLoop:
 						e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.*: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, merr.NewErrors(anyOfErrs...))
 						logrus.Errorln(e.Error())
 					}
+					reasons = append(reasons, fmt.Sprintf("%s/%s: signature verification failed for all of signatures.anyOf", imageAllowRule.Namespace, imageAllowRule.Name))
 					continue iarLoop // failed or errored in all anyOf, try next IAR
 				}
 			}
 		}
 
+		// > Attestations
+		if len(imageAllowRule.Attestations.Rules) > 0 {
+			if !listed {
+				attestations, err = listAttestations(ctx, c, namespace, image, imageDigest, opts...)
+				if err != nil {
+					return fmt.Errorf("error listing attestations of image %s: %w", image, err)
+				}
+				listed = true
+			}
+			if err := checkAttestations(imageAllowRule.Attestations.Rules, attestations); err != nil {
+				logrus.Debugf("image %s not allowed as per %s/%s: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, err)
+				reasons = append(reasons, fmt.Sprintf("%s/%s: %v", imageAllowRule.Namespace, imageAllowRule.Name, err))
+				continue iarLoop
+			}
+		}
+
 		return nil
 	}
-	return &ErrImageNotAllowed{Image: image}
+	return &ErrImageNotAllowed{Image: image, Reasons: reasons}
 }
 
 func imageCovered(image name.Reference, digest string, iar v1.ImageAllowRuleInstance) bool {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/attestation"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -103,3 +104,110 @@ func TestImageCovered(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckAttestations(t *testing.T) {
+	attestations := []v1.ImageAttestation{
+		{
+			Subject:       "sha256:app",
+			PredicateType: attestation.PredicateTypeProvenance,
+			Predicate: v1.GenericMap{
+				"builder": map[string]interface{}{
+					"id": attestation.BuilderID,
+				},
+			},
+		},
+		{
+			Subject:       "sha256:web",
+			PredicateType: attestation.PredicateTypeSPDX,
+			Predicate: v1.GenericMap{
+				"packages": []interface{}{
+					map[string]interface{}{
+						"name":        "log4j-core",
+						"versionInfo": "2.14.1",
+					},
+					map[string]interface{}{
+						"name":        "musl",
+						"versionInfo": "1.2.3",
+					},
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name   string
+		rule   v1.AttestationRules
+		reason string
+	}{
+		{
+			name: "provenance and sbom present",
+			rule: v1.AttestationRules{
+				Provenance: &v1.ProvenanceRules{},
+				SBOM:       &v1.SBOMRules{},
+			},
+		},
+		{
+			name: "allowed builder",
+			rule: v1.AttestationRules{
+				Provenance: &v1.ProvenanceRules{BuilderIDs: []string{"https://example.com/builder", attestation.BuilderID}},
+			},
+		},
+		{
+			name: "other builder",
+			rule: v1.AttestationRules{
+				Provenance: &v1.ProvenanceRules{BuilderIDs: []string{"https://example.com/builder"}},
+			},
+			reason: "attestations.rules.0: provenance of sha256:app names builder [https://github.com/acorn-io/runtime], which is not an allowed builder",
+		},
+		{
+			name: "denied package",
+			rule: v1.AttestationRules{
+				SBOM: &v1.SBOMRules{DenyPackages: []string{"openssl", "log4j-*"}},
+			},
+			reason: "attestations.rules.0: SBOM of sha256:web contains package [log4j-core], which is denied by [log4j-*]",
+		},
+		{
+			name: "denied package version",
+			rule: v1.AttestationRules{
+				SBOM: &v1.SBOMRules{DenyPackages: []string{"log4j-core@2.14.*"}},
+			},
+			reason: "attestations.rules.0: SBOM of sha256:web contains package [log4j-core@2.14.1], which is denied by [log4j-core@2.14.*]",
+		},
+		{
+			name: "allowed package version",
+			rule: v1.AttestationRules{
+				SBOM: &v1.SBOMRules{DenyPackages: []string{"log4j-core@2.13.*"}},
+			},
+		},
+		{
+			name: "missing predicate type",
+			rule: v1.AttestationRules{
+				PredicateTypes: []string{"https://cyclonedx.org/bom"},
+			},
+			reason: "attestations.rules.0: no attestation with predicate type https://cyclonedx.org/bom",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkAttestations([]v1.AttestationRules{tc.rule}, attestations)
+			if tc.reason == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.reason)
+			}
+		})
+	}
+
+	assert.EqualError(t, checkAttestations([]v1.AttestationRules{{SBOM: &v1.SBOMRules{}}}, attestations[:1]), "attestations.rules.0: no SBOM attestation")
+	assert.EqualError(t, checkAttestations([]v1.AttestationRules{{Provenance: &v1.ProvenanceRules{}}}, nil), "attestations.rules.0: no provenance attestation")
+}
+
+func TestErrImageNotAllowedReasons(t *testing.T) {
+	err := &ErrImageNotAllowed{
+		Image:   "ghcr.io/acorn-io/app:v1",
+		Reasons: []string{"acorn/sbom: attestations.rules.0: no SBOM attestation"},
+	}
+	assert.Equal(t, "image <ghcr.io/acorn-io/app:v1> is not allowed by any ImageAllowRule in this project: acorn/sbom: attestations.rules.0: no SBOM attestation", err.Error())
+	assert.Contains(t, err.Error(), ErrImageNotAllowedIdentifier)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/acorn-io/baaah/pkg/router"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/attestation"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	"github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/tags"
@@ -17,6 +19,21 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// GetAttestations returns the SBOMs and provenance attached to the image with the digest and to the images in it
+func GetAttestations(ctx context.Context, c kclient.Client, namespace, imageName, digest string, opts ...remote.Option) ([]v1.ImageAttestation, error) {
+	ref, err := images.GetImageReference(ctx, c, namespace, strings.ReplaceAll(imageName, "+", "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err = images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return attestation.List(ref.Context().Digest(digest), opts...)
+}
+
 func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName string, profiles []string, deployArgs map[string]any, nested string, noDefaultReg bool, opts ...remote.Option) (*apiv1.ImageDetails, error) {
 	imageName = strings.ReplaceAll(imageName, "+", "/")
 	name := strings.ReplaceAll(imageName, "/", "+")
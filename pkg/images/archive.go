@@ -0,0 +1,177 @@
+package images
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+)
+
+// RefNameAnnotation is the annotation of the OCI image layout holding the name the image was saved with
+const RefNameAnnotation = "org.opencontainers.image.ref.name"
+
+// WriteArchive writes the index and all images in it as an OCI image layout tarball. The name, if any, is recorded
+// so that the image can be tagged with it when it is loaded.
+func WriteArchive(w io.Writer, index ggcrv1.ImageIndex, name string) error {
+	dir, err := os.MkdirTemp("", "acorn-image-save-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	path, err := layout.Write(dir, empty.Index)
+	if err != nil {
+		return err
+	}
+
+	var opts []layout.Option
+	if name != "" {
+		opts = append(opts, layout.WithAnnotations(map[string]string{
+			RefNameAnnotation: name,
+		}))
+	}
+	if err := path.AppendIndex(index, opts...); err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+	err = filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil || file == dir {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// ReadArchive extracts the OCI image layout tarball written by WriteArchive into dir and returns the index in it and
+// the name it was saved with. Archives larger than maxSize bytes are rejected, a maxSize of 0 disables the limit.
+func ReadArchive(r io.Reader, dir string, maxSize int64) (ggcrv1.ImageIndex, string, error) {
+	if maxSize > 0 {
+		// read one byte more than allowed, so that archives of exactly maxSize bytes can be told apart from larger ones
+		r = &sizeLimitReader{reader: io.LimitReader(r, maxSize+1), maxSize: maxSize}
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, "", err
+		}
+		if maxSize > 0 && header.Size > maxSize {
+			return nil, "", fmt.Errorf("entry %s of image archive exceeds the maximum size of %d bytes", header.Name, maxSize)
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(filepath.Separator)) {
+			return nil, "", fmt.Errorf("invalid path %s in image archive", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return nil, "", err
+			}
+		case tar.TypeReg:
+			if err := extractFile(target, tr); err != nil {
+				return nil, "", err
+			}
+		default:
+			return nil, "", fmt.Errorf("invalid entry %s in image archive", header.Name)
+		}
+	}
+
+	layoutIndex, err := layout.ImageIndexFromPath(dir)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid image archive: %w", err)
+	}
+
+	manifest, err := layoutIndex.IndexManifest()
+	if err != nil {
+		return nil, "", err
+	}
+	if len(manifest.Manifests) != 1 || !manifest.Manifests[0].MediaType.IsIndex() {
+		return nil, "", fmt.Errorf("invalid image archive, expected one Acorn image, found %d images", len(manifest.Manifests))
+	}
+
+	index, err := layoutIndex.ImageIndex(manifest.Manifests[0].Digest)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return index, manifest.Manifests[0].Annotations[RefNameAnnotation], nil
+}
+
+func extractFile(target string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// sizeLimitReader fails once more than maxSize bytes are read
+type sizeLimitReader struct {
+	reader  io.Reader
+	read    int64
+	maxSize int64
+}
+
+func (s *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := s.reader.Read(p)
+	s.read += int64(n)
+	if s.read > s.maxSize {
+		return n, fmt.Errorf("image archive exceeds the maximum size of %d bytes", s.maxSize)
+	}
+	return n, err
+}
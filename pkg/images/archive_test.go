@@ -0,0 +1,89 @@
+package images
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	img, err := random.Image(64, 2)
+	require.NoError(t, err)
+	// a container image with two platforms
+	platforms, err := random.Index(64, 1, 2)
+	require.NoError(t, err)
+	index := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{Add: img}, mutate.IndexAddendum{Add: platforms})
+	digest, err := index.Digest()
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteArchive(buf, index, "ghcr.io/acorn-io/app:v1"))
+
+	loaded, imageName, err := ReadArchive(buf, t.TempDir(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, "ghcr.io/acorn-io/app:v1", imageName)
+
+	loadedDigest, err := loaded.Digest()
+	require.NoError(t, err)
+	assert.Equal(t, digest, loadedDigest)
+
+	// the loaded index has all images in it, so it can be written to a registry with the same digest
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	d, err := name.NewDigest(u.Host + "/acorn/app@" + digest.String())
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(d, loaded))
+
+	platformsDigest, err := platforms.Digest()
+	require.NoError(t, err)
+	_, err = remote.Index(d.Context().Digest(platformsDigest.String()))
+	require.NoError(t, err)
+}
+
+func TestReadArchiveInvalid(t *testing.T) {
+	_, _, err := ReadArchive(bytes.NewReader([]byte("not a tarball")), t.TempDir(), 0)
+	assert.Error(t, err)
+}
+
+func TestReadArchiveMaximumSize(t *testing.T) {
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	index := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{Add: img})
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteArchive(buf, index, ""))
+	archive := buf.Bytes()
+
+	_, _, err = ReadArchive(bytes.NewReader(archive), t.TempDir(), int64(len(archive)))
+	require.NoError(t, err)
+
+	_, _, err = ReadArchive(bytes.NewReader(archive), t.TempDir(), int64(len(archive)/2))
+	assert.ErrorContains(t, err, "image archive exceeds the maximum size")
+
+	// entries larger than the maximum are rejected before they are extracted
+	buf = &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "blobs/large", Typeflag: tar.TypeReg, Size: 4096, Mode: 0644}))
+	_, err = tw.Write(make([]byte, 4096))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	_, _, err = ReadArchive(buf, t.TempDir(), 1024)
+	assert.ErrorContains(t, err, "entry blobs/large of image archive exceeds the maximum size of 1024 bytes")
+}
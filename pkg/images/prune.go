@@ -0,0 +1,328 @@
+package images
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/imagesystem"
+	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const ImagesPrunedEventType = "ImagesPruned"
+
+// PruneOptions selects the images Prune prunes. Images that are not stored in the internal registry and images used
+// by an app are never pruned.
+type PruneOptions struct {
+	// Untagged, if set, selects the untagged images created longer ago than it
+	Untagged *time.Duration
+	// Tagged, if set, selects the tagged images created longer ago than it
+	Tagged *time.Duration
+	// DryRun only selects the images and computes the space pruning them would reclaim
+	DryRun bool
+	// Now is the time the age of the images is computed from, the current time if nil
+	Now func() time.Time
+}
+
+type PruneResult struct {
+	Images []v1.ImageInstance
+	// ReclaimedBytes is the size of the manifests, configs and layers that only the pruned images used. The registry
+	// frees the space when it garbage collects the blobs.
+	ReclaimedBytes int64
+}
+
+// Prune deletes the selected images of the namespace from the internal registry, along with their records. The
+// manifests that images that are kept still reference are not deleted.
+func Prune(ctx context.Context, c kclient.Client, namespace string, opts PruneOptions, remoteOpts ...remote.Option) (*PruneResult, error) {
+	now := time.Now()
+	if opts.Now != nil {
+		now = opts.Now()
+	}
+
+	imageList := &v1.ImageInstanceList{}
+	if err := c.List(ctx, imageList, kclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	inUse, err := imagesInUse(ctx, c, namespace, imageList)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept, pruned []v1.ImageInstance
+	for _, image := range imageList.Items {
+		if image.Remote {
+			// Remote images are only records of images stored in another registry
+			continue
+		}
+
+		maxAge := opts.Untagged
+		if len(image.Tags) > 0 {
+			maxAge = opts.Tagged
+		}
+		if inUse[image.Name] || maxAge == nil || now.Sub(image.CreationTimestamp.Time) < *maxAge {
+			kept = append(kept, image)
+		} else {
+			pruned = append(pruned, image)
+		}
+	}
+
+	result := &PruneResult{
+		Images: pruned,
+	}
+	if len(pruned) == 0 {
+		return result, nil
+	}
+
+	var internalRepo *name.Repository
+	imageDigest := func(image v1.ImageInstance) (name.Digest, error) {
+		if image.Repo != "" {
+			repo, err := name.NewRepository(image.Repo)
+			if err != nil {
+				return name.Digest{}, err
+			}
+			return repo.Digest(image.Digest), nil
+		}
+
+		if internalRepo == nil {
+			repo, _, err := imagesystem.GetInternalRepoForNamespace(ctx, c, namespace)
+			if err != nil {
+				return name.Digest{}, err
+			}
+			internalRepo = &repo
+		}
+		return internalRepo.Digest(image.Digest), nil
+	}
+
+	keptManifests := newManifestSet()
+	for _, image := range kept {
+		ref, err := imageDigest(image)
+		if err != nil {
+			return nil, err
+		}
+		if err := keptManifests.add(ref, remoteOpts...); err != nil {
+			return nil, err
+		}
+	}
+
+	prunedManifests := newManifestSet()
+	for _, image := range pruned {
+		ref, err := imageDigest(image)
+		if err != nil {
+			return nil, err
+		}
+		if err := prunedManifests.add(ref, remoteOpts...); err != nil {
+			return nil, err
+		}
+	}
+
+	for digest, size := range prunedManifests.blobs {
+		if _, ok := keptManifests.blobs[digest]; !ok {
+			result.ReclaimedBytes += size
+		}
+	}
+
+	if opts.DryRun {
+		return result, nil
+	}
+
+	for _, ref := range prunedManifests.manifests {
+		if _, ok := keptManifests.blobs[ref.DigestStr()]; ok {
+			continue
+		}
+		if err := remote.Delete(ref, remoteOpts...); err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("deleting %s: %w", ref, err)
+		}
+	}
+
+	for i := range pruned {
+		if err := c.Delete(ctx, &pruned[i]); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// imagesInUse returns the IDs of the images that the apps of the namespace run, including their nested images, and
+// the images the apps are set to run but have not pulled yet
+func imagesInUse(ctx context.Context, c kclient.Client, namespace string, imageList *v1.ImageInstanceList) (map[string]bool, error) {
+	appList := &v1.AppInstanceList{}
+	if err := c.List(ctx, appList, kclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	images := apiv1.ImageList{}
+	for _, image := range imageList.Items {
+		images.Items = append(images.Items, apiv1.Image{
+			ObjectMeta: image.ObjectMeta,
+			Remote:     image.Remote,
+			Repo:       image.Repo,
+			Digest:     image.Digest,
+			Tags:       image.Tags,
+		})
+	}
+
+	result := map[string]bool{}
+	for _, app := range appList.Items {
+		if app.Spec.Image != "" {
+			// The status only has the image once it is pulled, so an image an app was just created or updated with
+			// is resolved from the spec
+			if image, _, err := FindImageMatch(images, app.Spec.Image); err == nil {
+				result[image.Name] = true
+			} else if notFound := (ErrImageNotFound{}); !errors.As(err, &notFound) {
+				return nil, fmt.Errorf("resolving image %s of app %s: %w", app.Spec.Image, app.Name, err)
+			}
+			if digest, err := name.NewDigest(app.Spec.Image); err == nil {
+				result[strings.TrimPrefix(digest.DigestStr(), "sha256:")] = true
+			}
+		}
+
+		result[app.Status.AppImage.ID] = true
+		result[strings.TrimPrefix(app.Status.AppImage.Digest, "sha256:")] = true
+		for _, imageData := range typed.Concat(app.Status.AppImage.ImageData.Acorns, app.Status.AppImage.ImageData.Images) {
+			if tags.IsLocalReference(imageData.Image) {
+				result[strings.TrimPrefix(imageData.Image, "sha256:")] = true
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// manifestSet is the manifests of images, and the manifests, configs and layers they use with their sizes
+type manifestSet struct {
+	manifests []name.Digest
+	blobs     map[string]int64
+}
+
+func newManifestSet() *manifestSet {
+	return &manifestSet{
+		blobs: map[string]int64{},
+	}
+}
+
+// add adds the manifest and, if it is an index, the manifests in it. Manifests that no longer exist are skipped.
+func (m *manifestSet) add(ref name.Digest, opts ...remote.Option) error {
+	if _, ok := m.blobs[ref.DigestStr()]; ok {
+		return nil
+	}
+
+	desc, err := remote.Get(ref, opts...)
+	if isNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	m.manifests = append(m.manifests, ref)
+	m.blobs[ref.DigestStr()] = desc.Size
+
+	if desc.MediaType.IsIndex() {
+		index, err := desc.ImageIndex()
+		if err != nil {
+			return err
+		}
+		manifest, err := index.IndexManifest()
+		if err != nil {
+			return err
+		}
+		for _, child := range manifest.Manifests {
+			if err := m.add(ref.Context().Digest(child.Digest.String()), opts...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	img, err := desc.Image()
+	if err != nil {
+		return err
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return err
+	}
+	m.blobs[manifest.Config.Digest.String()] = manifest.Config.Size
+	for _, layer := range manifest.Layers {
+		m.blobs[layer.Digest.String()] = layer.Size
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
+
+// ImagesPrunedEventDetails captures additional info about pruned images.
+type ImagesPrunedEventDetails struct {
+	// Images are the digests of the pruned images.
+	Images []string `json:"images"`
+
+	// ReclaimedBytes is the size of the manifests and layers only the pruned images used.
+	ReclaimedBytes int64 `json:"reclaimedBytes"`
+}
+
+// RecordPruneEvent records an event reporting the pruned images and the space they reclaimed. The obj, if not nil, is
+// the resource the images were pruned for.
+func RecordPruneEvent(ctx context.Context, recorder event.Recorder, namespace string, obj kclient.Object, result *PruneResult) {
+	if len(result.Images) == 0 {
+		return
+	}
+
+	e := apiv1.Event{
+		Type:        ImagesPrunedEventType,
+		Severity:    v1.EventSeverityInfo,
+		Description: fmt.Sprintf("Pruned %d images, reclaiming %s", len(result.Images), FormatBytes(result.ReclaimedBytes)),
+		Observed:    v1.MicroTime(metav1.NowMicro()),
+	}
+	if obj != nil {
+		e.Resource = event.Resource(obj)
+	}
+	e.SetNamespace(namespace)
+
+	details := ImagesPrunedEventDetails{
+		ReclaimedBytes: result.ReclaimedBytes,
+	}
+	for _, image := range result.Images {
+		details.Images = append(details.Images, image.Digest)
+	}
+
+	var err error
+	if e.Details, err = v1.Mapify(details); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := recorder.Record(ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
+
+// FormatBytes formats the size in bytes with a binary unit, e.g. 1.5 MiB
+func FormatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,217 @@
+package images
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPrune(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	repo, err := name.NewRepository(u.Host + "/acorn/acorn")
+	require.NoError(t, err)
+
+	now := time.Now()
+	shared, err := random.Image(64, 1)
+	require.NoError(t, err)
+
+	// pushImage writes an Acorn image with the shared container image and one of its own to the registry
+	pushImage := func(created time.Time, tags ...string) (*v1.ImageInstance, ggcrv1.Image) {
+		own, err := random.Image(64, 1)
+		require.NoError(t, err)
+		index := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{Add: shared}, mutate.IndexAddendum{Add: own})
+		digest, err := index.Digest()
+		require.NoError(t, err)
+		require.NoError(t, remote.WriteIndex(repo.Digest(digest.String()), index))
+		return &v1.ImageInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              digest.Hex,
+				Namespace:         "acorn",
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Repo:   repo.String(),
+			Digest: digest.String(),
+			Tags:   tags,
+		}, own
+	}
+
+	used, _ := pushImage(now.Add(-48 * time.Hour))
+	old, oldOwn := pushImage(now.Add(-48 * time.Hour))
+	recent, _ := pushImage(now)
+	tagged, _ := pushImage(now.Add(-48*time.Hour), "acorn/app:v1")
+	remoteImage := &v1.ImageInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "1234567890123456789012345678901234567890123456789012345678901234",
+			Namespace:         "acorn",
+			CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+		},
+		Remote: true,
+		Repo:   "ghcr.io/acorn-io/app",
+		Digest: "sha256:1234567890123456789012345678901234567890123456789012345678901234",
+	}
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "acorn",
+		},
+		Status: v1.AppInstanceStatus{
+			AppImage: v1.AppImage{
+				ID:     used.Name,
+				Digest: used.Digest,
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(used, old, recent, tagged, remoteImage, app).Build()
+	opts := PruneOptions{
+		Untagged: z.Pointer(24 * time.Hour),
+		DryRun:   true,
+		Now: func() time.Time {
+			return now
+		},
+	}
+
+	// Only the manifests and layers that no other image uses are reclaimed
+	oldManifest, err := remote.Get(repo.Digest(old.Digest))
+	require.NoError(t, err)
+	ownManifest, err := oldOwn.Manifest()
+	require.NoError(t, err)
+	ownSize, err := oldOwn.Size()
+	require.NoError(t, err)
+	expectedSize := oldManifest.Size + ownSize + ownManifest.Config.Size
+	for _, layer := range ownManifest.Layers {
+		expectedSize += layer.Size
+	}
+
+	result, err := Prune(context.Background(), c, "acorn", opts)
+	require.NoError(t, err)
+	require.Len(t, result.Images, 1)
+	assert.Equal(t, old.Name, result.Images[0].Name)
+	assert.Equal(t, expectedSize, result.ReclaimedBytes)
+	require.NoError(t, c.Get(context.Background(), kclient.ObjectKeyFromObject(old), &v1.ImageInstance{}))
+
+	opts.DryRun = false
+	result, err = Prune(context.Background(), c, "acorn", opts)
+	require.NoError(t, err)
+	require.Len(t, result.Images, 1)
+	assert.Equal(t, expectedSize, result.ReclaimedBytes)
+
+	imageList := &v1.ImageInstanceList{}
+	require.NoError(t, c.List(context.Background(), imageList))
+	assert.Len(t, imageList.Items, 4)
+	for _, image := range imageList.Items {
+		assert.NotEqual(t, old.Name, image.Name)
+	}
+
+	_, err = remote.Head(repo.Digest(old.Digest))
+	assert.True(t, isNotFound(err), "expected the pruned image to be deleted, got %v", err)
+	ownDigest, err := oldOwn.Digest()
+	require.NoError(t, err)
+	_, err = remote.Head(repo.Digest(ownDigest.String()))
+	assert.True(t, isNotFound(err), "expected the image only the pruned image used to be deleted, got %v", err)
+	sharedDigest, err := shared.Digest()
+	require.NoError(t, err)
+	_, err = remote.Head(repo.Digest(sharedDigest.String()))
+	assert.NoError(t, err)
+	_, err = remote.Head(repo.Digest(used.Digest))
+	assert.NoError(t, err)
+
+	// With a retention for tagged images, the tagged image is pruned too
+	opts.Tagged = z.Pointer(24 * time.Hour)
+	result, err = Prune(context.Background(), c, "acorn", opts)
+	require.NoError(t, err)
+	require.Len(t, result.Images, 1)
+	assert.Equal(t, tagged.Name, result.Images[0].Name)
+}
+
+// TestPruneKeepsSpecImage tests that the images apps are set to run are kept before the apps have pulled them
+func TestPruneKeepsSpecImage(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	repo, err := name.NewRepository(u.Host + "/acorn/acorn")
+	require.NoError(t, err)
+
+	now := time.Now()
+	pushImage := func(tags ...string) *v1.ImageInstance {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		digest, err := img.Digest()
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(repo.Digest(digest.String()), img))
+		return &v1.ImageInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              digest.Hex,
+				Namespace:         "acorn",
+				CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+			},
+			Repo:   repo.String(),
+			Digest: digest.String(),
+			Tags:   tags,
+		}
+	}
+	app := func(name, image string) *v1.AppInstance {
+		return &v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "acorn",
+			},
+			Spec: v1.AppInstanceSpec{
+				Image: image,
+			},
+		}
+	}
+
+	byID := pushImage()
+	byTag := pushImage("acorn/app:v1")
+	byDigest := pushImage()
+	unused := pushImage()
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(byID, byTag, byDigest, unused,
+		app("by-id", byID.Name[:12]),
+		app("by-tag", "acorn/app:v1"),
+		app("by-digest", "ghcr.io/acorn-io/app@"+byDigest.Digest),
+	).Build()
+
+	result, err := Prune(context.Background(), c, "acorn", PruneOptions{
+		Untagged: z.Pointer(24 * time.Hour),
+		Tagged:   z.Pointer(24 * time.Hour),
+		DryRun:   true,
+		Now: func() time.Time {
+			return now
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Images, 1)
+	assert.Equal(t, unused.Name, result.Images[0].Name)
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", FormatBytes(512))
+	assert.Equal(t, "1.5 KiB", FormatBytes(1536))
+	assert.Equal(t, "2.0 GiB", FormatBytes(2<<30))
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/appdefinition"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	"github.com/acorn-io/runtime/pkg/build"
@@ -14,6 +15,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/credentials"
 	"github.com/acorn-io/runtime/pkg/deployargs"
+	"github.com/acorn-io/runtime/pkg/vcs"
 )
 
 type ImageSource struct {
@@ -22,6 +24,18 @@ type ImageSource struct {
 	Args      []string
 	Profiles  []string
 	Platforms []string
+	// Secrets and SSH are the build secrets and SSH agents provided to the build, see client.AcornImageBuildOptions
+	Secrets []string
+	SSH     []string
+	// CacheFrom and CacheTo are the registry repositories the build cache is imported from and exported to
+	CacheFrom []string
+	CacheTo   []string
+	// SBOM and Provenance attach SBOMs of the container images and the provenance of the Acorn image to the image
+	SBOM       bool
+	Provenance bool
+	// GitRef, if set, is the git ref of the repository of the directory that is built instead of the contents of
+	// the directory
+	GitRef string
 	// NoDefaultRegistry - if true, indicates that no container registry should be assumed for the Image.
 	// This is used if the ImageSource is for an app with auto-upgrade enabled.
 	NoDefaultRegistry bool
@@ -177,6 +191,16 @@ func (i ImageSource) GetImageAndDeployArgs(ctx context.Context, c client.Client)
 			return "", nil, err
 		}
 
+		var buildVCS *v1.VCS
+		if i.GitRef != "" {
+			var cleanup func()
+			i, buildVCS, cleanup, err = i.checkout()
+			if err != nil {
+				return "", nil, err
+			}
+			defer cleanup()
+		}
+
 		_, params, err := i.GetAppDefinition(ctx, c)
 		if err != nil {
 			return "", nil, err
@@ -193,6 +217,13 @@ func (i ImageSource) GetImageAndDeployArgs(ctx context.Context, c client.Client)
 			Args:        params,
 			Profiles:    i.Profiles,
 			Platforms:   platforms,
+			Secrets:     i.Secrets,
+			SSH:         i.SSH,
+			CacheFrom:   i.CacheFrom,
+			CacheTo:     i.CacheTo,
+			SBOM:        i.SBOM,
+			Provenance:  i.Provenance,
+			VCS:         buildVCS,
 		})
 		if err != nil {
 			return "", nil, err
@@ -204,6 +235,28 @@ func (i ImageSource) GetImageAndDeployArgs(ctx context.Context, c client.Client)
 	return i.Image, deployArgs, err
 }
 
+// checkout writes a clean checkout of the git ref to a temporary directory and returns the image source of the
+// checkout, with the VCS of the checkout and a function that deletes it
+func (i ImageSource) checkout() (ImageSource, *v1.VCS, func(), error) {
+	dir, err := os.MkdirTemp("", "acorn-git-ref-")
+	if err != nil {
+		return i, nil, nil, err
+	}
+	cleanup := func() {
+		_ = os.RemoveAll(dir)
+	}
+
+	cwd, file, checkoutVCS, err := vcs.Checkout(i.Image, i.File, i.GitRef, dir)
+	if err != nil {
+		cleanup()
+		return i, nil, nil, err
+	}
+
+	i.Image = cwd
+	i.File = file
+	return i, &checkoutVCS, cleanup, nil
+}
+
 func GetCreds(c client.Client) (client.CredentialLookup, error) {
 	cfg, err := config.ReadCLIConfig(false)
 	if err != nil {
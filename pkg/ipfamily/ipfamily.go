@@ -0,0 +1,95 @@
+package ipfamily
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Detect returns the IP families of the cluster, the primary family first. The families are detected from the pod
+// CIDRs of the nodes, or from the kubernetes Service if the nodes have none. Nil is returned for IPv4 single-stack
+// clusters and if the families can't be detected, so that Services keep the defaults of the cluster.
+func Detect(ctx context.Context, c kclient.Reader) ([]corev1.IPFamily, error) {
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); meta.IsNoMatchError(err) {
+		// Node type doesn't exist probably because we are running against manager
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var families []corev1.IPFamily
+	for _, node := range nodes.Items {
+		podCIDRs := node.Spec.PodCIDRs
+		if len(podCIDRs) == 0 && node.Spec.PodCIDR != "" {
+			podCIDRs = []string{node.Spec.PodCIDR}
+		}
+		if nodeFamilies := cidrFamilies(podCIDRs); len(nodeFamilies) > len(families) {
+			families = nodeFamilies
+		}
+	}
+
+	if len(families) == 0 {
+		kubernetes := &corev1.Service{}
+		if err := c.Get(ctx, router.Key("default", "kubernetes"), kubernetes); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		families = kubernetes.Spec.IPFamilies
+	}
+
+	if len(families) == 1 && families[0] == corev1.IPv4Protocol {
+		return nil, nil
+	}
+	return families, nil
+}
+
+func cidrFamilies(cidrs []string) (result []corev1.IPFamily) {
+	seen := map[corev1.IPFamily]bool{}
+	for _, cidr := range cidrs {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		family := Of(ip)
+		if !seen[family] {
+			seen[family] = true
+			result = append(result, family)
+		}
+	}
+	return
+}
+
+// Of returns the IP family of the address
+func Of(ip net.IP) corev1.IPFamily {
+	if ip.To4() == nil {
+		return corev1.IPv6Protocol
+	}
+	return corev1.IPv4Protocol
+}
+
+// Set sets the IP family policy and families of a ClusterIP, NodePort or LoadBalancer Service to the families of the
+// cluster. Services of dual-stack clusters prefer dual-stack, so that they can be reached with either family. Nothing
+// is set if families is empty.
+func Set(service *corev1.Service, families []corev1.IPFamily) {
+	if len(families) == 0 || service.Spec.Type == corev1.ServiceTypeExternalName {
+		return
+	}
+
+	policy := corev1.IPFamilyPolicySingleStack
+	if len(families) > 1 {
+		policy = corev1.IPFamilyPolicyPreferDualStack
+	}
+	service.Spec.IPFamilyPolicy = &policy
+	service.Spec.IPFamilies = families
+}
+
+// JoinHostPort combines host and port into an address, IPv6 hosts are enclosed in brackets
+func JoinHostPort(host string, port int32) string {
+	return net.JoinHostPort(host, strconv.Itoa(int(port)))
+}
@@ -0,0 +1,37 @@
+package ipfamily
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCIDRFamilies(t *testing.T) {
+	assert.Equal(t, []corev1.IPFamily{corev1.IPv4Protocol}, cidrFamilies([]string{"10.42.0.0/24"}))
+	assert.Equal(t, []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}, cidrFamilies([]string{"fd00:10:42::/64", "10.42.0.0/24"}))
+	assert.Nil(t, cidrFamilies([]string{"invalid"}))
+}
+
+func TestSet(t *testing.T) {
+	service := &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}}
+	Set(service, nil)
+	assert.Nil(t, service.Spec.IPFamilyPolicy)
+
+	Set(service, []corev1.IPFamily{corev1.IPv6Protocol})
+	assert.Equal(t, corev1.IPFamilyPolicySingleStack, *service.Spec.IPFamilyPolicy)
+
+	Set(service, []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol})
+	assert.Equal(t, corev1.IPFamilyPolicyPreferDualStack, *service.Spec.IPFamilyPolicy)
+	assert.Equal(t, []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}, service.Spec.IPFamilies)
+
+	externalName := &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName}}
+	Set(externalName, []corev1.IPFamily{corev1.IPv6Protocol})
+	assert.Nil(t, externalName.Spec.IPFamilyPolicy)
+}
+
+func TestJoinHostPort(t *testing.T) {
+	assert.Equal(t, "203.0.113.1:80", JoinHostPort("203.0.113.1", 80))
+	assert.Equal(t, "[2001:db8::1]:80", JoinHostPort("2001:db8::1", 80))
+	assert.Equal(t, "example.com:443", JoinHostPort("example.com", 443))
+}
@@ -29,8 +29,12 @@ const (
 	AcornSecretName                        = Prefix + "secret-name"
 	AcornSecretSourceName                  = Prefix + "secret-source-name"
 	AcornSecretGenerated                   = Prefix + "secret-generated"
+	AcornSecretShareName                   = Prefix + "secret-share-name"
+	AcornSecretShareProject                = Prefix + "secret-share-project"
 	AcornContainerName                     = Prefix + "container-name"
 	AcornRouterName                        = Prefix + "router-name"
+	AcornAuthProxyName                     = Prefix + "auth-proxy-name"
+	AcornACMESolverName                    = Prefix + "acme-solver-name"
 	AcornJobName                           = Prefix + "job-name"
 	AcornAppImage                          = Prefix + "app-image"
 	AcornAppDevHash                        = Prefix + "app-dev-hash"
@@ -44,8 +48,10 @@ const (
 	AcornSecretRevPrefix                   = "secret-rev." + Prefix
 	AcornPublishURL                        = Prefix + "publish-url"
 	AcornTargets                           = Prefix + "targets"
+	AcornGatewayCerts                      = Prefix + "gateway-certs"
 	AcornDNSHash                           = Prefix + "dns-hash"
 	AcornLinkName                          = Prefix + "link-name"
+	AcornLinkProject                       = Prefix + "link-project"
 	AcornDNSState                          = Prefix + "applied-dns-state"
 	AcornDomain                            = Prefix + "domain"
 	AcornCertNotValidBefore                = Prefix + "cert-not-valid-before"
@@ -58,7 +64,11 @@ const (
 	AcornCalculatedProjectDefaultRegion    = Prefix + "calculated-project-default-region"
 	AcornCalculatedProjectSupportedRegions = Prefix + "calculated-project-supported-regions"
 	ProjectEnforcedQuotaAnnotation         = Prefix + "enforced-quota"
+	ProjectACMETLSAnnotation               = Prefix + "acme-tls"
+	AcornACMEHTTP01                        = Prefix + "acme-http01"
+	AcornACMEStatus                        = Prefix + "acme-status"
 	AcornPermissions                       = Prefix + "permissions"
+	AcornAuditEvent                        = Prefix + "audit-event"
 
 	IdentityPrefix                = "identity." + Prefix
 	AcornIdentityAccountServerURL = IdentityPrefix + "account-server-url"
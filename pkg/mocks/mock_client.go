@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -13,6 +14,7 @@ import (
 	client "github.com/acorn-io/runtime/pkg/client"
 	term "github.com/acorn-io/runtime/pkg/client/term"
 	gomock "github.com/golang/mock/gomock"
+	signature "github.com/sigstore/sigstore/pkg/signature"
 	client0 "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -99,6 +101,21 @@ func (mr *MockClientMockRecorder) AcornImageBuildList(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcornImageBuildList", reflect.TypeOf((*MockClient)(nil).AcornImageBuildList), arg0)
 }
 
+// AcornImageBuildLogs mocks base method.
+func (m *MockClient) AcornImageBuildLogs(arg0 context.Context, arg1 string) (*v1.AcornImageBuildLogs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcornImageBuildLogs", arg0, arg1)
+	ret0, _ := ret[0].(*v1.AcornImageBuildLogs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcornImageBuildLogs indicates an expected call of AcornImageBuildLogs.
+func (mr *MockClientMockRecorder) AcornImageBuildLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcornImageBuildLogs", reflect.TypeOf((*MockClient)(nil).AcornImageBuildLogs), arg0, arg1)
+}
+
 // AppConfirmUpgrade mocks base method.
 func (m *MockClient) AppConfirmUpgrade(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -601,6 +618,36 @@ func (mr *MockClientMockRecorder) ImageList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageList", reflect.TypeOf((*MockClient)(nil).ImageList), arg0)
 }
 
+// ImageLoad mocks base method.
+func (m *MockClient) ImageLoad(arg0 context.Context, arg1 io.Reader, arg2 *client.ImageLoadOptions) (<-chan client.ImageProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageLoad", arg0, arg1, arg2)
+	ret0, _ := ret[0].(<-chan client.ImageProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageLoad indicates an expected call of ImageLoad.
+func (mr *MockClientMockRecorder) ImageLoad(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageLoad", reflect.TypeOf((*MockClient)(nil).ImageLoad), arg0, arg1, arg2)
+}
+
+// ImagePrune mocks base method.
+func (m *MockClient) ImagePrune(arg0 context.Context, arg1 *client.ImagePruneOptions) (*v1.ImagePrune, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePrune", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ImagePrune)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImagePrune indicates an expected call of ImagePrune.
+func (mr *MockClientMockRecorder) ImagePrune(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePrune", reflect.TypeOf((*MockClient)(nil).ImagePrune), arg0, arg1)
+}
+
 // ImagePull mocks base method.
 func (m *MockClient) ImagePull(arg0 context.Context, arg1 string, arg2 *client.ImagePullOptions) (<-chan client.ImageProgress, error) {
 	m.ctrl.T.Helper()
@@ -631,6 +678,35 @@ func (mr *MockClientMockRecorder) ImagePush(arg0, arg1, arg2 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePush", reflect.TypeOf((*MockClient)(nil).ImagePush), arg0, arg1, arg2)
 }
 
+// ImageSave mocks base method.
+func (m *MockClient) ImageSave(arg0 context.Context, arg1 string, arg2 io.Writer, arg3 *client.ImageSaveOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageSave", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImageSave indicates an expected call of ImageSave.
+func (mr *MockClientMockRecorder) ImageSave(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageSave", reflect.TypeOf((*MockClient)(nil).ImageSave), arg0, arg1, arg2, arg3)
+}
+
+// ImageSign mocks base method.
+func (m *MockClient) ImageSign(arg0 context.Context, arg1 string, arg2 signature.Signer, arg3 *client.ImageSignOptions) (*v1.ImageSignature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageSign", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1.ImageSignature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageSign indicates an expected call of ImageSign.
+func (mr *MockClientMockRecorder) ImageSign(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageSign", reflect.TypeOf((*MockClient)(nil).ImageSign), arg0, arg1, arg2, arg3)
+}
+
 // ImageTag mocks base method.
 func (m *MockClient) ImageTag(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -645,6 +721,21 @@ func (mr *MockClientMockRecorder) ImageTag(arg0, arg1, arg2 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageTag", reflect.TypeOf((*MockClient)(nil).ImageTag), arg0, arg1, arg2)
 }
 
+// ImageVerify mocks base method.
+func (m *MockClient) ImageVerify(arg0 context.Context, arg1 string, arg2 *client.ImageVerifyOptions) (*v1.ImageVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageVerify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.ImageVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageVerify indicates an expected call of ImageVerify.
+func (mr *MockClientMockRecorder) ImageVerify(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageVerify", reflect.TypeOf((*MockClient)(nil).ImageVerify), arg0, arg1, arg2)
+}
+
 // Info mocks base method.
 func (m *MockClient) Info(arg0 context.Context) ([]v1.Info, error) {
 	m.ctrl.T.Helper()
@@ -720,6 +811,21 @@ func (mr *MockClientMockRecorder) ProjectGet(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectGet", reflect.TypeOf((*MockClient)(nil).ProjectGet), arg0, arg1)
 }
 
+// ProjectKeys mocks base method.
+func (m *MockClient) ProjectKeys(arg0 context.Context, arg1 string) (*v1.ProjectKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectKeys", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ProjectKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectKeys indicates an expected call of ProjectKeys.
+func (mr *MockClientMockRecorder) ProjectKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectKeys", reflect.TypeOf((*MockClient)(nil).ProjectKeys), arg0, arg1)
+}
+
 // ProjectList mocks base method.
 func (m *MockClient) ProjectList(arg0 context.Context) ([]v1.Project, error) {
 	m.ctrl.T.Helper()
@@ -735,6 +841,36 @@ func (mr *MockClientMockRecorder) ProjectList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectList", reflect.TypeOf((*MockClient)(nil).ProjectList), arg0)
 }
 
+// ProjectRetireKey mocks base method.
+func (m *MockClient) ProjectRetireKey(arg0 context.Context, arg1, arg2 string) (*v1.ProjectKeyRetirement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectRetireKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.ProjectKeyRetirement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectRetireKey indicates an expected call of ProjectRetireKey.
+func (mr *MockClientMockRecorder) ProjectRetireKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectRetireKey", reflect.TypeOf((*MockClient)(nil).ProjectRetireKey), arg0, arg1, arg2)
+}
+
+// ProjectRotateKey mocks base method.
+func (m *MockClient) ProjectRotateKey(arg0 context.Context, arg1 string) (*v1.ProjectKeyRotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectRotateKey", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ProjectKeyRotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectRotateKey indicates an expected call of ProjectRotateKey.
+func (mr *MockClientMockRecorder) ProjectRotateKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectRotateKey", reflect.TypeOf((*MockClient)(nil).ProjectRotateKey), arg0, arg1)
+}
+
 // ProjectUpdate mocks base method.
 func (m *MockClient) ProjectUpdate(arg0 context.Context, arg1 *v1.Project, arg2 string, arg3 []string) (*v1.Project, error) {
 	m.ctrl.T.Helper()
@@ -825,6 +961,21 @@ func (mr *MockClientMockRecorder) SecretGet(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretGet", reflect.TypeOf((*MockClient)(nil).SecretGet), arg0, arg1)
 }
 
+// SecretHistory mocks base method.
+func (m *MockClient) SecretHistory(arg0 context.Context, arg1 string) (*v1.SecretHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretHistory", arg0, arg1)
+	ret0, _ := ret[0].(*v1.SecretHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretHistory indicates an expected call of SecretHistory.
+func (mr *MockClientMockRecorder) SecretHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretHistory", reflect.TypeOf((*MockClient)(nil).SecretHistory), arg0, arg1)
+}
+
 // SecretList mocks base method.
 func (m *MockClient) SecretList(arg0 context.Context) ([]v1.Secret, error) {
 	m.ctrl.T.Helper()
@@ -855,6 +1006,21 @@ func (mr *MockClientMockRecorder) SecretReveal(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretReveal", reflect.TypeOf((*MockClient)(nil).SecretReveal), arg0, arg1)
 }
 
+// SecretRollback mocks base method.
+func (m *MockClient) SecretRollback(arg0 context.Context, arg1 string, arg2 int) (*v1.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretRollback", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretRollback indicates an expected call of SecretRollback.
+func (mr *MockClientMockRecorder) SecretRollback(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretRollback", reflect.TypeOf((*MockClient)(nil).SecretRollback), arg0, arg1, arg2)
+}
+
 // SecretUpdate mocks base method.
 func (m *MockClient) SecretUpdate(arg0 context.Context, arg1 string, arg2 map[string][]byte) (*v1.Secret, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +114,8 @@ func PortPublishForService(serviceName string, bindings []v1.PortBinding) (resul
 				Protocol:   binding.Protocol,
 				Hostname:   binding.Hostname,
 				TargetPort: binding.TargetPort,
+				Auth:       binding.Auth,
+				HTTP:       binding.HTTP,
 			})
 		}
 	}
@@ -132,6 +134,17 @@ func ApplyBindings(mode v1.PublishMode, bindings []v1.PortPublish, ports []v1.Po
 			published bool
 		)
 
+		// Auth and HTTP options set by a binding apply to all the endpoints of the port, including the cluster domain
+		// endpoint
+		for _, binding := range bindings {
+			if binding.Auth != nil && matches(binding, port) {
+				port.Auth = binding.Auth
+			}
+			if binding.HTTP != nil && matches(binding, port) {
+				port.HTTP = binding.HTTP
+			}
+		}
+
 		for _, binding := range bindings {
 			if matches(binding, port) {
 				published = true
@@ -22,6 +22,18 @@ var (
 	// HttpEndpointPatternDefault is a pattern that works with Let's Encrypt
 	HttpEndpointPatternDefault = "{{hashConcat 8 .Container .App .Namespace | truncate}}.{{.ClusterDomain}}"
 
+	// ServiceLBModeDefault creates a LoadBalancer service for the published TCP and UDP ports of each app
+	ServiceLBModeDefault = "dedicated"
+
+	// SharedLBPortRangeDefault is the default Kubernetes NodePort range
+	SharedLBPortRangeDefault = "30000-32767"
+
+	// SecretHistoryLimitDefault is the default number of previous versions kept for each secret
+	SecretHistoryLimitDefault = 10
+
+	// ImageLoadMaximumSizeDefault is the default maximum size of image archives loaded into the cluster
+	ImageLoadMaximumSizeDefault int64 = 10 << 30
+
 	// Features
 	FeatureImageAllowRules = "image-allow-rules"
 	FeatureDefaults        = map[string]bool{
@@ -31,14 +43,18 @@ var (
 
 func defaultProfile() apiv1.Config {
 	return apiv1.Config{
+		ACMEDirectoryURL:               new(string),
 		AcornDNS:                       z.Pointer(AcornDNSStateDefault),
 		AcornDNSEndpoint:               z.Pointer(AcornDNSEndpointDefault),
+		AuditEventTTL:                  new(string),
 		AutoUpgradeInterval:            z.Pointer(AutoUpgradeIntervalDefault),
 		AWSIdentityProviderARN:         new(string),
 		BuilderPerProject:              new(bool),
 		CertManagerIssuer:              new(string),
+		DenyEgressByDefault:            new(bool),
 		EventTTL:                       new(string),
 		Features:                       FeatureDefaults,
+		Gateway:                        new(string),
 		HttpEndpointPattern:            z.Pointer(HttpEndpointPatternDefault),
 		IgnoreUserLabelsAndAnnotations: new(bool),
 		IngressClassName:               new(string),
@@ -54,10 +70,15 @@ func defaultProfile() apiv1.Config {
 		Profile:                        new(string),
 		PublishBuilders:                new(bool),
 		RecordBuilds:                   new(bool),
+		SecretHistoryLimit:             z.Pointer(SecretHistoryLimitDefault),
+		ServiceLBMode:                  z.Pointer(ServiceLBModeDefault),
+		SharedLBAddress:                new(string),
+		SharedLBPortRange:              z.Pointer(SharedLBPortRangeDefault),
 		SetPodSecurityEnforceProfile:   z.Pointer(true),
 		UseCustomCABundle:              new(bool),
 		WorkloadMemoryDefault:          new(int64),
 		WorkloadMemoryMaximum:          new(int64),
+		ImageLoadMaximumSize:           z.Pointer(ImageLoadMaximumSizeDefault),
 		RegistryMemory:                 new(string),
 		RegistryCPU:                    new(string),
 		BuildkitdMemory:                new(string),
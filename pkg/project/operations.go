@@ -267,3 +267,31 @@ func getProjectDetails(ctx context.Context, wg *sync.WaitGroup, result chan<- De
 	}
 	return nil
 }
+
+func Keys(ctx context.Context, opts Options, name string) (*apiv1.ProjectKeys, error) {
+	opts.Project = name
+	c, err := Client(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.ProjectKeys(ctx, lastPart(name))
+}
+
+func RotateKey(ctx context.Context, opts Options, name string) (*apiv1.ProjectKeyRotation, error) {
+	opts.Project = name
+	c, err := Client(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.ProjectRotateKey(ctx, lastPart(name))
+}
+
+func RetireKey(ctx context.Context, opts Options, name, keyID string) error {
+	opts.Project = name
+	c, err := Client(ctx, opts)
+	if err != nil {
+		return err
+	}
+	_, err = c.ProjectRetireKey(ctx, lastPart(name), keyID)
+	return err
+}
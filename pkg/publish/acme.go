@@ -0,0 +1,262 @@
+package publish
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/acme"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tolerations"
+	"github.com/acorn-io/z"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const ACMESolverPort = 8080
+
+// ACMESolverName is the name of the Service answering the HTTP-01 challenges for the custom domains of the service
+func ACMESolverName(serviceName string) string {
+	return name.SafeConcatName(serviceName, "acme-solver")
+}
+
+// ACMESecretName is the name of the TLS secret the certificate for the custom domain is issued into by the solver
+func ACMESecretName(solverName, hostname string) string {
+	return name.SafeConcatName(solverName, strings.ReplaceAll(hostname, ".", "-"))
+}
+
+// ACMEEnabled returns true if an ACME server is configured, either Let's Encrypt or a custom ACME directory
+func ACMEEnabled(cfg *apiv1.Config) bool {
+	return *cfg.ACMEDirectoryURL != "" || strings.EqualFold(*cfg.LetsEncrypt, "enabled") || strings.EqualFold(*cfg.LetsEncrypt, "staging")
+}
+
+// ACMEHosts returns the hosts that the solver Ingress routes the HTTP-01 challenges of to the solver, mapped to the
+// name of the TLS secret the certificate of the host is issued into.
+func ACMEHosts(ingress *networkingv1.Ingress) map[string]string {
+	solverName := ingress.Annotations[labels.AcornACMEHTTP01]
+	if solverName == "" {
+		return nil
+	}
+
+	result := map[string]string{}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Path == acme.ChallengePath && path.Backend.Service != nil && path.Backend.Service.Name == solverName {
+				result[rule.Host] = ACMESecretName(solverName, rule.Host)
+			}
+		}
+	}
+	return result
+}
+
+// ACMEStatus returns the errors of the last certificate requests for the domains of the Ingress that failed
+func ACMEStatus(ingress *networkingv1.Ingress) (map[string]string, error) {
+	status := map[string]string{}
+	if statusJSON := ingress.Annotations[labels.AcornACMEStatus]; statusJSON != "" {
+		if err := json.Unmarshal([]byte(statusJSON), &status); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// acmeHTTP01 requests certificates from the ACME server for the custom domains that have no certificate yet, if the
+// project of the service has ACME TLS enabled. The HTTP-01 challenges are routed to the solver by a separate Ingress,
+// so they reach the cluster the same way the traffic of the app does, but aren't affected by the annotations of the
+// Ingress of the app. The certificates themselves are requested by the tls controller once the solver is running.
+func acmeHTTP01(req router.Request, cfg *apiv1.Config, svc *v1.ServiceInstance, ingressClassName *string, rules []networkingv1.IngressRule, ingressTLS []networkingv1.IngressTLS) ([]networkingv1.IngressTLS, []kclient.Object, error) {
+	if !ACMEEnabled(cfg) {
+		return nil, nil, nil
+	}
+
+	project := &corev1.Namespace{}
+	if err := req.Get(project, "", svc.Spec.AppNamespace); apierrors.IsNotFound(err) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	} else if project.Annotations[labels.ProjectACMETLSAnnotation] != "true" {
+		return nil, nil, nil
+	}
+
+	hostsWithTLS := map[string]bool{}
+	for _, tls := range ingressTLS {
+		for _, host := range tls.Hosts {
+			hostsWithTLS[host] = true
+		}
+	}
+
+	var (
+		solverName  = ACMESolverName(svc.Name)
+		result      []networkingv1.IngressTLS
+		solverRules []networkingv1.IngressRule
+	)
+	for _, rule := range rules {
+		if hostsWithTLS[rule.Host] || strings.HasPrefix(rule.Host, "*.") {
+			continue
+		}
+		hostsWithTLS[rule.Host] = true
+
+		result = append(result, networkingv1.IngressTLS{
+			Hosts:      []string{rule.Host},
+			SecretName: ACMESecretName(solverName, rule.Host),
+		})
+		solverRules = append(solverRules, networkingv1.IngressRule{
+			Host: rule.Host,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{
+						{
+							Path:     acme.ChallengePath,
+							PathType: z.Pointer(networkingv1.PathTypePrefix),
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: solverName,
+									Port: networkingv1.ServiceBackendPort{
+										Number: ACMESolverPort,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	if len(result) == 0 {
+		return nil, nil, nil
+	}
+
+	objs := []kclient.Object{
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      solverName,
+				Namespace: svc.Namespace,
+				Labels:    svc.Spec.Labels,
+				Annotations: map[string]string{
+					labels.AcornACMEHTTP01: solverName,
+				},
+			},
+			Spec: networkingv1.IngressSpec{
+				IngressClassName: ingressClassName,
+				Rules:            solverRules,
+			},
+		},
+	}
+
+	// The solver answers the challenges of the ACME account, which the tls controller creates for the solver Ingress
+	accountSecret := &corev1.Secret{}
+	if err := req.Get(accountSecret, system.Namespace, system.LEAccountSecretName); apierrors.IsNotFound(err) {
+		return result, objs, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	thumbprint, err := acme.Thumbprint(accountSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, append(objs, acmeSolver(svc, thumbprint)...), nil
+}
+
+// acmeSolver returns the objects of the solver answering the HTTP-01 challenges of the ACME account with the
+// thumbprint. The solver runs the acorn image and is stateless, since the answer to every challenge can be computed
+// from the token of the challenge and the thumbprint.
+func acmeSolver(svc *v1.ServiceInstance, thumbprint string) []kclient.Object {
+	solverName := ACMESolverName(svc.Name)
+	solverLabels := labels.Merge(labels.ManagedByApp(svc.Spec.AppNamespace, svc.Spec.AppName), map[string]string{
+		labels.AcornACMESolverName: solverName,
+	})
+	selector := map[string]string{
+		labels.AcornACMESolverName: solverName,
+	}
+
+	return []kclient.Object{
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      solverName,
+				Namespace: svc.Namespace,
+				Labels:    solverLabels,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: selector,
+				},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: solverLabels,
+					},
+					Spec: corev1.PodSpec{
+						TerminationGracePeriodSeconds: z.Pointer[int64](5),
+						EnableServiceLinks:            new(bool),
+						AutomountServiceAccountToken:  new(bool),
+						Containers: []corev1.Container{
+							{
+								Name:  "acme-solver",
+								Image: system.DefaultImage(),
+								Args: []string{
+									"acme-solver",
+									"--thumbprint", thumbprint,
+									"--listen-port", strconv.Itoa(ACMESolverPort),
+								},
+								Ports: []corev1.ContainerPort{
+									{
+										Name:          "http",
+										ContainerPort: ACMESolverPort,
+										Protocol:      corev1.ProtocolTCP,
+									},
+								},
+								ReadinessProbe: &corev1.Probe{
+									ProbeHandler: corev1.ProbeHandler{
+										TCPSocket: &corev1.TCPSocketAction{
+											Port: intstr.FromInt(ACMESolverPort),
+										},
+									},
+								},
+							},
+						},
+						Tolerations: []corev1.Toleration{
+							{
+								Key:      tolerations.WorkloadTolerationKey,
+								Operator: corev1.TolerationOpExists,
+							},
+						},
+					},
+				},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      solverName,
+				Namespace: svc.Namespace,
+				Labels:    solverLabels,
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{
+						Name:        "http",
+						Port:        ACMESolverPort,
+						TargetPort:  intstr.FromInt(ACMESolverPort),
+						Protocol:    corev1.ProtocolTCP,
+						AppProtocol: z.Pointer("HTTP"),
+					},
+				},
+				Selector: selector,
+				Type:     corev1.ServiceTypeClusterIP,
+			},
+		},
+	}
+}
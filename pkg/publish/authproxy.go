@@ -0,0 +1,185 @@
+package publish
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/authproxy"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tolerations"
+	"github.com/acorn-io/z"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	authProxyPort      = 8080
+	authProxySecretDir = "/run/secrets/acorn-auth"
+)
+
+func authProxyName(svc *v1.ServiceInstance, port int32) string {
+	return name.SafeConcatName(svc.Name, "auth", strconv.Itoa(int(port)))
+}
+
+// authProxy returns the objects of the proxy that authenticates requests to the port of the service. The proxy runs
+// the acorn image, so it works with any ingress controller or Gateway. The auth secret is looked up in the app
+// namespace first, so secrets of the app can be used, and then in the project.
+func authProxy(req router.Request, svc *v1.ServiceInstance, port int32, auth *v1.PortAuth) ([]kclient.Object, error) {
+	source := &corev1.Secret{}
+	sourceNamespace := svc.Namespace
+	if err := req.Get(source, sourceNamespace, auth.Secret); apierrors.IsNotFound(err) {
+		sourceNamespace = svc.Spec.AppNamespace
+		if err := req.Get(source, sourceNamespace, auth.Secret); apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("auth secret %s for port %d not found", auth.Secret, port)
+		} else if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	data, err := nacl.DecryptNamespacedDataMap(req.Ctx, req.Client, source.Data, sourceNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := authproxy.ValidateSecret(auth.Type, data); err != nil {
+		return nil, fmt.Errorf("auth secret %s for port %d: %w", auth.Secret, port, err)
+	}
+
+	proxyName := authProxyName(svc, port)
+	proxyLabels := labels.Merge(labels.ManagedByApp(svc.Spec.AppNamespace, svc.Spec.AppName), map[string]string{
+		labels.AcornAuthProxyName: proxyName,
+	})
+	selector := map[string]string{
+		labels.AcornAuthProxyName: proxyName,
+	}
+
+	// Restart the proxy when the credentials change
+	hash := sha256.New()
+	for _, entry := range typed.Sorted(data) {
+		hash.Write([]byte(entry.Key))
+		hash.Write(entry.Value)
+	}
+	podAnnotations := map[string]string{
+		labels.AcornSecretRevPrefix + proxyName: hex.EncodeToString(hash.Sum(nil))[:12],
+	}
+
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      proxyName,
+			Namespace: svc.Namespace,
+			Labels:    proxyLabels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: selector,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      proxyLabels,
+					Annotations: podAnnotations,
+				},
+				Spec: corev1.PodSpec{
+					TerminationGracePeriodSeconds: z.Pointer[int64](5),
+					EnableServiceLinks:            new(bool),
+					AutomountServiceAccountToken:  new(bool),
+					Containers: []corev1.Container{
+						{
+							Name:  "auth-proxy",
+							Image: system.DefaultImage(),
+							Args: []string{
+								"auth-proxy",
+								"--type", auth.Type,
+								"--upstream", fmt.Sprintf("http://%s:%d", svc.Name, port),
+								"--secret-dir", authProxySecretDir,
+								"--listen-port", strconv.Itoa(authProxyPort),
+								"--name", svc.Namespace + "/" + proxyName,
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "auth",
+									ReadOnly:  true,
+									MountPath: authProxySecretDir,
+								},
+							},
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "http",
+									ContainerPort: authProxyPort,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									TCPSocket: &corev1.TCPSocketAction{
+										Port: intstr.FromInt(authProxyPort),
+									},
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "auth",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: proxyName,
+								},
+							},
+						},
+					},
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      tolerations.WorkloadTolerationKey,
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return []kclient.Object{
+		dep,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      proxyName,
+				Namespace: svc.Namespace,
+				Labels:    proxyLabels,
+			},
+			Data: data,
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      proxyName,
+				Namespace: svc.Namespace,
+				Labels:    proxyLabels,
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{
+						Name:        "http",
+						Port:        authProxyPort,
+						TargetPort:  intstr.FromInt(authProxyPort),
+						Protocol:    corev1.ProtocolTCP,
+						AppProtocol: z.Pointer("HTTP"),
+					},
+				},
+				Selector: selector,
+				Type:     corev1.ServiceTypeClusterIP,
+			},
+		},
+	}, nil
+}
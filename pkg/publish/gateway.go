@@ -0,0 +1,555 @@
+package publish
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/gatewayapi"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/z"
+	"golang.org/x/exp/maps"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// gatewayListenerPrefix is the prefix of the names of the listeners Acorn adds to the Gateway
+const gatewayListenerPrefix = "acorn-tls"
+
+// GatewayRef returns the namespace and name of the Gateway endpoints are published through. An empty name is
+// returned if no Gateway is configured.
+func GatewayRef(cfg *apiv1.Config) (namespace, gatewayName string, _ error) {
+	if cfg.Gateway == nil || *cfg.Gateway == "" {
+		return "", "", nil
+	}
+	namespace, gatewayName, ok := strings.Cut(*cfg.Gateway, "/")
+	if !ok || namespace == "" || gatewayName == "" {
+		return "", "", fmt.Errorf("invalid gateway %q, must be in the form of namespace/name", *cfg.Gateway)
+	}
+	return namespace, gatewayName, nil
+}
+
+// GatewayServesHTTPS returns true if the Gateway has an HTTPS listener for the hostname. TLS is terminated by the
+// listeners of the Gateway, either configured by the admin or added by GatewayListeners for the certificates of apps.
+func GatewayServesHTTPS(gateway *gatewayapi.Gateway, hostname string) bool {
+	hostname, _, _ = strings.Cut(hostname, ":")
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Protocol != gatewayapi.ProtocolHTTPS {
+			continue
+		}
+		if listener.Hostname == nil || *listener.Hostname == "" || *listener.Hostname == hostname {
+			return true
+		}
+		if strings.HasPrefix(*listener.Hostname, "*.") && strings.HasSuffix(hostname, (*listener.Hostname)[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// GatewayRoutes publishes the ports of the service through the configured Gateway. HTTP ports get an HTTPRoute per
+// port with the same hostnames an Ingress would get, TCP and UDP ports get a TCPRoute or UDPRoute attached to the
+// listener of the Gateway with the published port, so no LoadBalancer service is needed per service. TCP ports
+// published on a TLS listener of the Gateway get a TLSRoute instead, so the Gateway can pass the TLS connections
+// through to the service based on the SNI hostname.
+func GatewayRoutes(req router.Request, svc *v1.ServiceInstance) (result []kclient.Object, _ error) {
+	if svc.Spec.PublishMode == v1.PublishModeNone {
+		return nil, nil
+	}
+
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayNamespace, gatewayName, err := GatewayRef(cfg)
+	if err != nil || gatewayName == "" {
+		return nil, err
+	}
+
+	gateway := &gatewayapi.Gateway{}
+	if err := req.Get(gateway, gatewayNamespace, gatewayName); apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("gateway %s/%s not found", gatewayNamespace, gatewayName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	parentRef := gatewayapi.ParentReference{
+		Group:     z.Pointer(gatewayapi.Group),
+		Kind:      z.Pointer("Gateway"),
+		Namespace: &gatewayNamespace,
+		Name:      gatewayName,
+	}
+
+	httpRoutes, err := httpRoutes(req, cfg, gateway, parentRef, svc)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, httpRoutes...)
+
+	portRoutes, err := portRoutes(cfg, gateway, parentRef, svc)
+	if err != nil {
+		return nil, err
+	}
+	return append(result, portRoutes...), nil
+}
+
+func httpRoutes(req router.Request, cfg *apiv1.Config, gateway *gatewayapi.Gateway, parentRef gatewayapi.ParentReference, svc *v1.ServiceInstance) (result []kclient.Object, _ error) {
+	bindings := ports.ApplyBindings(svc.Spec.PublishMode, svc.Spec.Publish, ports.ByProtocol(svc.Spec.Ports, v1.ProtocolHTTP))
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+
+	hosts, err := httpHosts(cfg, svc, bindings)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = authProxies(req, svc, hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	certSecrets, certs, err := gatewayCerts(req, gateway.Namespace, svc, hosts)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, certSecrets...)
+
+	var (
+		hostnames = map[int32][]string{}
+		targets   = map[int32]map[string]Target{}
+		portCerts = map[int32]map[string]string{}
+		auth      = map[int32]bool{}
+	)
+	for _, host := range hosts {
+		if host.HTTP != nil {
+			return nil, fmt.Errorf("http options of port [%d] are not supported when publishing through a gateway", host.Port)
+		}
+		auth[host.Port] = host.Auth != nil
+		if targets[host.Port] == nil {
+			targets[host.Port] = map[string]Target{}
+		}
+		targets[host.Port][host.Hostname] = host.Target
+
+		hostname, _, _ := strings.Cut(host.Hostname, ":")
+		hostnames[host.Port] = append(hostnames[host.Port], hostname)
+
+		proto := v1.PublishProtocolHTTP
+		if secretName, ok := certs[hostname]; ok {
+			if portCerts[host.Port] == nil {
+				portCerts[host.Port] = map[string]string{}
+			}
+			portCerts[host.Port][hostname] = secretName
+			proto = v1.PublishProtocolHTTPS
+		} else if GatewayServesHTTPS(gateway, hostname) {
+			proto = v1.PublishProtocolHTTPS
+		}
+		svc.Status.Endpoints = append(svc.Status.Endpoints, v1.Endpoint{
+			Address:         host.Hostname,
+			PublishProtocol: proto,
+		})
+	}
+
+	for _, entry := range typed.Sorted(targets) {
+		port, target := entry.Key, entry.Value
+		targetJSON, err := json.Marshal(target)
+		if err != nil {
+			return nil, err
+		}
+
+		annotations := map[string]string{
+			labels.AcornTargets: string(targetJSON),
+		}
+		if len(portCerts[port]) > 0 {
+			certsJSON, err := json.Marshal(portCerts[port])
+			if err != nil {
+				return nil, err
+			}
+			annotations[labels.AcornGatewayCerts] = string(certsJSON)
+		}
+
+		rules := httpRouteRules(svc, port)
+		if auth[port] {
+			rules = []gatewayapi.HTTPRouteRule{prefixRule(authProxyName(svc, port), authProxyPort)}
+		}
+
+		result = append(result, &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name.SafeConcatName(svc.Name, strconv.Itoa(int(port))),
+				Namespace:   svc.Namespace,
+				Labels:      svc.Spec.Labels,
+				Annotations: labels.Merge(svc.Spec.Annotations, annotations),
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{parentRef},
+				},
+				Hostnames: hostnames[port],
+				Rules:     rules,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// gatewayCerts copies the TLS secrets matching the hostnames into the namespace of the service, picking the
+// certificates the same way an Ingress does, and returns the name of the copied secret for each hostname. A
+// ReferenceGrant allows the Gateway to use the copied secrets in the listeners returned by GatewayListeners.
+func gatewayCerts(req router.Request, gatewayNamespace string, svc *v1.ServiceInstance, hosts []httpHost) (result []kclient.Object, certs map[string]string, _ error) {
+	var rules []networkingv1.IngressRule
+	for _, host := range hosts {
+		hostname, _, _ := strings.Cut(host.Hostname, ":")
+		rules = append(rules, networkingv1.IngressRule{Host: hostname})
+	}
+
+	tlsCerts, err := getCerts(req, svc.Spec.AppNamespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, tlsCerts, err = copySecretsForCerts(req, svc, getCertsMatchingRules(rules, tlsCerts))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grant := &gatewayapi.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name.SafeConcatName(svc.Name, "gateway"),
+			Namespace:   svc.Namespace,
+			Labels:      svc.Spec.Labels,
+			Annotations: svc.Spec.Annotations,
+		},
+		Spec: gatewayapi.ReferenceGrantSpec{
+			From: []gatewayapi.ReferenceGrantFrom{{
+				Group:     gatewayapi.Group,
+				Kind:      "Gateway",
+				Namespace: gatewayNamespace,
+			}},
+		},
+	}
+
+	certs = map[string]string{}
+	for _, tls := range getCertsForPublishedHosts(rules, tlsCerts) {
+		for _, host := range tls.Hosts {
+			certs[host] = tls.SecretName
+		}
+		grant.Spec.To = append(grant.Spec.To, gatewayapi.ReferenceGrantTo{
+			Kind: "Secret",
+			Name: z.Pointer(tls.SecretName),
+		})
+	}
+
+	if len(grant.Spec.To) > 0 {
+		result = append(result, grant)
+	}
+
+	return result, certs, nil
+}
+
+// GatewayListeners returns the listeners of the Gateway with an HTTPS listener for each hostname an HTTPRoute of the
+// Gateway has a certificate for. The listeners added before are replaced, all other listeners of the Gateway are
+// kept as they are. Each listener only accepts routes from the namespace of the certificate.
+func GatewayListeners(gateway *gatewayapi.Gateway, routes []gatewayapi.HTTPRoute) ([]gatewayapi.Listener, error) {
+	var result []gatewayapi.Listener
+	for _, listener := range gateway.Spec.Listeners {
+		if !strings.HasPrefix(listener.Name, gatewayListenerPrefix) {
+			result = append(result, listener)
+		}
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Namespace == routes[j].Namespace {
+			return routes[i].Name < routes[j].Name
+		}
+		return routes[i].Namespace < routes[j].Namespace
+	})
+
+	listeners := map[string]gatewayapi.Listener{}
+	for _, route := range routes {
+		if !routeOfGateway(route.Spec.ParentRefs, route.Namespace, gateway) || route.Annotations[labels.AcornGatewayCerts] == "" {
+			continue
+		}
+
+		certs := map[string]string{}
+		if err := json.Unmarshal([]byte(route.Annotations[labels.AcornGatewayCerts]), &certs); err != nil {
+			return nil, fmt.Errorf("invalid %s annotation on HTTPRoute %s/%s: %w", labels.AcornGatewayCerts, route.Namespace, route.Name, err)
+		}
+
+		for _, entry := range typed.Sorted(certs) {
+			hostname, secretName := entry.Key, entry.Value
+			if _, ok := listeners[hostname]; ok {
+				continue
+			}
+			listeners[hostname] = gatewayapi.Listener{
+				Name:     name.SafeConcatName(gatewayListenerPrefix, hostname),
+				Hostname: z.Pointer(hostname),
+				Port:     443,
+				Protocol: gatewayapi.ProtocolHTTPS,
+				TLS: &gatewayapi.GatewayTLSConfig{
+					Mode: z.Pointer(gatewayapi.TLSModeTerminate),
+					CertificateRefs: []gatewayapi.SecretObjectReference{{
+						Kind:      z.Pointer("Secret"),
+						Name:      secretName,
+						Namespace: z.Pointer(route.Namespace),
+					}},
+				},
+				AllowedRoutes: &gatewayapi.AllowedRoutes{
+					Namespaces: &gatewayapi.RouteNamespaces{
+						From: z.Pointer(gatewayapi.NamespacesFromSelector),
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								corev1.LabelMetadataName: route.Namespace,
+							},
+						},
+					},
+				},
+			}
+		}
+	}
+
+	for _, entry := range typed.Sorted(listeners) {
+		result = append(result, entry.Value)
+	}
+	return result, nil
+}
+
+// routeOfGateway returns true if one of the parent references of a route in the namespace refers to the Gateway
+func routeOfGateway(parentRefs []gatewayapi.ParentReference, namespace string, gateway *gatewayapi.Gateway) bool {
+	for _, parentRef := range parentRefs {
+		if parentRef.Namespace != nil {
+			namespace = *parentRef.Namespace
+		}
+		if parentRef.Name == gateway.Name && namespace == gateway.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// httpRouteRules sends all traffic to the port of the service, or if the service is a router, each route directly
+// to its targets. Unlike an Ingress, an HTTPRoute can split traffic and match headers and query params itself.
+func httpRouteRules(svc *v1.ServiceInstance, port int32) (result []gatewayapi.HTTPRouteRule) {
+	if len(svc.Spec.Routes) == 0 {
+		return []gatewayapi.HTTPRouteRule{prefixRule(svc.Name, port)}
+	}
+
+	for _, route := range svc.Spec.Routes {
+		targets := route.WeightedTargets()
+		if route.Path == "" || len(targets) == 0 {
+			continue
+		}
+
+		pathType := gatewayapi.PathMatchPathPrefix
+		if route.PathType == v1.PathTypeExact {
+			pathType = gatewayapi.PathMatchExact
+		}
+
+		match := gatewayapi.HTTPRouteMatch{
+			Path: &gatewayapi.HTTPPathMatch{
+				Type:  &pathType,
+				Value: z.Pointer(route.Path),
+			},
+		}
+		for _, header := range route.Headers {
+			match.Headers = append(match.Headers, gatewayapi.HTTPMatch{Name: header.Name, Value: header.Value})
+		}
+		for _, param := range route.QueryParams {
+			match.QueryParams = append(match.QueryParams, gatewayapi.HTTPMatch{Name: param.Name, Value: param.Value})
+		}
+
+		rule := gatewayapi.HTTPRouteRule{
+			Matches: []gatewayapi.HTTPRouteMatch{match},
+		}
+		for _, target := range targets {
+			targetPort := int32(target.TargetPort)
+			if targetPort == 0 {
+				targetPort = 80
+			}
+			rule.BackendRefs = append(rule.BackendRefs, gatewayapi.BackendRef{
+				Name:   target.TargetServiceName,
+				Port:   &targetPort,
+				Weight: z.Pointer(int32(target.Weight)),
+			})
+		}
+		result = append(result, rule)
+	}
+
+	return result
+}
+
+// prefixRule sends all requests to the port of the service
+func prefixRule(serviceName string, port int32) gatewayapi.HTTPRouteRule {
+	return gatewayapi.HTTPRouteRule{
+		Matches: []gatewayapi.HTTPRouteMatch{
+			{
+				Path: &gatewayapi.HTTPPathMatch{
+					Type:  z.Pointer(gatewayapi.PathMatchPathPrefix),
+					Value: z.Pointer("/"),
+				},
+			},
+		},
+		BackendRefs: []gatewayapi.BackendRef{
+			{
+				Name: serviceName,
+				Port: &port,
+			},
+		},
+	}
+}
+
+// gatewayTLSPorts returns the ports of the TLS listeners of the Gateway
+func gatewayTLSPorts(gateway *gatewayapi.Gateway) map[int32]bool {
+	result := map[int32]bool{}
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Protocol == gatewayapi.ProtocolTLS {
+			result[listener.Port] = true
+		}
+	}
+	return result
+}
+
+func portRoutes(cfg *apiv1.Config, gateway *gatewayapi.Gateway, parentRef gatewayapi.ParentReference, svc *v1.ServiceInstance) (result []kclient.Object, _ error) {
+	tlsPorts := gatewayTLSPorts(gateway)
+
+	bindings := ports.ApplyBindings(svc.Spec.PublishMode, svc.Spec.Publish, ports.ByProtocol(svc.Spec.Ports, v1.ProtocolTCP, v1.ProtocolUDP))
+
+	listens := maps.Keys(bindings)
+	sort.Slice(listens, func(i, j int) bool {
+		if listens[i].Port == listens[j].Port {
+			return listens[i].Protocol < listens[j].Protocol
+		}
+		return listens[i].Port < listens[j].Port
+	})
+
+	for _, listen := range listens {
+		boundPorts := bindings[listen]
+		if len(boundPorts) > 1 {
+			return nil, fmt.Errorf("port [%d] is bound to [%d] ports, can only be bound to 1", listen.Port, len(boundPorts))
+		}
+
+		port := boundPorts[0].Complete()
+		if listen.Protocol == v1.ProtocolTCP && tlsPorts[listen.Port] {
+			tlsRoute, err := tlsRoute(cfg, parentRef, svc, listen.Port, port)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, tlsRoute)
+			continue
+		}
+
+		targetJSON, err := json.Marshal(map[string]Target{
+			strconv.Itoa(int(listen.Port)): {Port: port.TargetPort, Service: svc.Name},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		objectMeta := metav1.ObjectMeta{
+			Name:      name.SafeConcatName(svc.Name, string(listen.Protocol), strconv.Itoa(int(listen.Port))),
+			Namespace: svc.Namespace,
+			Labels:    svc.Spec.Labels,
+			Annotations: labels.Merge(svc.Spec.Annotations, map[string]string{
+				labels.AcornTargets: string(targetJSON),
+			}),
+		}
+
+		listenerRef := parentRef
+		listenerRef.Port = z.Pointer(listen.Port)
+
+		spec := gatewayapi.RouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{listenerRef},
+			},
+			Rules: []gatewayapi.RouteRule{
+				{
+					BackendRefs: []gatewayapi.BackendRef{
+						{
+							Name: svc.Name,
+							Port: z.Pointer(port.Port),
+						},
+					},
+				},
+			},
+		}
+
+		if listen.Protocol == v1.ProtocolUDP {
+			result = append(result, &gatewayapi.UDPRoute{ObjectMeta: objectMeta, Spec: spec})
+		} else {
+			result = append(result, &gatewayapi.TCPRoute{ObjectMeta: objectMeta, Spec: spec})
+		}
+	}
+
+	return result, nil
+}
+
+// tlsRoute routes the TLS connections to the published port with the cluster domain hostnames of the port as SNI
+// hostname to the service, the service terminates TLS itself.
+func tlsRoute(cfg *apiv1.Config, parentRef gatewayapi.ParentReference, svc *v1.ServiceInstance, publishedPort int32, port v1.PortDef) (*gatewayapi.TLSRoute, error) {
+	var (
+		hostnames []string
+		targets   = map[string]Target{}
+	)
+	for _, domain := range cfg.ClusterDomains {
+		hostname, err := toHTTPEndpointHostname(*cfg.HttpEndpointPattern, domain, svc.Name, svc.Spec.AppName, svc.Spec.AppNamespace)
+		if err != nil {
+			return nil, err
+		}
+		address := net.JoinHostPort(hostname, strconv.Itoa(int(publishedPort)))
+		hostnames = append(hostnames, hostname)
+		targets[address] = Target{Port: port.TargetPort, Service: svc.Name}
+		svc.Status.Endpoints = append(svc.Status.Endpoints, v1.Endpoint{
+			Address:         address,
+			PublishProtocol: v1.PublishProtocolTCP,
+		})
+	}
+
+	targetJSON, err := json.Marshal(targets)
+	if err != nil {
+		return nil, err
+	}
+
+	listenerRef := parentRef
+	listenerRef.Port = z.Pointer(publishedPort)
+
+	return &gatewayapi.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.SafeConcatName(svc.Name, "tls", strconv.Itoa(int(publishedPort))),
+			Namespace: svc.Namespace,
+			Labels:    svc.Spec.Labels,
+			Annotations: labels.Merge(svc.Spec.Annotations, map[string]string{
+				labels.AcornTargets: string(targetJSON),
+			}),
+		},
+		Spec: gatewayapi.TLSRouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{listenerRef},
+			},
+			Hostnames: hostnames,
+			Rules: []gatewayapi.RouteRule{
+				{
+					BackendRefs: []gatewayapi.BackendRef{
+						{
+							Name: svc.Name,
+							Port: z.Pointer(port.Port),
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
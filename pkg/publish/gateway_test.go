@@ -0,0 +1,92 @@
+package publish
+
+import (
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/gatewayapi"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func certRoute(namespace, gatewayName, certs string) gatewayapi.HTTPRoute {
+	return gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "oneimage-80",
+			Namespace: namespace,
+			Annotations: map[string]string{
+				labels.AcornGatewayCerts: certs,
+			},
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{{
+					Namespace: z.Pointer("gateway-system"),
+					Name:      gatewayName,
+				}},
+			},
+		},
+	}
+}
+
+func TestGatewayListeners(t *testing.T) {
+	gateway := &gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shared",
+			Namespace: "gateway-system",
+		},
+		Spec: gatewayapi.GatewaySpec{
+			Listeners: []gatewayapi.Listener{
+				{Name: "http", Port: 80, Protocol: gatewayapi.ProtocolHTTP},
+				{Name: "acorn-tls-removed.acorn.not", Port: 443, Protocol: gatewayapi.ProtocolHTTPS},
+			},
+		},
+	}
+
+	listeners, err := GatewayListeners(gateway, []gatewayapi.HTTPRoute{
+		certRoute("app-created-namespace", "shared", `{"ci1.acorn.not":"test-tls-oneimage-1234567890ab"}`),
+		certRoute("other-namespace", "other", `{"ci2.acorn.not":"test-tls-oneimage-1234567890ab"}`),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []gatewayapi.Listener{
+		{Name: "http", Port: 80, Protocol: gatewayapi.ProtocolHTTP},
+		{
+			Name:     "acorn-tls-ci1.acorn.not",
+			Hostname: z.Pointer("ci1.acorn.not"),
+			Port:     443,
+			Protocol: gatewayapi.ProtocolHTTPS,
+			TLS: &gatewayapi.GatewayTLSConfig{
+				Mode: z.Pointer(gatewayapi.TLSModeTerminate),
+				CertificateRefs: []gatewayapi.SecretObjectReference{{
+					Kind:      z.Pointer("Secret"),
+					Name:      "test-tls-oneimage-1234567890ab",
+					Namespace: z.Pointer("app-created-namespace"),
+				}},
+			},
+			AllowedRoutes: &gatewayapi.AllowedRoutes{
+				Namespaces: &gatewayapi.RouteNamespaces{
+					From: z.Pointer(gatewayapi.NamespacesFromSelector),
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							corev1.LabelMetadataName: "app-created-namespace",
+						},
+					},
+				},
+			},
+		},
+	}, listeners)
+}
+
+func TestGatewayListenersInvalidCerts(t *testing.T) {
+	_, err := GatewayListeners(&gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shared",
+			Namespace: "gateway-system",
+		},
+	}, []gatewayapi.HTTPRoute{certRoute("app-created-namespace", "shared", "{")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,210 @@
+package publish
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/traefik"
+	"github.com/acorn-io/z"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	nginxIngressController   = "k8s.io/ingress-nginx"
+	traefikIngressController = "traefik.io/ingress-controller"
+
+	nginxAnnotationPrefix        = "nginx.ingress.kubernetes.io/"
+	traefikMiddlewaresAnnotation = "traefik.ingress.kubernetes.io/router.middlewares"
+)
+
+// ingressController returns the controller of the IngressClass that serves Ingresses with the ingressClassName, or
+// of the default IngressClass if no name is set.
+func ingressController(req router.Request, ingressClassName *string) (string, error) {
+	var ingressClasses networkingv1.IngressClassList
+	if err := req.List(&ingressClasses, &kclient.ListOptions{}); err != nil {
+		return "", err
+	}
+
+	for _, ic := range ingressClasses.Items {
+		if ingressClassName != nil {
+			if ic.Name == *ingressClassName {
+				return ic.Spec.Controller, nil
+			}
+		} else if ic.Annotations["ingressclass.kubernetes.io/is-default-class"] == "true" {
+			return ic.Spec.Controller, nil
+		}
+	}
+
+	if ingressClassName != nil {
+		return "", fmt.Errorf("ingress class [%s] not found", *ingressClassName)
+	}
+	return "", fmt.Errorf("failed to find the default ingress class")
+}
+
+// httpOptions applies the HTTP options of a published port to the Ingress of the port. There is no standard for
+// these options, so they are translated into the annotations, and objects, of the ingress controller that serves the
+// Ingress.
+func httpOptions(req router.Request, svc *v1.ServiceInstance, ingress *networkingv1.Ingress, opts *v1.PortHTTPOptions) ([]kclient.Object, error) {
+	if opts.Rewrite != nil && len(svc.Spec.Routes) > 0 {
+		return nil, fmt.Errorf("rewrite can not be used on the ports of router [%s]", svc.Name)
+	}
+
+	controller, err := ingressController(req, ingress.Spec.IngressClassName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch controller {
+	case nginxIngressController:
+		return nil, nginxHTTPOptions(ingress, opts)
+	case traefikIngressController:
+		return traefikHTTPOptions(svc, ingress, opts)
+	}
+	return nil, fmt.Errorf("http options are only supported by the ingress-nginx and traefik ingress controllers, not [%s]", controller)
+}
+
+func nginxHTTPOptions(ingress *networkingv1.Ingress, opts *v1.PortHTTPOptions) error {
+	annotations := map[string]string{}
+
+	if opts.ForceHTTPS {
+		annotations["force-ssl-redirect"] = "true"
+	}
+
+	if opts.Rewrite != nil {
+		// The rest of the path after the prefix is captured, so that it can be appended to the replacement
+		setRulePaths(ingress, regexp.QuoteMeta(opts.Rewrite.Prefix)+"(/|$)(.*)", networkingv1.PathTypeImplementationSpecific)
+		annotations["use-regex"] = "true"
+		annotations["rewrite-target"] = opts.Rewrite.ReplacementPrefix() + "/$2"
+	}
+
+	if opts.CORS != nil {
+		annotations["enable-cors"] = "true"
+		annotations["cors-allow-origin"] = strings.Join(opts.CORS.AllowOrigins, ", ")
+		annotations["cors-allow-credentials"] = strconv.FormatBool(opts.CORS.AllowCredentials)
+		if len(opts.CORS.AllowMethods) > 0 {
+			annotations["cors-allow-methods"] = strings.Join(opts.CORS.AllowMethods, ", ")
+		}
+		if len(opts.CORS.AllowHeaders) > 0 {
+			annotations["cors-allow-headers"] = strings.Join(opts.CORS.AllowHeaders, ", ")
+		}
+		if opts.CORS.MaxAge > 0 {
+			annotations["cors-max-age"] = strconv.Itoa(int(opts.CORS.MaxAge))
+		}
+	}
+
+	if opts.MaxBodySize != "" {
+		size, err := opts.MaxBodySizeBytes()
+		if err != nil {
+			return err
+		}
+		annotations["proxy-body-size"] = strconv.FormatInt(size, 10)
+	}
+
+	if opts.Timeout != "" {
+		seconds, err := opts.TimeoutSeconds()
+		if err != nil {
+			return err
+		}
+		annotations["proxy-read-timeout"] = strconv.FormatInt(seconds, 10)
+		annotations["proxy-send-timeout"] = strconv.FormatInt(seconds, 10)
+	}
+
+	for key, value := range annotations {
+		ingress.Annotations[nginxAnnotationPrefix+key] = value
+	}
+	return nil
+}
+
+// traefikHTTPOptions creates a Middleware per option and adds them to the router traefik creates for the Ingress.
+// The Middlewares are only picked up if the kubernetescrd provider of traefik is enabled.
+func traefikHTTPOptions(svc *v1.ServiceInstance, ingress *networkingv1.Ingress, opts *v1.PortHTTPOptions) ([]kclient.Object, error) {
+	if opts.Timeout != "" {
+		return nil, fmt.Errorf("timeout is not supported by the traefik ingress controller")
+	}
+
+	var (
+		result      []kclient.Object
+		middlewares []string
+	)
+	addMiddleware := func(kind string, spec traefik.MiddlewareSpec) {
+		middleware := &traefik.Middleware{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.SafeConcatName(ingress.Name, kind),
+				Namespace: ingress.Namespace,
+				Labels:    svc.Spec.Labels,
+			},
+			Spec: spec,
+		}
+		result = append(result, middleware)
+		middlewares = append(middlewares, fmt.Sprintf("%s-%s@kubernetescrd", middleware.Namespace, middleware.Name))
+	}
+
+	if opts.ForceHTTPS {
+		addMiddleware("redirect", traefik.MiddlewareSpec{
+			RedirectScheme: &traefik.RedirectScheme{
+				Scheme:    "https",
+				Permanent: true,
+			},
+		})
+	}
+
+	if opts.Rewrite != nil {
+		setRulePaths(ingress, opts.Rewrite.Prefix, networkingv1.PathTypePrefix)
+		addMiddleware("rewrite", traefik.MiddlewareSpec{
+			ReplacePathRegex: &traefik.ReplacePathRegex{
+				Regex:       "^" + regexp.QuoteMeta(opts.Rewrite.Prefix) + "(/|$)(.*)",
+				Replacement: opts.Rewrite.ReplacementPrefix() + "/$2",
+			},
+		})
+	}
+
+	if opts.CORS != nil {
+		addMiddleware("cors", traefik.MiddlewareSpec{
+			Headers: &traefik.Headers{
+				AccessControlAllowOriginList:  opts.CORS.AllowOrigins,
+				AccessControlAllowMethods:     opts.CORS.AllowMethods,
+				AccessControlAllowHeaders:     opts.CORS.AllowHeaders,
+				AccessControlAllowCredentials: opts.CORS.AllowCredentials,
+				AccessControlMaxAge:           int64(opts.CORS.MaxAge),
+				AddVaryHeader:                 true,
+			},
+		})
+	}
+
+	if opts.MaxBodySize != "" {
+		size, err := opts.MaxBodySizeBytes()
+		if err != nil {
+			return nil, err
+		}
+		addMiddleware("buffering", traefik.MiddlewareSpec{
+			Buffering: &traefik.Buffering{
+				MaxRequestBodyBytes: size,
+			},
+		})
+	}
+
+	if len(middlewares) > 0 {
+		ingress.Annotations[traefikMiddlewaresAnnotation] = strings.Join(middlewares, ",")
+	}
+	return result, nil
+}
+
+// setRulePaths changes the path of all the rules of the Ingress
+func setRulePaths(ingress *networkingv1.Ingress, path string, pathType networkingv1.PathType) {
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for i := range rule.HTTP.Paths {
+			rule.HTTP.Paths[i].Path = path
+			rule.HTTP.Paths[i].PathType = z.Pointer(pathType)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/labels"
@@ -161,64 +162,96 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 		}
 	}
 
+	hosts, err := httpHosts(cfg, svc, bindings)
+	if err != nil {
+		return nil, err
+	}
+
+	authProxies, err := authProxies(req, svc, hosts)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, authProxies...)
+
 	var (
 		// Separate rules for cluster domain and custom domain
 		// This is needed to have separate ingress resources so that for custom domain, we can apply cert-manager setting to request certificate,
 		// while keeping cluster domain certs as it is with acorn's built-in LE feature.
-		customDomainRules    []networkingv1.IngressRule
-		clusterDomainRules   []networkingv1.IngressRule
-		customDomainTargets  = map[string]Target{}
-		clusterDomainTargets = map[string]Target{}
+		groups = []*ingressGroup{
+			{name: clusterDomain, targets: map[string]Target{}},
+			{name: customDomain, custom: true, targets: map[string]Target{}},
+		}
+		groupsByName = map[string]*ingressGroup{
+			clusterDomain: groups[0],
+			customDomain:  groups[1],
+		}
 	)
 
-	for _, entry := range typed.Sorted(bindings.ByHostname()) {
-		hostname := entry.Key
-		ports := typed.MapSlice(entry.Value, func(p v1.PortDef) v1.PortDef {
-			return p.Complete()
-		})
-		if hostname == "" {
-			for i, port := range ports {
-				targetName := svc.Name
-				if i > 0 {
-					targetName = name.SafeConcatName(targetName, fmt.Sprint(port.Port))
-				}
+	for _, host := range hosts {
+		groupName := clusterDomain
+		if host.Custom {
+			groupName = customDomain
+		}
+		// The HTTP options of a port apply to the whole Ingress, so ports with options get Ingresses of their own
+		if host.HTTP != nil {
+			groupName = name.SafeConcatName(groupName, strconv.Itoa(int(host.Port)))
+		}
 
-				for _, domain := range cfg.ClusterDomains {
-					hostname, err := toHTTPEndpointHostname(*cfg.HttpEndpointPattern, domain, targetName, svc.Spec.AppName, svc.Spec.AppNamespace)
-					if err != nil {
-						return nil, err
-					}
-					clusterDomainTargets[hostname] = Target{Port: port.TargetPort, Service: svc.Name}
-					clusterDomainRules = append(clusterDomainRules, getIngressRule(svc, hostname, port.Port))
-				}
-			}
-		} else {
-			if len(ports) > 1 {
-				return nil, fmt.Errorf("multiple ports bound to the same hostname [%s]", hostname)
-			}
-			customDomainTargets[hostname] = Target{Port: ports[0].TargetPort, Service: svc.Name}
-			customDomainRules = append(customDomainRules, getIngressRule(svc, hostname, ports[0].Port))
+		group := groupsByName[groupName]
+		if group == nil {
+			group = &ingressGroup{name: groupName, custom: host.Custom, http: host.HTTP, targets: map[string]Target{}}
+			groupsByName[groupName] = group
+			groups = append(groups, group)
+		}
+
+		rule := getIngressRule(svc, host.Hostname, host.Port)
+		if host.Auth != nil {
+			rule = authProxyRule(host.Hostname, authProxyName(svc, host.Port))
 		}
+		group.targets[host.Hostname] = host.Target
+		group.rules = append(group.rules, rule)
 	}
 
-	for _, rules := range []struct {
-		rules  []networkingv1.IngressRule
-		name   string
-		target map[string]Target
-	}{
-		{rules: clusterDomainRules, name: clusterDomain, target: clusterDomainTargets},
-		{rules: customDomainRules, name: customDomain, target: customDomainTargets},
-	} {
-		if len(rules.rules) == 0 {
+	var (
+		acmeRules []networkingv1.IngressRule
+		acmeTLS   []networkingv1.IngressTLS
+	)
+	for _, group := range groups {
+		if len(group.rules) == 0 {
 			continue
 		}
 		// For custom domain, always use cert-manager to provision certificate.
-		secrets, ingressTLS, ingressAnnotation, err := setupCertsForRules(req, svc, rules.rules, rules.name == customDomain, *cfg.CertManagerIssuer)
+		group.secrets, group.tls, group.annotations, err = setupCertsForRules(req, svc, group.rules, group.custom, *cfg.CertManagerIssuer)
+		if err != nil {
+			return nil, err
+		}
+		if group.custom {
+			acmeRules = append(acmeRules, group.rules...)
+			acmeTLS = append(acmeTLS, group.tls...)
+		}
+	}
+
+	// One solver requests the certificates of the custom domains of all the Ingresses of the service
+	if len(acmeRules) > 0 {
+		var solver []kclient.Object
+		acmeTLS, solver, err = acmeHTTP01(req, cfg, svc, ingressClassName, acmeRules, acmeTLS)
 		if err != nil {
 			return nil, err
 		}
+		result = append(result, solver...)
+	}
+
+	for _, group := range groups {
+		if len(group.rules) == 0 {
+			continue
+		}
+
+		ingressTLS := group.tls
+		if group.custom {
+			ingressTLS = append(ingressTLS, tlsForRules(acmeTLS, group.rules)...)
+		}
 
-		targetJSON, err := json.Marshal(rules.target)
+		targetJSON, err := json.Marshal(group.targets)
 		if err != nil {
 			return nil, err
 		}
@@ -229,7 +262,7 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 		}
 
 		hostnameSeen := map[string]struct{}{}
-		for _, rule := range rules.rules {
+		for _, rule := range group.rules {
 			if _, ok := hostnameSeen[rule.Host]; ok {
 				continue
 			}
@@ -243,27 +276,123 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 		ingress := &networkingv1.Ingress{
 			TypeMeta: metav1.TypeMeta{},
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      name.SafeConcatName(svc.Name, rules.name),
+				Name:      name.SafeConcatName(svc.Name, group.name),
 				Namespace: svc.Namespace,
 				Labels:    svc.Spec.Labels,
-				Annotations: labels.Merge(ingressAnnotation, map[string]string{
+				Annotations: labels.Merge(group.annotations, map[string]string{
 					labels.AcornTargets: string(targetJSON),
 				}),
 			},
 			Spec: networkingv1.IngressSpec{
 				IngressClassName: ingressClassName,
-				Rules:            rules.rules,
+				Rules:            group.rules,
 				TLS:              ingressTLS,
 			},
 		}
+
+		if group.http != nil {
+			objs, err := httpOptions(req, svc, ingress, group.http)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, objs...)
+		}
 		result = append(result, ingress)
 
-		result = append(result, secrets...)
+		result = append(result, group.secrets...)
 	}
 
 	return
 }
 
+// ingressGroup is the rules of the hosts that are published by the same Ingress, and the certificates of the hosts
+type ingressGroup struct {
+	name   string
+	custom bool
+	// http are the HTTP options of the port of the hosts, nil for ports without options
+	http        *v1.PortHTTPOptions
+	rules       []networkingv1.IngressRule
+	targets     map[string]Target
+	secrets     []kclient.Object
+	tls         []networkingv1.IngressTLS
+	annotations map[string]string
+}
+
+// tlsForRules returns the entries of tls that are for the hosts of the rules
+func tlsForRules(tls []networkingv1.IngressTLS, rules []networkingv1.IngressRule) (result []networkingv1.IngressTLS) {
+	hosts := map[string]bool{}
+	for _, rule := range rules {
+		hosts[rule.Host] = true
+	}
+	for _, entry := range tls {
+		for _, host := range entry.Hosts {
+			if hosts[host] {
+				result = append(result, entry)
+				break
+			}
+		}
+	}
+	return
+}
+
+// httpHost is a hostname that a published HTTP port of a service is reachable on.
+type httpHost struct {
+	Hostname string
+	// Port is the port of the service that receives the traffic for the hostname
+	Port   int32
+	Target Target
+	// Custom is true if the hostname was chosen by the user instead of generated from a cluster domain
+	Custom bool
+	// Auth is set if requests to the port have to go through an auth proxy
+	Auth *v1.PortAuth
+	// HTTP are the options for how the ingress controller handles requests to the port
+	HTTP *v1.PortHTTPOptions
+}
+
+func httpHosts(cfg *apiv1.Config, svc *v1.ServiceInstance, bindings ports.BoundPorts) (result []httpHost, _ error) {
+	for _, entry := range typed.Sorted(bindings.ByHostname()) {
+		hostname := entry.Key
+		ports := typed.MapSlice(entry.Value, func(p v1.PortDef) v1.PortDef {
+			return p.Complete()
+		})
+		if hostname == "" {
+			for i, port := range ports {
+				targetName := svc.Name
+				if i > 0 {
+					targetName = name.SafeConcatName(targetName, fmt.Sprint(port.Port))
+				}
+
+				for _, domain := range cfg.ClusterDomains {
+					hostname, err := toHTTPEndpointHostname(*cfg.HttpEndpointPattern, domain, targetName, svc.Spec.AppName, svc.Spec.AppNamespace)
+					if err != nil {
+						return nil, err
+					}
+					result = append(result, httpHost{
+						Hostname: hostname,
+						Port:     port.Port,
+						Target:   Target{Port: port.TargetPort, Service: svc.Name},
+						Auth:     port.Auth,
+						HTTP:     port.HTTP,
+					})
+				}
+			}
+		} else {
+			if len(ports) > 1 {
+				return nil, fmt.Errorf("multiple ports bound to the same hostname [%s]", hostname)
+			}
+			result = append(result, httpHost{
+				Hostname: hostname,
+				Port:     ports[0].Port,
+				Target:   Target{Port: ports[0].TargetPort, Service: svc.Name},
+				Custom:   true,
+				Auth:     ports[0].Auth,
+				HTTP:     ports[0].HTTP,
+			})
+		}
+	}
+	return result, nil
+}
+
 func setupCertManager(serviceName string, rules []networkingv1.IngressRule) []networkingv1.IngressTLS {
 	var result []networkingv1.IngressTLS
 	hostsSeen := map[string]bool{}
@@ -300,12 +429,42 @@ func IngressClassNameIfNoDefault(ctx context.Context, client kclient.Client) (*s
 }
 
 func getIngressRule(svc *v1.ServiceInstance, host string, port int32) networkingv1.IngressRule {
-	// strip possible port in host
-	host, _, _ = strings.Cut(host, ":")
-
 	if len(svc.Spec.Routes) > 0 {
-		return routerRule(host, svc.Spec.Routes)
+		// strip possible port in host
+		host, _, _ = strings.Cut(host, ":")
+		return routerRule(host, svc.Name, port, svc.Spec.Routes)
+	}
+
+	return serviceRule(host, svc.Name, port)
+}
+
+// authProxyRule sends all requests for the host to the auth proxy, which passes them on to the service, or the router
+// if the service is a router, once authenticated.
+func authProxyRule(host, proxyName string) networkingv1.IngressRule {
+	return serviceRule(host, proxyName, authProxyPort)
+}
+
+// authProxies returns the auth proxies for the ports of the hosts that require auth
+func authProxies(req router.Request, svc *v1.ServiceInstance, hosts []httpHost) (result []kclient.Object, _ error) {
+	seen := map[int32]bool{}
+	for _, host := range hosts {
+		if host.Auth == nil || seen[host.Port] {
+			continue
+		}
+		seen[host.Port] = true
+
+		objs, err := authProxy(req, svc, host.Port, host.Auth)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, objs...)
 	}
+	return result, nil
+}
+
+func serviceRule(host, serviceName string, port int32) networkingv1.IngressRule {
+	// strip possible port in host
+	host, _, _ = strings.Cut(host, ":")
 
 	return networkingv1.IngressRule{
 		Host: host,
@@ -317,7 +476,7 @@ func getIngressRule(svc *v1.ServiceInstance, host string, port int32) networking
 						PathType: z.Pointer(networkingv1.PathTypePrefix),
 						Backend: networkingv1.IngressBackend{
 							Service: &networkingv1.IngressServiceBackend{
-								Name: svc.Name,
+								Name: serviceName,
 								Port: networkingv1.ServiceBackendPort{
 									Number: port,
 								},
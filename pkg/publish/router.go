@@ -5,33 +5,60 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 )
 
-func routerRule(host string, routes []v1.Route) networkingv1.IngressRule {
+// routerRule returns an ingress rule sending each route directly to its target. Routes that split traffic
+// between targets or match on headers or query params can't be expressed by an ingress rule, so their path is
+// sent to the router itself, which runs the routing logic.
+func routerRule(host, routerServiceName string, routerPort int32, routes []v1.Route) networkingv1.IngressRule {
 	rule := networkingv1.IngressRule{
 		Host: host,
 		IngressRuleValue: networkingv1.IngressRuleValue{
 			HTTP: &networkingv1.HTTPIngressRuleValue{},
 		},
 	}
+
+	var (
+		seen      = map[string]bool{}
+		viaRouter = map[string]bool{}
+		added     = map[string]bool{}
+	)
+	for _, route := range routes {
+		if route.Path == "" || len(route.WeightedTargets()) == 0 {
+			continue
+		}
+		key := string(route.PathType) + " " + route.Path
+		viaRouter[key] = viaRouter[key] || seen[key] || route.HasAdvancedRouting()
+		seen[key] = true
+	}
+
 	for _, route := range routes {
-		if route.Path == "" || route.TargetServiceName == "" {
+		targets := route.WeightedTargets()
+		key := string(route.PathType) + " " + route.Path
+		if route.Path == "" || len(targets) == 0 || added[key] {
 			continue
 		}
+		added[key] = true
+
 		pathType := networkingv1.PathTypePrefix
 		if route.PathType == v1.PathTypeExact {
 			pathType = networkingv1.PathTypeExact
 		}
-		port := route.TargetPort
+
+		serviceName, port := targets[0].TargetServiceName, int32(targets[0].TargetPort)
+		if viaRouter[key] {
+			serviceName, port = routerServiceName, routerPort
+		}
 		if port == 0 {
 			port = 80
 		}
+
 		rule.IngressRuleValue.HTTP.Paths = append(rule.IngressRuleValue.HTTP.Paths, networkingv1.HTTPIngressPath{
 			Path:     route.Path,
 			PathType: &pathType,
 			Backend: networkingv1.IngressBackend{
 				Service: &networkingv1.IngressServiceBackend{
-					Name: route.TargetServiceName,
+					Name: serviceName,
 					Port: networkingv1.ServiceBackendPort{
-						Number: int32(port),
+						Number: port,
 					},
 				},
 			},
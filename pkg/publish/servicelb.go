@@ -2,19 +2,29 @@ package publish
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/acorn-io/baaah/pkg/name"
 	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/ipfamily"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/z"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	ServiceLBModeDedicated = "dedicated"
+	ServiceLBModeShared    = "shared"
+)
+
 func ServiceLoadBalancer(req router.Request, svc *v1.ServiceInstance) (result []kclient.Object, _ error) {
 	if svc.Spec.PublishMode == v1.PublishModeNone {
 		return nil, nil
@@ -61,7 +71,7 @@ func ServiceLoadBalancer(req router.Request, svc *v1.ServiceInstance) (result []
 		return nil, err
 	}
 
-	result = append(result, &corev1.Service{
+	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name.SafeConcatName(svc.Name, "publish", svc.ShortID()),
 			Namespace: svc.Namespace,
@@ -75,7 +85,166 @@ func ServiceLoadBalancer(req router.Request, svc *v1.ServiceInstance) (result []
 			Selector: labels.Merge(labels.ManagedByApp(svc.Spec.AppNamespace, svc.Spec.AppName), selectorLabels),
 			Type:     corev1.ServiceTypeLoadBalancer,
 		},
-	})
+	}
+
+	switch z.Dereference(cfg.ServiceLBMode) {
+	case "", ServiceLBModeDedicated:
+	case ServiceLBModeShared:
+		if err := allocateSharedPorts(req, *cfg.SharedLBPortRange, svc, service); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid service LB mode %q, must be %s or %s", *cfg.ServiceLBMode, ServiceLBModeDedicated, ServiceLBModeShared)
+	}
+
+	families, err := ipfamily.Detect(req.Ctx, req.Client)
+	if err != nil {
+		return nil, err
+	}
+	ipfamily.Set(service, families)
+
+	return append(result, service), nil
+}
+
+// allocateSharedPorts turns the service into a NodePort service with ports from the shared port range, so a single
+// load balancer in front of the nodes can serve all apps. A port keeps its allocation as long as the service exists,
+// new ports get the published port if it is free and in range, or else the lowest free port. Ports explicitly
+// published by the user must be free and in range. Allocations are released when the service is deleted.
+func allocateSharedPorts(req router.Request, portRange string, svc *v1.ServiceInstance, service *corev1.Service) error {
+	low, high, err := parsePortRange(portRange)
+	if err != nil {
+		return err
+	}
+
+	existing := &corev1.Service{}
+	if err := req.Get(existing, service.Namespace, service.Name); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	current := map[string]int32{}
+	for _, port := range existing.Spec.Ports {
+		current[port.Name+"/"+string(port.Protocol)] = port.NodePort
+	}
+
+	services := &corev1.ServiceList{}
+	if err := req.List(services, &kclient.ListOptions{
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornServicePublish: "true",
+		}),
+	}); err != nil {
+		return err
+	}
+
+	allocated := map[int32]string{}
+	for _, other := range services.Items {
+		if other.Namespace == service.Namespace && other.Name == service.Name {
+			continue
+		}
+		for _, port := range other.Spec.Ports {
+			if port.NodePort != 0 {
+				allocated[port.NodePort] = other.Labels[labels.AcornAppNamespace] + "/" + other.Labels[labels.AcornAppName]
+			}
+		}
+	}
+
+	explicit := map[int32]bool{}
+	for _, publish := range svc.Spec.Publish {
+		if publish.Port != 0 && publish.Protocol != v1.ProtocolHTTP {
+			explicit[publish.Port] = true
+		}
+	}
+
+	allocator := portAllocator{
+		portRange: portRange,
+		low:       low,
+		high:      high,
+		current:   current,
+		allocated: allocated,
+		explicit:  explicit,
+	}
+	if err := allocator.assign(service.Spec.Ports); err != nil {
+		return err
+	}
+
+	service.Spec.Type = corev1.ServiceTypeNodePort
+	return nil
+}
+
+type portAllocator struct {
+	portRange string
+	low, high int32
+	// current are the ports allocated to the service by port name and protocol
+	current map[string]int32
+	// allocated is the app each port in use by other services is allocated to
+	allocated map[int32]string
+	// explicit are the ports the user published explicitly
+	explicit map[int32]bool
+}
+
+func (p portAllocator) assign(ports []corev1.ServicePort) error {
+	var (
+		used = map[int32]bool{}
+		free = func(port int32) bool {
+			return p.inRange(port) && p.allocated[port] == "" && !used[port]
+		}
+		// TCP and UDP on the same published port share the allocated port
+		byPublishedPort = map[int32]int32{}
+	)
+
+	for i, port := range ports {
+		current := p.current[port.Name+"/"+string(port.Protocol)]
+		nodePort := byPublishedPort[port.Port]
+		switch {
+		case nodePort != 0:
+		case p.explicit[port.Port]:
+			if !p.inRange(port.Port) {
+				return fmt.Errorf("published port %d is outside of the shared load balancer port range %s", port.Port, p.portRange)
+			} else if owner := p.allocated[port.Port]; owner != "" {
+				return fmt.Errorf("published port %d is already allocated to app %s", port.Port, owner)
+			}
+			nodePort = port.Port
+		case free(current):
+			nodePort = current
+		case free(port.Port):
+			nodePort = port.Port
+		default:
+			for candidate := p.low; candidate <= p.high; candidate++ {
+				if free(candidate) {
+					nodePort = candidate
+					break
+				}
+			}
+			if nodePort == 0 {
+				return fmt.Errorf("no free port left in the shared load balancer port range %s", p.portRange)
+			}
+		}
 
-	return result, nil
+		used[nodePort] = true
+		byPublishedPort[port.Port] = nodePort
+		ports[i].NodePort = nodePort
+	}
+
+	return nil
+}
+
+func (p portAllocator) inRange(port int32) bool {
+	return port >= p.low && port <= p.high
+}
+
+func parsePortRange(portRange string) (low, high int32, _ error) {
+	lowStr, highStr, ok := strings.Cut(portRange, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid port range %q, must be in the form of low-high", portRange)
+	}
+	lowPort, err := strconv.ParseInt(strings.TrimSpace(lowStr), 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port range %q: %w", portRange, err)
+	}
+	highPort, err := strconv.ParseInt(strings.TrimSpace(highStr), 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port range %q: %w", portRange, err)
+	}
+	if lowPort < 1 || highPort > 65535 || lowPort > highPort {
+		return 0, 0, fmt.Errorf("invalid port range %q", portRange)
+	}
+	return int32(lowPort), int32(highPort), nil
 }
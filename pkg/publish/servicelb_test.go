@@ -0,0 +1,75 @@
+package publish
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPortAllocatorAssign(t *testing.T) {
+	allocator := portAllocator{
+		portRange: "30000-30005",
+		low:       30000,
+		high:      30005,
+		current: map[string]int32{
+			"80/TCP": 30004,
+		},
+		allocated: map[int32]string{
+			30000: "other/app",
+			30002: "other/app",
+		},
+	}
+
+	ports := []corev1.ServicePort{
+		{Name: "53", Port: 53, Protocol: corev1.ProtocolTCP},
+		{Name: "53-udp", Port: 53, Protocol: corev1.ProtocolUDP},
+		{Name: "80", Port: 80, Protocol: corev1.ProtocolTCP},
+		{Name: "30003", Port: 30003, Protocol: corev1.ProtocolTCP},
+		{Name: "5432", Port: 5432, Protocol: corev1.ProtocolTCP},
+	}
+	require.NoError(t, allocator.assign(ports))
+
+	var nodePorts []int32
+	for _, port := range ports {
+		nodePorts = append(nodePorts, port.NodePort)
+	}
+	assert.Equal(t, []int32{30001, 30001, 30004, 30003, 30005}, nodePorts)
+
+	err := allocator.assign(append(ports, corev1.ServicePort{Name: "8080", Port: 8080, Protocol: corev1.ProtocolTCP}))
+	assert.EqualError(t, err, "no free port left in the shared load balancer port range 30000-30005")
+}
+
+func TestPortAllocatorAssignExplicit(t *testing.T) {
+	allocator := portAllocator{
+		portRange: "30000-30005",
+		low:       30000,
+		high:      30005,
+		allocated: map[int32]string{
+			30000: "other/app",
+		},
+		explicit: map[int32]bool{
+			30000: true,
+			5432:  true,
+		},
+	}
+
+	err := allocator.assign([]corev1.ServicePort{{Name: "30000", Port: 30000, Protocol: corev1.ProtocolTCP}})
+	assert.EqualError(t, err, "published port 30000 is already allocated to app other/app")
+
+	err = allocator.assign([]corev1.ServicePort{{Name: "5432", Port: 5432, Protocol: corev1.ProtocolTCP}})
+	assert.EqualError(t, err, "published port 5432 is outside of the shared load balancer port range 30000-30005")
+}
+
+func TestParsePortRange(t *testing.T) {
+	low, high, err := parsePortRange("30000-32767")
+	require.NoError(t, err)
+	assert.Equal(t, int32(30000), low)
+	assert.Equal(t, int32(32767), high)
+
+	for _, invalid := range []string{"30000", "a-b", "2-1", "0-10", "1-70000"} {
+		_, _, err := parsePortRange(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -127,7 +127,11 @@ func (r *resolver) getService(svc *v1.ServiceInstance, namespace, name string) e
 		if publicname.Get(svc) == svc.Spec.External {
 			return nil
 		}
-		return Lookup(r.ctx, r.req, svc, svc.Spec.AppNamespace, strings.Split(svc.Spec.External, ".")...)
+		project, service, err := ExternalService(r.ctx, r.req, svc)
+		if err != nil {
+			return err
+		}
+		return Lookup(r.ctx, r.req, svc, project, strings.Split(service, ".")...)
 	} else if svc.Spec.Alias != "" {
 		if svc.Name == svc.Spec.Alias {
 			return nil
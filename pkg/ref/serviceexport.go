@@ -0,0 +1,44 @@
+package ref
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ExternalService returns the project and the name of the service the link of the ServiceInstance points to. Links to
+// services of other projects are in the form project/app.service and are only followed if the other project exports
+// the service to the project of the link with a ServiceExport.
+func ExternalService(ctx context.Context, c kclient.Client, svc *v1.ServiceInstance) (project, service string, _ error) {
+	project, service, ok := strings.Cut(svc.Spec.External, "/")
+	if !ok {
+		return svc.Spec.AppNamespace, svc.Spec.External, nil
+	}
+	if project == svc.Spec.AppNamespace {
+		return project, service, nil
+	}
+	return project, service, CheckServiceExport(ctx, c, project, service, svc.Spec.AppNamespace)
+}
+
+// CheckServiceExport returns a forbidden error unless the project exports the service to the consumer project
+func CheckServiceExport(ctx context.Context, c kclient.Client, project, service, consumerProject string) error {
+	exports := &v1.ServiceExportInstanceList{}
+	if err := c.List(ctx, exports, &kclient.ListOptions{
+		Namespace: project,
+	}); err != nil {
+		return err
+	}
+
+	for _, export := range exports.Items {
+		if export.Exports(service, consumerProject) {
+			return nil
+		}
+	}
+
+	return apierrors.NewForbidden(v1.SchemeGroupVersion.WithResource("serviceinstances").GroupResource(), project+"/"+service,
+		fmt.Errorf("project %s does not export service %s to project %s", project, service, consumerProject))
+}
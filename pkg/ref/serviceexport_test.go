@@ -0,0 +1,125 @@
+package ref
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func serviceExportObjects(exportTo ...string) []kclient.Object {
+	return []kclient.Object{
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "db-project",
+			},
+		},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "web-app-namespace",
+				Labels: map[string]string{
+					labels.AcornAppName:      "web-app",
+					labels.AcornAppNamespace: "web-project",
+				},
+			},
+		},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "db-app-namespace",
+				Labels: map[string]string{
+					labels.AcornAppName:      "db-app",
+					labels.AcornAppNamespace: "db-project",
+				},
+			},
+		},
+		&v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db-app",
+				Namespace: "db-project",
+			},
+			Status: v1.AppInstanceStatus{
+				Namespace: "db-app-namespace",
+			},
+		},
+		&v1.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db",
+				Namespace: "db-app-namespace",
+			},
+			Spec: v1.ServiceInstanceSpec{
+				Address: "db.example.com",
+			},
+		},
+		&v1.ServiceExportInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db",
+				Namespace: "db-project",
+			},
+			Spec: v1.ServiceExportInstanceSpec{
+				Service:  "db-app.db",
+				Projects: exportTo,
+			},
+		},
+		&v1.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db",
+				Namespace: "web-app-namespace",
+			},
+			Spec: v1.ServiceInstanceSpec{
+				AppName:      "web-app",
+				AppNamespace: "web-project",
+				External:     "db-project/db-app.db",
+			},
+		},
+	}
+}
+
+func TestLookupExportedService(t *testing.T) {
+	c := &tester.Client{
+		Objects:   serviceExportObjects("other-project", "web-project"),
+		SchemeObj: scheme.Scheme,
+	}
+
+	svc := &v1.ServiceInstance{}
+	require.NoError(t, Lookup(context.Background(), c, svc, "web-app-namespace", "db"))
+	assert.Equal(t, "db-app-namespace", svc.Namespace)
+	assert.Equal(t, "db.example.com", svc.Spec.Address)
+}
+
+func TestLookupServiceNotExported(t *testing.T) {
+	c := &tester.Client{
+		Objects:   serviceExportObjects("other-project"),
+		SchemeObj: scheme.Scheme,
+	}
+
+	err := Lookup(context.Background(), c, &v1.ServiceInstance{}, "web-app-namespace", "db")
+	assert.True(t, apierrors.IsForbidden(err), err)
+	assert.ErrorContains(t, err, "project db-project does not export service db-app.db to project web-project")
+}
+
+func TestExternalServiceSameProject(t *testing.T) {
+	c := &tester.Client{
+		SchemeObj: scheme.Scheme,
+	}
+
+	for _, external := range []string{"db-app.db", "web-project/db-app.db"} {
+		project, service, err := ExternalService(context.Background(), c, &v1.ServiceInstance{
+			Spec: v1.ServiceInstanceSpec{
+				AppNamespace: "web-project",
+				External:     external,
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "web-project", project)
+		assert.Equal(t, "db-app.db", service)
+	}
+}
@@ -27,6 +27,12 @@ var (
 					"regions",
 				},
 			},
+			{
+				Verbs: []string{"get"},
+				Resources: []string{
+					"projects/keys",
+				},
+			},
 		},
 		ClusterEdit: {
 			{
@@ -35,6 +41,13 @@ var (
 					"projects",
 				},
 			},
+			{
+				Verbs: []string{"create"},
+				Resources: []string{
+					"projects/rotatekey",
+					"projects/retirekey",
+				},
+			},
 		},
 	}
 	projectRoles = map[string][]rbacv1.PolicyRule{
@@ -62,12 +75,16 @@ var (
 					"computeclasses",
 					"regions",
 					"imageallowrules",
+					"secretshares",
+					"secretimports",
+					"serviceexports",
 				},
 			},
 			{
 				Verbs: []string{"get", "create"},
 				Resources: []string{
 					"images/details",
+					"images/verify",
 				},
 			},
 			{
@@ -105,9 +122,12 @@ var (
 				Verbs: []string{"create"},
 				Resources: []string{
 					"images/tag",
+					"images/sign",
+					"images/prune",
 					"apps/confirmupgrade",
 					"apps/pullimage",
 					"apps/ignorecleanup",
+					"secrets/rollback",
 					"events",
 				},
 			},
@@ -125,8 +145,11 @@ var (
 					"images/push",
 					"images/pull",
 					"images/copy",
+					"images/save",
+					"images/load",
 					"containerreplicas/exec",
 					"secrets/reveal",
+					"secrets/history",
 				},
 			},
 		},
@@ -141,6 +164,7 @@ var (
 			{
 				Verbs: []string{"get"},
 				Resources: []string{
+					"acornimagebuilds/logs",
 					"builders/port",
 				},
 			},
@@ -160,6 +184,9 @@ var (
 				Verbs: []string{"*"},
 				Resources: []string{
 					"imageallowrules",
+					"secretshares",
+					"secretimports",
+					"serviceexports",
 				},
 			},
 		},
@@ -5,6 +5,8 @@ import (
 	acornapiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	acornv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	acornadminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/gatewayapi"
+	"github.com/acorn-io/runtime/pkg/traefik"
 	"github.com/rancher/wrangler/pkg/merr"
 	"github.com/rancher/wrangler/pkg/schemes"
 	appsv1 "k8s.io/api/apps/v1"
@@ -53,6 +55,8 @@ func AddToScheme(scheme *runtime.Scheme) error {
 	errs = append(errs, discoveryv1.AddToScheme(scheme))
 	errs = append(errs, schedulingv1.AddToScheme(scheme))
 	errs = append(errs, coordinationv1.AddToScheme(scheme))
+	errs = append(errs, gatewayapi.AddToScheme(scheme))
+	errs = append(errs, traefik.AddToScheme(scheme))
 	return merr.NewErrors(errs...)
 }
 
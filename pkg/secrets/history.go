@@ -0,0 +1,215 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	HistoryReasonUpdate   = "update"
+	HistoryReasonRollback = "rollback"
+
+	historyIndexKey = "index"
+)
+
+// HistorySecretName returns the name of the secret that holds the previous versions of the named secret.
+func HistorySecretName(secretName string) string {
+	return name.SafeHashConcatName(secretName, "history")
+}
+
+// GetHistory returns the recorded versions of the secret, newest first. A secret that has never
+// been changed has no history and an empty result is returned.
+func GetHistory(ctx context.Context, c kclient.Reader, secret *corev1.Secret) ([]apiv1.SecretVersion, error) {
+	history, err := getHistorySecret(ctx, c, secret)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return historyIndex(history)
+}
+
+// GetHistoryVersionData returns the decrypted data of the given version of the secret.
+func GetHistoryVersionData(ctx context.Context, c kclient.Reader, secret *corev1.Secret, version int) (map[string][]byte, error) {
+	history, err := getHistorySecret(ctx, c, secret)
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("secret %s has no previous versions", secret.Name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	encrypted, ok := history.Data[strconv.Itoa(version)]
+	if !ok {
+		return nil, fmt.Errorf("version %d of secret %s not found", version, secret.Name)
+	}
+
+	decrypted, err := nacl.DecryptNamespacedData(ctx, c, encrypted, secret.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string][]byte{}
+	return data, json.Unmarshal(decrypted, &data)
+}
+
+// RecordHistory stores the current data of the secret as a new version in its history. The data is
+// encrypted with the primary key of the secret's namespace. Only the newest limit versions are kept,
+// a limit less than one disables the history and removes any existing history.
+func RecordHistory(ctx context.Context, c kclient.Client, secret *corev1.Secret, actor, reason string, limit int) (*apiv1.SecretVersion, error) {
+	history := &corev1.Secret{}
+	err := c.Get(ctx, router.Key(secret.Namespace, HistorySecretName(secret.Name)), history)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if err == nil && (limit < 1 || !isOwnedBy(history, secret)) {
+		// Either the history is disabled or the existing history belongs to a previous secret with the same name
+		// that was deleted, but its history has not been garbage collected yet.
+		if err := c.Delete(ctx, history); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		err = apierrors.NewNotFound(corev1.Resource("secrets"), history.Name)
+	}
+
+	if limit < 1 {
+		return nil, nil
+	}
+
+	if apierrors.IsNotFound(err) {
+		history = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      HistorySecretName(secret.Name),
+				Namespace: secret.Namespace,
+				Labels: map[string]string{
+					labels.AcornManaged: "true",
+				},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion:         "v1",
+					Kind:               "Secret",
+					Name:               secret.Name,
+					UID:                secret.UID,
+					BlockOwnerDeletion: z.Pointer(false),
+				}},
+			},
+			Type: apiv1.SecretTypeHistory,
+		}
+	}
+
+	versions, err := historyIndex(history)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := nacl.GetOrCreatePrimaryNaclKey(ctx, c, secret.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := json.Marshal(secret.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	encData, err := nacl.Encrypt(string(plain), nacl.KeyBytesToB64String(key.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := encData.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	version := apiv1.SecretVersion{
+		Version: 1,
+		Created: metav1.Now(),
+		Actor:   actor,
+		Reason:  reason,
+		Keys:    sortedKeys(secret.Data),
+	}
+	if len(versions) > 0 {
+		version.Version = versions[0].Version + 1
+	}
+	versions = append([]apiv1.SecretVersion{version}, versions...)
+
+	if history.Data == nil {
+		history.Data = map[string][]byte{}
+	}
+	history.Data[strconv.Itoa(version.Version)] = []byte(encrypted)
+
+	if len(versions) > limit {
+		for _, expired := range versions[limit:] {
+			delete(history.Data, strconv.Itoa(expired.Version))
+		}
+		versions = versions[:limit]
+	}
+
+	history.Data[historyIndexKey], err = json.Marshal(versions)
+	if err != nil {
+		return nil, err
+	}
+
+	if history.ResourceVersion == "" {
+		return &version, c.Create(ctx, history)
+	}
+	return &version, c.Update(ctx, history)
+}
+
+func getHistorySecret(ctx context.Context, c kclient.Reader, secret *corev1.Secret) (*corev1.Secret, error) {
+	history := &corev1.Secret{}
+	if err := c.Get(ctx, router.Key(secret.Namespace, HistorySecretName(secret.Name)), history); err != nil {
+		return nil, err
+	}
+	if !isOwnedBy(history, secret) {
+		// The history belongs to a previous secret with the same name that has been deleted but
+		// not garbage collected yet.
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), history.Name)
+	}
+	return history, nil
+}
+
+func isOwnedBy(history, secret *corev1.Secret) bool {
+	for _, owner := range history.OwnerReferences {
+		if owner.UID == secret.UID {
+			return true
+		}
+	}
+	return false
+}
+
+func historyIndex(history *corev1.Secret) (result []apiv1.SecretVersion, _ error) {
+	index, ok := history.Data[historyIndexKey]
+	if !ok {
+		return nil, nil
+	}
+	if err := json.Unmarshal(index, &result); err != nil {
+		return nil, fmt.Errorf("invalid history for secret %s: %w", history.Name, err)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version > result[j].Version
+	})
+	return result, nil
+}
+
+func sortedKeys(data map[string][]byte) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,135 @@
+package secrets
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func historyTestSecret(uid string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "creds",
+			Namespace: "acorn",
+			UID:       types.UID(uid),
+		},
+		Data: map[string][]byte{
+			"password": []byte("v1"),
+		},
+	}
+}
+
+func TestRecordHistory(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "acorn",
+			UID:  "namespace-uid-1234",
+		},
+	}).Build()
+	secret := historyTestSecret("secret-uid")
+
+	// A secret that was never changed has no history
+	versions, err := GetHistory(ctx, c, secret)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+	_, err = GetHistoryVersionData(ctx, c, secret, 1)
+	assert.EqualError(t, err, "secret creds has no previous versions")
+
+	for i := 1; i <= 4; i++ {
+		secret.Data["password"] = []byte("v" + strconv.Itoa(i))
+		version, err := RecordHistory(ctx, c, secret, "user", HistoryReasonUpdate, 3)
+		require.NoError(t, err)
+		assert.Equal(t, i, version.Version)
+		assert.Equal(t, []string{"password"}, version.Keys)
+	}
+
+	// Only the newest three versions are kept
+	versions, err = GetHistory(ctx, c, secret)
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	for i, version := range versions {
+		assert.Equal(t, 4-i, version.Version)
+		assert.Equal(t, "user", version.Actor)
+		assert.Equal(t, HistoryReasonUpdate, version.Reason)
+	}
+
+	history := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, router.Key("acorn", HistorySecretName("creds")), history))
+	assert.NotContains(t, history.Data, "1")
+	assert.NotContains(t, string(history.Data["4"]), "v4", "expected the data to be encrypted")
+
+	_, err = GetHistoryVersionData(ctx, c, secret, 1)
+	assert.EqualError(t, err, "version 1 of secret creds not found")
+
+	data, err := GetHistoryVersionData(ctx, c, secret, 2)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("v2")}, data)
+
+	// The history is encrypted with the primary key of the namespace
+	decrypted, err := nacl.DecryptNamespacedData(ctx, c, history.Data["4"], "acorn")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"password":"djQ="}`, string(decrypted))
+}
+
+// TestRecordHistoryRecreatedSecret tests that the history of a deleted secret is not shown for a new secret with the
+// same name, and is replaced once the new secret is changed
+func TestRecordHistoryRecreatedSecret(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "acorn",
+			UID:  "namespace-uid-1234",
+		},
+	}).Build()
+
+	_, err := RecordHistory(ctx, c, historyTestSecret("old-uid"), "user", HistoryReasonUpdate, 10)
+	require.NoError(t, err)
+
+	secret := historyTestSecret("new-uid")
+	versions, err := GetHistory(ctx, c, secret)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+
+	version, err := RecordHistory(ctx, c, secret, "user", HistoryReasonRollback, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version.Version)
+
+	versions, err = GetHistory(ctx, c, secret)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, HistoryReasonRollback, versions[0].Reason)
+}
+
+// TestRecordHistoryDisabled tests that a limit less than one removes the existing history
+func TestRecordHistoryDisabled(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "acorn",
+			UID:  "namespace-uid-1234",
+		},
+	}).Build()
+	secret := historyTestSecret("secret-uid")
+
+	_, err := RecordHistory(ctx, c, secret, "user", HistoryReasonUpdate, 10)
+	require.NoError(t, err)
+
+	version, err := RecordHistory(ctx, c, secret, "user", HistoryReasonUpdate, 0)
+	require.NoError(t, err)
+	assert.Nil(t, version)
+
+	versions, err := GetHistory(ctx, c, secret)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+}
@@ -0,0 +1,185 @@
+package secrets
+
+import (
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/rancher/wrangler/pkg/data/convert"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	PreviewActionCreate     = "create"
+	PreviewActionRegenerate = "regenerate"
+	PreviewActionRebind     = "rebind"
+	PreviewActionRemove     = "remove"
+	PreviewActionUnchanged  = "unchanged"
+)
+
+// PreviewChange describes what would happen to a secret of an app if the app was updated. It never
+// contains any secret values.
+type PreviewChange struct {
+	// Name is the name of the secret in the Acornfile.
+	Name string `json:"name"`
+
+	// Type is the type of the secret in the Acornfile.
+	Type string `json:"type,omitempty"`
+
+	// Action is one of create, regenerate, rebind, remove or unchanged.
+	Action string `json:"action"`
+
+	// BoundTo is the secret that is bound to this secret after the update, if any.
+	BoundTo string `json:"boundTo,omitempty"`
+
+	// Restarts are the containers and jobs that would be restarted because they reference the
+	// secret with onChange set to redeploy.
+	Restarts []string `json:"restarts,omitempty"`
+}
+
+// Preview compares the secrets of an app spec and its secret bindings before and after an update and returns
+// the change to each secret, sorted by name. The comparison is based on the definitions of the secrets only,
+// so values that are generated by jobs are reported as regenerated whenever the job definition changes.
+func Preview(oldSpec, newSpec *v1.AppSpec, oldBindings, newBindings []v1.SecretBinding) []PreviewChange {
+	var (
+		names   = sets.New[string]()
+		changes = map[string]*PreviewChange{}
+	)
+
+	names.Insert(typed.SortedKeys(oldSpec.Secrets)...)
+	names.Insert(typed.SortedKeys(newSpec.Secrets)...)
+
+	for _, name := range sets.List(names) {
+		oldDef, inOld := oldSpec.Secrets[name]
+		newDef, inNew := newSpec.Secrets[name]
+
+		change := &PreviewChange{
+			Name:    name,
+			Type:    newDef.Type,
+			Action:  PreviewActionUnchanged,
+			BoundTo: secretSource(name, newDef, newBindings),
+		}
+		oldSource := secretSource(name, oldDef, oldBindings)
+
+		switch {
+		case !inNew:
+			change.Type = oldDef.Type
+			change.Action = PreviewActionRemove
+		case !inOld:
+			change.Action = PreviewActionCreate
+		case oldSource != change.BoundTo:
+			change.Action = PreviewActionRebind
+		case change.BoundTo != "":
+			// Bound secrets are not managed by the app, they are unchanged as long as the binding is
+		case secretDefinitionChanged(oldSpec, newSpec, oldDef, newDef):
+			change.Action = PreviewActionRegenerate
+		}
+
+		changes[name] = change
+	}
+
+	// Templates are rendered from other secrets, so they are regenerated when a secret they reference changes
+	for changed := true; changed; {
+		changed = false
+		for _, change := range changes {
+			if change.Action != PreviewActionUnchanged || change.Type != "template" {
+				continue
+			}
+			for _, ref := range templateReferences(newSpec.Secrets[change.Name]) {
+				if dep, ok := changes[ref]; ok && dep.Action != PreviewActionUnchanged && dep.Action != PreviewActionCreate {
+					change.Action = PreviewActionRegenerate
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	result := make([]PreviewChange, 0, len(changes))
+	for _, name := range sets.List(names) {
+		change := changes[name]
+		if change.Action == PreviewActionRegenerate || change.Action == PreviewActionRebind {
+			change.Restarts = redeployedOnChange(newSpec, name)
+		}
+		result = append(result, *change)
+	}
+
+	return result
+}
+
+// secretSource returns the secret outside the app that provides the values of the named secret, if any.
+func secretSource(name string, secretDef v1.Secret, bindings []v1.SecretBinding) string {
+	for _, binding := range bindings {
+		if binding.Target == name {
+			return binding.Secret
+		}
+	}
+	if secretDef.External != "" {
+		return secretDef.External
+	}
+	return secretDef.Alias
+}
+
+func secretDefinitionChanged(oldSpec, newSpec *v1.AppSpec, oldDef, newDef v1.Secret) bool {
+	if oldDef.Type != newDef.Type || !equality.Semantic.DeepEqual(oldDef.Data, newDef.Data) {
+		return true
+	}
+
+	switch newDef.Type {
+	case "basic", "token":
+		// Existing values are kept for these types unless new values are explicitly set in data
+		return false
+	case "generated":
+		job := convert.ToString(newDef.Params["job"])
+		if !equality.Semantic.DeepEqual(oldSpec.Jobs[job], newSpec.Jobs[job]) {
+			return true
+		}
+	}
+
+	return !equality.Semantic.DeepEqual(oldDef.Params, newDef.Params)
+}
+
+func templateReferences(secretDef v1.Secret) (result []string) {
+	for _, value := range secretDef.Data {
+		for _, groups := range templateSecretRegexp.FindAllStringSubmatch(value, -1) {
+			result = append(result, groups[1])
+		}
+	}
+	return result
+}
+
+// redeployedOnChange returns the containers and jobs that reference the secret with onChange set to redeploy.
+func redeployedOnChange(appSpec *v1.AppSpec, secretName string) []string {
+	result := sets.New[string]()
+	check := func(name string, container v1.Container) {
+		for _, env := range container.Environment {
+			if env.Secret.Name == secretName && env.Secret.OnChange == v1.ChangeTypeRedeploy {
+				result.Insert(name)
+			}
+		}
+		for _, file := range container.Files {
+			if file.Secret.Name == secretName && file.Secret.OnChange == v1.ChangeTypeRedeploy {
+				result.Insert(name)
+			}
+		}
+		for _, dir := range container.Dirs {
+			if dir.Secret.Name == secretName && dir.Secret.OnChange == v1.ChangeTypeRedeploy {
+				result.Insert(name)
+			}
+		}
+	}
+
+	for _, workloads := range []map[string]v1.Container{appSpec.Containers, appSpec.Jobs} {
+		for name, container := range workloads {
+			check(name, container)
+			for _, sidecar := range container.Sidecars {
+				// Sidecars are restarted along with the container they belong to
+				check(name, sidecar)
+			}
+		}
+	}
+
+	if result.Len() == 0 {
+		return nil
+	}
+	return sets.List(result)
+}
@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreview(t *testing.T) {
+	oldSpec := &v1.AppSpec{
+		Containers: map[string]v1.Container{
+			"web": {
+				Environment: v1.EnvVars{
+					{Name: "PASS", Secret: v1.SecretReference{Name: "creds", Key: "password", OnChange: v1.ChangeTypeRedeploy}},
+					{Name: "CONF", Secret: v1.SecretReference{Name: "config", Key: "template", OnChange: v1.ChangeTypeNoAction}},
+				},
+				Files: v1.Files{
+					"/token": {Secret: v1.SecretReference{Name: "token", Key: "token", OnChange: v1.ChangeTypeRedeploy}},
+				},
+				Sidecars: map[string]v1.Container{},
+			},
+		},
+		Secrets: map[string]v1.Secret{
+			"creds":   {Type: "basic"},
+			"token":   {Type: "token", Params: v1.GenericMap{"length": 8}},
+			"opaque":  {Type: "opaque", Data: map[string]string{"key": "value"}},
+			"config":  {Type: "template", Data: map[string]string{"template": "${secret://opaque/key}"}},
+			"removed": {Type: "opaque"},
+			"bound":   {Type: "opaque"},
+		},
+	}
+
+	newSpec := oldSpec.DeepCopy()
+	delete(newSpec.Secrets, "removed")
+	newSpec.Secrets["added"] = v1.Secret{Type: "opaque"}
+	// Changing the params of a token doesn't regenerate an existing token
+	newSpec.Secrets["token"] = v1.Secret{Type: "token", Params: v1.GenericMap{"length": 16}}
+	newSpec.Secrets["opaque"] = v1.Secret{Type: "opaque", Data: map[string]string{"key": "changed"}}
+	newSpec.Containers["web"].Sidecars["side"] = v1.Container{
+		Environment: v1.EnvVars{
+			{Name: "OPAQUE", Secret: v1.SecretReference{Name: "opaque", Key: "key", OnChange: v1.ChangeTypeRedeploy}},
+		},
+	}
+
+	changes := Preview(oldSpec, newSpec, []v1.SecretBinding{
+		{Secret: "existing", Target: "bound"},
+	}, []v1.SecretBinding{
+		{Secret: "existing", Target: "bound"},
+		{Secret: "other", Target: "creds"},
+	})
+
+	assert.Equal(t, []PreviewChange{
+		{Name: "added", Type: "opaque", Action: PreviewActionCreate},
+		{Name: "bound", Type: "opaque", Action: PreviewActionUnchanged, BoundTo: "existing"},
+		{Name: "config", Type: "template", Action: PreviewActionRegenerate},
+		{Name: "creds", Type: "basic", Action: PreviewActionRebind, BoundTo: "other", Restarts: []string{"web"}},
+		{Name: "opaque", Type: "opaque", Action: PreviewActionRegenerate, Restarts: []string{"web"}},
+		{Name: "removed", Type: "opaque", Action: PreviewActionRemove},
+		{Name: "token", Type: "token", Action: PreviewActionUnchanged},
+	}, changes)
+}
+
+// TestPreviewNewBoundSecret tests that a secret that is bound when it is added to the app is created, not rebound
+func TestPreviewNewBoundSecret(t *testing.T) {
+	newSpec := &v1.AppSpec{
+		Containers: map[string]v1.Container{
+			"web": {
+				Environment: v1.EnvVars{
+					{Name: "PASS", Secret: v1.SecretReference{Name: "creds", Key: "password", OnChange: v1.ChangeTypeRedeploy}},
+				},
+			},
+		},
+		Secrets: map[string]v1.Secret{
+			"creds": {Type: "basic"},
+		},
+	}
+
+	changes := Preview(&v1.AppSpec{}, newSpec, nil, []v1.SecretBinding{
+		{Secret: "existing", Target: "creds"},
+	})
+
+	assert.Equal(t, []PreviewChange{
+		{Name: "creds", Type: "basic", Action: PreviewActionCreate, BoundTo: "existing"},
+	}, changes)
+}
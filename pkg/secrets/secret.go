@@ -450,3 +450,9 @@ func generate(characters string, tokenLength int) (string, error) {
 	}
 	return string(token), nil
 }
+
+// IsAcornSecret returns true if the secret has one of the types of Acorn secrets. Only these secrets are exposed by
+// the secrets API, the other secrets of a project, such as pull secrets and TLS secrets, are internal.
+func IsAcornSecret(secret *corev1.Secret) bool {
+	return strings.HasPrefix(string(secret.Type), v1.SecretTypePrefix)
+}
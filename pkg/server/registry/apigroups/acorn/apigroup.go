@@ -20,7 +20,10 @@ import (
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/info"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/projects"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/regions"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/secretimports"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/secrets"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/secretshares"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/serviceexports"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/class"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/admin/computeclass"
@@ -54,17 +57,19 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 
 	containersStorage := containers.NewStorage(c)
 
-	containerExec, err := containers.NewContainerExec(c, cfg)
+	recorder := event.NewRecorder(c)
+
+	containerExec, err := containers.NewContainerExec(c, cfg, recorder)
 	if err != nil {
 		return nil, err
 	}
 
-	portForward, err := containers.NewPortForward(c, cfg)
+	portForward, err := containers.NewPortForward(c, cfg, recorder)
 	if err != nil {
 		return nil, err
 	}
 
-	appsStorage := apps.NewStorage(c, clientFactory, event.NewRecorder(c))
+	appsStorage := apps.NewStorage(c, clientFactory, recorder)
 
 	logsStorage, err := apps.NewLogs(c, cfg)
 	if err != nil {
@@ -75,6 +80,7 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 
 	stores := map[string]rest.Storage{
 		"acornimagebuilds":              buildsStorage,
+		"acornimagebuilds/logs":         builds.NewLogs(c),
 		"apps":                          appsStorage,
 		"apps/log":                      logsStorage,
 		"apps/confirmupgrade":           apps.NewConfirmUpgrade(c),
@@ -89,15 +95,28 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		"images/pull":                   images.NewImagePull(c, clientFactory, transport),
 		"images/details":                images.NewImageDetails(c, transport),
 		"images/copy":                   images.NewImageCopy(c, transport),
+		"images/save":                   images.NewImageSave(c, transport),
+		"images/load":                   images.NewImageLoad(c, clientFactory, transport),
+		"images/sign":                   images.NewImageSign(c, transport),
+		"images/verify":                 images.NewImageVerify(c, transport),
+		"images/prune":                  images.NewImagePrune(c, transport, recorder),
 		"projects":                      projects.NewStorage(c, true),
+		"projects/keys":                 projects.NewKeys(c, transport),
+		"projects/rotatekey":            projects.NewRotateKey(c),
+		"projects/retirekey":            projects.NewRetireKey(c, transport),
 		"volumes":                       volumesStorage,
 		"volumeclasses":                 class.NewClassStorage(c),
 		"containerreplicas":             containersStorage,
 		"containerreplicas/exec":        containerExec,
 		"containerreplicas/portforward": portForward,
 		"credentials":                   credentials.NewStore(c),
-		"secrets":                       secrets.NewStorage(c),
-		"secrets/reveal":                secrets.NewReveal(c),
+		"secrets":                       secrets.NewStorage(c, recorder),
+		"secrets/reveal":                secrets.NewReveal(c, recorder),
+		"secrets/history":               secrets.NewHistory(c),
+		"secrets/rollback":              secrets.NewRollback(c, recorder),
+		"secretshares":                  secretshares.NewStorage(c),
+		"secretimports":                 secretimports.NewStorage(c),
+		"serviceexports":                serviceexports.NewStorage(c),
 		"infos":                         info.NewStorage(c),
 		"computeclasses":                computeclass.NewAggregateStorage(c),
 		"regions":                       regions.NewStorage(c),
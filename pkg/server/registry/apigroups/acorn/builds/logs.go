@@ -0,0 +1,50 @@
+package builds
+
+import (
+	"context"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/buildserver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewLogs(c kclient.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AcornImageBuildLogs{}).
+		WithGet(&LogsStrategy{
+			client: c,
+		}).Build()
+}
+
+type LogsStrategy struct {
+	client kclient.Client
+}
+
+func (s *LogsStrategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	build := &v1.AcornImageBuildInstance{}
+	if err := s.client.Get(ctx, router.Key(namespace, name), build); err != nil {
+		return nil, err
+	}
+
+	logs, err := buildserver.GetBuildLogs(ctx, s.client, build)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.AcornImageBuildLogs{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Logs: logs,
+	}, nil
+}
+
+func (s *LogsStrategy) New() types.Object {
+	return &apiv1.AcornImageBuildLogs{}
+}
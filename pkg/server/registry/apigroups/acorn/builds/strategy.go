@@ -7,11 +7,14 @@ import (
 	"github.com/acorn-io/mink/pkg/strategy"
 	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/buildserver"
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -46,6 +49,15 @@ func (s *Strategy) Create(ctx context.Context, object types.Object) (types.Objec
 		return nil, err
 	}
 
+	if err := s.defaultBuildCache(ctx, acornBuild); err != nil {
+		return nil, err
+	}
+
+	acornBuild.Spec.Actor = ""
+	if user, ok := request.UserFrom(ctx); ok {
+		acornBuild.Spec.Actor = user.GetName()
+	}
+
 	pushRepo, err := imagesystem.GetBuildPushRepoForNamespace(ctx, s.client, acornBuild.Namespace)
 	if err != nil {
 		return nil, err
@@ -74,6 +86,26 @@ func (s *Strategy) Create(ctx context.Context, object types.Object) (types.Objec
 	return acornBuild, nil
 }
 
+// defaultBuildCache sets the build cache of the project for builds that don't set their own
+func (s *Strategy) defaultBuildCache(ctx context.Context, acornBuild *apiv1.AcornImageBuild) error {
+	if len(acornBuild.Spec.CacheFrom) > 0 || len(acornBuild.Spec.CacheTo) > 0 {
+		return nil
+	}
+
+	project := &v1.ProjectInstance{}
+	if err := s.client.Get(ctx, router.Key("", acornBuild.Namespace), project); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if project.Spec.BuildCache != "" {
+		acornBuild.Spec.CacheFrom = []string{project.Spec.BuildCache}
+		acornBuild.Spec.CacheTo = []string{project.Spec.BuildCache}
+	}
+	return nil
+}
+
 func (s *Strategy) New() types.Object {
 	return s.creator.New()
 }
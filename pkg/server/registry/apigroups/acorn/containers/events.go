@@ -0,0 +1,106 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ContainerReplicaExecEventType        = "ContainerReplicaExec"
+	ContainerReplicaPortForwardEventType = "ContainerReplicaPortForward"
+)
+
+// ContainerReplicaExecEventDetails captures additional info about an exec into a ContainerReplica.
+type ContainerReplicaExecEventDetails struct {
+	// Container is the name of the container the command was executed in.
+	Container string `json:"container"`
+
+	// Command is the command that was executed.
+	Command []string `json:"command"`
+
+	// TTY is true if a TTY was allocated for the command.
+	TTY bool `json:"tty,omitempty"`
+
+	// DebugImage is the image of the ephemeral container the command was executed in, if any.
+	DebugImage string `json:"debugImage,omitempty"`
+
+	// Error is the reason the connection to the container failed, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// ContainerReplicaPortForwardEventDetails captures additional info about a port-forward to a ContainerReplica.
+type ContainerReplicaPortForwardEventDetails struct {
+	// Port is the forwarded port of the ContainerReplica.
+	Port int `json:"port"`
+
+	// Error is the reason the connection to the container failed, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// auditedProxy returns a copy of the proxy that calls record with the outcome of the connection to the upstream,
+// once the upstream accepted the upgrade of the connection or the connection failed.
+func auditedProxy(proxy *httputil.ReverseProxy, record func(error)) *httputil.ReverseProxy {
+	var once sync.Once
+	p := &httputil.ReverseProxy{
+		FlushInterval: proxy.FlushInterval,
+		Transport:     proxy.Transport,
+		Director:      proxy.Director,
+	}
+	p.ModifyResponse = func(resp *http.Response) error {
+		once.Do(func() {
+			if resp.StatusCode == http.StatusSwitchingProtocols {
+				record(nil)
+			} else {
+				record(fmt.Errorf("connection rejected: %s", resp.Status))
+			}
+		})
+		return nil
+	}
+	p.ErrorHandler = func(rw http.ResponseWriter, _ *http.Request, err error) {
+		once.Do(func() {
+			record(err)
+		})
+		logrus.Debugf("Failed to proxy connection: %v", err)
+		rw.WriteHeader(http.StatusBadGateway)
+	}
+	return p
+}
+
+// recordAuditEvent records an audit event for the container, as an error if the connection to the container failed.
+func recordAuditEvent(ctx context.Context, recorder event.Recorder, container *apiv1.ContainerReplica, eventType, description string, details any, connErr error) {
+	severity := v1.EventSeverityInfo
+	if connErr != nil {
+		severity = v1.EventSeverityError
+	}
+
+	mapped, err := v1.Mapify(details)
+	if err != nil {
+		logrus.Warnf("Failed to generate event details, event recording disabled for request: %v", err)
+		return
+	}
+
+	if err := recorder.Record(ctx, &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: container.Namespace,
+			Labels:    event.AuditLabels(),
+		},
+		Type:        eventType,
+		Severity:    severity,
+		Details:     mapped,
+		Description: description,
+		AppName:     container.Spec.AppName,
+		Resource:    event.Resource(container),
+		Observed:    v1.NowMicro(),
+	}); err != nil {
+		logrus.Warnf("Failed to record event: %v", err)
+	}
+}
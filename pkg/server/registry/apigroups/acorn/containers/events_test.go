@@ -0,0 +1,240 @@
+package containers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type testRecorder struct {
+	lock   sync.Mutex
+	events []*apiv1.Event
+}
+
+func (r *testRecorder) Record(_ context.Context, e *apiv1.Event) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *testRecorder) recorded() []*apiv1.Event {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.events
+}
+
+// newUpstream returns a server standing in for the Kubernetes API, it accepts the upgrade of exec and port-forward
+// connections unless the command is "reject" or the port is 81.
+func newUpstream(t *testing.T) *httptest.Server {
+	t.Helper()
+	upstream := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("command") == "reject" || req.URL.Query().Get("ports") == "81" {
+			rw.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		conn, buf, err := rw.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		_, _ = buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: " + req.Header.Get("Upgrade") + "\r\n\r\n")
+		_ = buf.Flush()
+	}))
+	t.Cleanup(upstream.Close)
+	return upstream
+}
+
+// connect serves the handler and upgrades a connection to it, returning the status code of the response.
+func connect(t *testing.T, handler http.Handler) int {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "SPDY/3.1")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func testContainerReplica() *apiv1.ContainerReplica {
+	return &apiv1.ContainerReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app.web-1234:web",
+			Namespace: "acorn",
+			UID:       "container-uid",
+		},
+		Spec: apiv1.ContainerReplicaSpec{
+			AppName:       "app",
+			ContainerName: "web",
+		},
+		Status: apiv1.ContainerReplicaStatus{
+			PodName:      "web-1234",
+			PodNamespace: "app-namespace",
+		},
+	}
+}
+
+func TestContainerExecAuditEvent(t *testing.T) {
+	tests := []struct {
+		name                string
+		host                string
+		command             []string
+		expectedStatus      int
+		expectedSeverity    v1.EventSeverity
+		expectedDescription string
+		expectedError       string
+	}{
+		{
+			name:                "connected",
+			command:             []string{"ls"},
+			expectedStatus:      http.StatusSwitchingProtocols,
+			expectedSeverity:    v1.EventSeverityInfo,
+			expectedDescription: "Command executed in container acorn/app.web-1234:web",
+		},
+		{
+			name:                "rejected",
+			command:             []string{"reject"},
+			expectedStatus:      http.StatusForbidden,
+			expectedSeverity:    v1.EventSeverityError,
+			expectedDescription: "Failed to execute command in container acorn/app.web-1234:web",
+			expectedError:       "connection rejected: 403 Forbidden",
+		},
+		{
+			name:                "unreachable",
+			host:                "http://127.0.0.1:1",
+			command:             []string{"ls"},
+			expectedStatus:      http.StatusBadGateway,
+			expectedSeverity:    v1.EventSeverityError,
+			expectedDescription: "Failed to execute command in container acorn/app.web-1234:web",
+			expectedError:       "connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := tt.host
+			if host == "" {
+				host = newUpstream(t).URL
+			}
+
+			recorder := &testRecorder{}
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(testContainerReplica()).Build()
+			exec, err := NewContainerExec(c, &rest.Config{Host: host}, recorder)
+			require.NoError(t, err)
+
+			ctx := request.WithNamespace(context.Background(), "acorn")
+			handler, err := exec.Connect(ctx, "app.web-1234:web", &apiv1.ContainerReplicaExecOptions{
+				Command: tt.command,
+				TTY:     true,
+			}, nil)
+			require.NoError(t, err)
+			assert.Empty(t, recorder.recorded(), "expected no event before connecting")
+
+			assert.Equal(t, tt.expectedStatus, connect(t, handler))
+
+			events := recorder.recorded()
+			require.Len(t, events, 1)
+			e := events[0]
+			assert.Equal(t, ContainerReplicaExecEventType, e.Type)
+			assert.Equal(t, tt.expectedSeverity, e.Severity)
+			assert.Equal(t, tt.expectedDescription, e.Description)
+			assert.Equal(t, "acorn", e.Namespace)
+			assert.Equal(t, "app", e.AppName)
+			assert.Equal(t, event.AuditLabels(), e.Labels)
+			assert.Equal(t, "true", e.Labels[labels.AcornAuditEvent])
+			assert.Equal(t, "container", e.Resource.Kind)
+
+			details := ContainerReplicaExecEventDetails{}
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(e.Details, &details))
+			assert.Equal(t, "web", details.Container)
+			assert.Equal(t, tt.command, details.Command)
+			assert.True(t, details.TTY)
+			if tt.expectedError == "" {
+				assert.Empty(t, details.Error)
+			} else {
+				assert.Contains(t, details.Error, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestPortForwardAuditEvent(t *testing.T) {
+	tests := []struct {
+		name                string
+		port                int
+		expectedStatus      int
+		expectedSeverity    v1.EventSeverity
+		expectedDescription string
+		expectedError       string
+	}{
+		{
+			name:                "connected",
+			port:                80,
+			expectedStatus:      http.StatusSwitchingProtocols,
+			expectedSeverity:    v1.EventSeverityInfo,
+			expectedDescription: "Port 80 of container acorn/app.web-1234:web forwarded",
+		},
+		{
+			name:                "rejected",
+			port:                81,
+			expectedStatus:      http.StatusForbidden,
+			expectedSeverity:    v1.EventSeverityError,
+			expectedDescription: "Failed to forward port 81 of container acorn/app.web-1234:web",
+			expectedError:       "connection rejected: 403 Forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &testRecorder{}
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(testContainerReplica()).Build()
+			portForward, err := NewPortForward(c, &rest.Config{Host: newUpstream(t).URL}, recorder)
+			require.NoError(t, err)
+
+			ctx := request.WithNamespace(context.Background(), "acorn")
+			handler, err := portForward.Connect(ctx, "app.web-1234:web", &apiv1.ContainerReplicaPortForwardOptions{
+				Port: tt.port,
+			}, nil)
+			require.NoError(t, err)
+			assert.Empty(t, recorder.recorded(), "expected no event before connecting")
+
+			assert.Equal(t, tt.expectedStatus, connect(t, handler))
+
+			events := recorder.recorded()
+			require.Len(t, events, 1)
+			e := events[0]
+			assert.Equal(t, ContainerReplicaPortForwardEventType, e.Type)
+			assert.Equal(t, tt.expectedSeverity, e.Severity)
+			assert.Equal(t, tt.expectedDescription, e.Description)
+			assert.Equal(t, event.AuditLabels(), e.Labels)
+
+			details := ContainerReplicaPortForwardEventDetails{}
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(e.Details, &details))
+			assert.Equal(t, tt.port, details.Port)
+			assert.Equal(t, tt.expectedError, details.Error)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/watcher"
 	"github.com/acorn-io/mink/pkg/strategy"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/k8sclient"
 	"github.com/rancher/wrangler/pkg/name"
 	"github.com/rancher/wrangler/pkg/randomtoken"
@@ -41,9 +42,10 @@ type ContainerExec struct {
 	proxy      httputil.ReverseProxy
 	RESTClient rest.Interface
 	k8s        kubernetes.Interface
+	recorder   event.Recorder
 }
 
-func NewContainerExec(client kclient.WithWatch, cfg *rest.Config) (*ContainerExec, error) {
+func NewContainerExec(client kclient.WithWatch, cfg *rest.Config, recorder event.Recorder) (*ContainerExec, error) {
 	cfg = rest.CopyConfig(cfg)
 	restconfig.SetScheme(cfg, scheme.Scheme)
 
@@ -69,6 +71,7 @@ func NewContainerExec(client kclient.WithWatch, cfg *rest.Config) (*ContainerExe
 			Director:      func(request *http.Request) {},
 		},
 		RESTClient: k8s.CoreV1().RESTClient(),
+		recorder:   recorder,
 	}, nil
 }
 
@@ -76,7 +79,7 @@ func (c *ContainerExec) New() runtime.Object {
 	return &apiv1.ContainerReplicaExecOptions{}
 }
 
-func (c *ContainerExec) connect(podName, podNamespace, containerName string, execOpt *apiv1.ContainerReplicaExecOptions) (http.Handler, error) {
+func (c *ContainerExec) connect(podName, podNamespace, containerName string, execOpt *apiv1.ContainerReplicaExecOptions, record func(error)) (http.Handler, error) {
 	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 		req := c.RESTClient.Get().
 			Namespace(podNamespace).
@@ -92,7 +95,7 @@ func (c *ContainerExec) connect(podName, podNamespace, containerName string, exe
 				Command:   command(execOpt.Command),
 			}, scheme.ParameterCodec)
 		request.URL = req.URL()
-		c.proxy.ServeHTTP(writer, request)
+		auditedProxy(&c.proxy, record).ServeHTTP(writer, request)
 	}), nil
 }
 
@@ -115,11 +118,31 @@ func (c *ContainerExec) Connect(ctx context.Context, id string, options runtime.
 		containerName = container.Spec.SidecarName
 	}
 
+	// The command is audited once the outcome of the connection to the container is known
+	record := func(err error) {
+		details := ContainerReplicaExecEventDetails{
+			Container:  containerName,
+			Command:    command(execOpt.Command),
+			TTY:        execOpt.TTY,
+			DebugImage: execOpt.DebugImage,
+		}
+		description := fmt.Sprintf("Command executed in container %s/%s", ns, id)
+		if err != nil {
+			details.Error = err.Error()
+			description = fmt.Sprintf("Failed to execute command in container %s/%s", ns, id)
+		}
+		recordAuditEvent(ctx, c.recorder, container, ContainerReplicaExecEventType, description, details, err)
+	}
+
 	if execOpt.DebugImage != "" {
-		return c.execEphemeral(ctx, container, containerName, execOpt)
+		handler, err := c.execEphemeral(ctx, container, containerName, execOpt, record)
+		if err != nil {
+			record(err)
+		}
+		return handler, err
 	}
 
-	return c.connect(container.Status.PodName, container.Status.PodNamespace, containerName, execOpt)
+	return c.connect(container.Status.PodName, container.Status.PodNamespace, containerName, execOpt, record)
 }
 
 func (c *ContainerExec) NewConnectOptions() (runtime.Object, bool, string) {
@@ -137,7 +160,7 @@ func command(args []string) []string {
 	return args
 }
 
-func (c *ContainerExec) execEphemeral(ctx context.Context, container *apiv1.ContainerReplica, containerName string, execOpts *apiv1.ContainerReplicaExecOptions) (http.Handler, error) {
+func (c *ContainerExec) execEphemeral(ctx context.Context, container *apiv1.ContainerReplica, containerName string, execOpts *apiv1.ContainerReplicaExecOptions, record func(error)) (http.Handler, error) {
 	pods := c.k8s.CoreV1().Pods(container.Status.PodNamespace)
 	pod, err := pods.Get(ctx, container.Status.PodName, metav1.GetOptions{})
 	if err != nil {
@@ -209,5 +232,5 @@ func (c *ContainerExec) execEphemeral(ctx context.Context, container *apiv1.Cont
 		return nil, err
 	}
 
-	return c.connect(pod.Name, pod.Namespace, execName, execOpts)
+	return c.connect(pod.Name, pod.Namespace, execName, execOpts, record)
 }
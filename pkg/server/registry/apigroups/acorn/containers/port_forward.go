@@ -2,6 +2,7 @@ package containers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/restconfig"
 	"github.com/acorn-io/mink/pkg/strategy"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/k8sclient"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -26,9 +28,10 @@ type PortForward struct {
 	t          *Translator
 	proxy      httputil.ReverseProxy
 	RESTClient rest.Interface
+	recorder   event.Recorder
 }
 
-func NewPortForward(client kclient.WithWatch, cfg *rest.Config) (*PortForward, error) {
+func NewPortForward(client kclient.WithWatch, cfg *rest.Config, recorder event.Recorder) (*PortForward, error) {
 	cfg = rest.CopyConfig(cfg)
 	restconfig.SetScheme(cfg, scheme.Scheme)
 
@@ -53,6 +56,7 @@ func NewPortForward(client kclient.WithWatch, cfg *rest.Config) (*PortForward, e
 			Director:      func(request *http.Request) {},
 		},
 		RESTClient: k8s.CoreV1().RESTClient(),
+		recorder:   recorder,
 	}, nil
 }
 
@@ -60,7 +64,7 @@ func (c *PortForward) New() runtime.Object {
 	return &apiv1.ContainerReplicaExecOptions{}
 }
 
-func (c *PortForward) connect(podName, podNamespace string, execOpt *apiv1.ContainerReplicaPortForwardOptions) (http.Handler, error) {
+func (c *PortForward) connect(podName, podNamespace string, execOpt *apiv1.ContainerReplicaPortForwardOptions, record func(error)) (http.Handler, error) {
 	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 		req := c.RESTClient.Get().
 			Namespace(podNamespace).
@@ -71,7 +75,7 @@ func (c *PortForward) connect(podName, podNamespace string, execOpt *apiv1.Conta
 				Ports: []int32{int32(execOpt.Port)},
 			}, scheme.ParameterCodec)
 		request.URL = req.URL()
-		c.proxy.ServeHTTP(writer, request)
+		auditedProxy(&c.proxy, record).ServeHTTP(writer, request)
 	}), nil
 }
 
@@ -86,7 +90,20 @@ func (c *PortForward) Connect(ctx context.Context, id string, options runtime.Ob
 		return nil, err
 	}
 
-	return c.connect(container.Status.PodName, container.Status.PodNamespace, forwardOpts)
+	// The port-forward is audited once the outcome of the connection to the container is known
+	record := func(err error) {
+		details := ContainerReplicaPortForwardEventDetails{
+			Port: forwardOpts.Port,
+		}
+		description := fmt.Sprintf("Port %d of container %s/%s forwarded", forwardOpts.Port, ns, id)
+		if err != nil {
+			details.Error = err.Error()
+			description = fmt.Sprintf("Failed to forward port %d of container %s/%s", forwardOpts.Port, ns, id)
+		}
+		recordAuditEvent(ctx, c.recorder, container, ContainerReplicaPortForwardEventType, description, details, err)
+	}
+
+	return c.connect(container.Status.PodName, container.Status.PodNamespace, forwardOpts, record)
 }
 
 func (c *PortForward) NewConnectOptions() (runtime.Object, bool, string) {
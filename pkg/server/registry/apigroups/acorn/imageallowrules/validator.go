@@ -2,6 +2,7 @@ package imageallowrules
 
 import (
 	"context"
+	"path"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
@@ -13,10 +14,30 @@ type Validator struct{}
 
 func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
 	aiar := obj.(*apiv1.ImageAllowRule)
-	if len(aiar.Images) == 0 && len(aiar.Signatures.Rules) == 0 {
-		return append(result, field.Invalid(field.NewPath(""), aiar, "at least one of scope or signatures must be specified"))
+	if len(aiar.Images) == 0 && len(aiar.Signatures.Rules) == 0 && len(aiar.Attestations.Rules) == 0 {
+		return append(result, field.Invalid(field.NewPath(""), aiar, "at least one of scope, signatures or attestations must be specified"))
 	}
 	result = append(result, validateSignatureRules(ctx, aiar.Signatures)...)
+	result = append(result, validateAttestationRules(aiar.Attestations)...)
+	return
+}
+
+func validateAttestationRules(attRules internalv1.ImageAllowRuleAttestations) (result field.ErrorList) {
+	for i, rule := range attRules.Rules {
+		rulePath := field.NewPath("attestations").Child("rules").Index(i)
+		if len(rule.PredicateTypes) == 0 && rule.Provenance == nil && rule.SBOM == nil {
+			result = append(result, field.Invalid(rulePath, rule, "must not be empty (at least one of predicateTypes, provenance or sbom must be specified)"))
+			continue
+		}
+		if rule.SBOM != nil {
+			for j, pattern := range rule.SBOM.DenyPackages {
+				if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+					result = append(result, field.Invalid(rulePath.Child("sbom", "denyPackages").Index(j), pattern, "must be a valid glob pattern"))
+				}
+			}
+		}
+	}
+
 	return
 }
 
@@ -126,7 +126,7 @@ func (i *ImageCopy) Validate(ctx context.Context, args apiv1.ImageCopy, namespac
 
 func (i *ImageCopy) ImageCopy(ctx context.Context, namespace, sourceImage, destImage string, srcAuth, dstAuth *apiv1.RegistryAuth, force bool) (<-chan ImageProgress, error) {
 	// The source is allowed to be a local image, so we use getImageReference, which checks locally and remotely if it wasn't found.
-	sourceRef, err := i.getImageReference(ctx, sourceImage, namespace)
+	sourceRef, err := getImageReference(ctx, i.client, sourceImage, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +169,7 @@ func (i *ImageCopy) ImageCopy(ctx context.Context, namespace, sourceImage, destI
 	// progress gets closed by remote.WriteIndex, so this second channel is so that
 	// we can control closing the result channel in case we need to write an error.
 	progress2 := make(chan ImageProgress)
+	attestationOpts := destOpts
 	destOpts = append(destOpts, remote.WithProgress(progress))
 
 	go func() {
@@ -192,6 +193,8 @@ func (i *ImageCopy) ImageCopy(ctx context.Context, namespace, sourceImage, destI
 		// remote.WriteIndex will also close the currProgress channel on its own.
 		if err := remote.WriteIndex(destRef, sourceIndex, destOpts...); err != nil {
 			handleWriteIndexError(err, progress)
+		} else if digest, err := sourceIndex.Digest(); err == nil {
+			copyAttestations(sourceRef.Context().Digest(digest.String()), destRef.Context(), sourceOpts, attestationOpts)
 		}
 	}()
 
@@ -320,17 +323,17 @@ func errIfImageExistsAndIsDifferent(sourceIndex ggcrv1.ImageIndex, destRef name.
 
 // getImageReference returns a name.Reference for the given image.
 // It checks the internal registry first.
-func (i *ImageCopy) getImageReference(ctx context.Context, img, namespace string) (name.Reference, error) {
+func getImageReference(ctx context.Context, c kclient.Reader, img, namespace string) (name.Reference, error) {
 	safeName := strings.ReplaceAll(img, "/", "+")
 	image := &apiv1.Image{}
-	if err := i.client.Get(ctx, router.Key(namespace, safeName), image); err != nil {
+	if err := c.Get(ctx, router.Key(namespace, safeName), image); err != nil {
 		if apierrors.IsNotFound(err) {
 			return name.ParseReference(img, name.WithDefaultRegistry(images.NoDefaultRegistry))
 		}
 		return nil, err
 	}
 
-	repo, _, err := imagesystem.GetInternalRepoForNamespace(ctx, i.client, image.Namespace)
+	repo, _, err := imagesystem.GetInternalRepoForNamespace(ctx, c, image.Namespace)
 	if err != nil {
 		return nil, err
 	}
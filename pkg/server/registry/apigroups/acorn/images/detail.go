@@ -56,7 +56,13 @@ func (s *ImageDetailStrategy) Create(ctx context.Context, obj types.Object) (typ
 			opts = append(opts, remote.WithAuthFromKeychain(images.NewSimpleKeychain(ref.Context(), *details.Auth, nil)))
 		}
 	}
-	return imagedetails.GetImageDetails(ctx, s.client, ns, details.Name, details.Profiles, details.DeployArgs, details.NestedDigest, details.NoDefaultRegistry, opts...)
+	result, err := imagedetails.GetImageDetails(ctx, s.client, ns, details.Name, details.Profiles, details.DeployArgs, details.NestedDigest, details.NoDefaultRegistry, opts...)
+	if err != nil || !details.IncludeAttestations || result.AppImage.Digest == "" {
+		return result, err
+	}
+
+	result.Attestations, err = imagedetails.GetAttestations(ctx, s.client, result.Namespace, result.Name, result.AppImage.Digest, opts...)
+	return result, err
 }
 
 func (s *ImageDetailStrategy) New() types.Object {
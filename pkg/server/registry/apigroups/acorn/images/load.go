@@ -0,0 +1,246 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/acorn-io/mink/pkg/strategy"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/imagesystem"
+	"github.com/acorn-io/runtime/pkg/k8schannel"
+	"github.com/acorn-io/z"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewImageLoad(c kclient.WithWatch, clientFactory *client.Factory, transport http.RoundTripper) *ImageLoad {
+	return &ImageLoad{
+		client:        c,
+		clientFactory: clientFactory,
+		transportOpt:  remote.WithTransport(transport),
+	}
+}
+
+type ImageLoad struct {
+	*strategy.DestroyAdapter
+	client        kclient.WithWatch
+	clientFactory *client.Factory
+	transportOpt  remote.Option
+}
+
+func (i *ImageLoad) NamespaceScoped() bool {
+	return true
+}
+
+func (i *ImageLoad) New() runtime.Object {
+	return &apiv1.ImageLoad{}
+}
+
+func (i *ImageLoad) NewConnectOptions() (runtime.Object, bool, string) {
+	return &apiv1.ImageLoad{}, false, ""
+}
+
+func (i *ImageLoad) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// Connect receives an OCI image layout tarball in binary messages, ended by an empty message, and loads the image in it
+func (i *ImageLoad) Connect(ctx context.Context, _ string, _ runtime.Object, _ rest.Responder) (http.Handler, error) {
+	ns, _ := request.NamespaceFrom(ctx)
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := k8schannel.Upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			logrus.Errorf("Error during handshake for image load: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		k8schannel.AddCloseHandler(conn)
+
+		args := &apiv1.ImageLoad{}
+		if err := conn.ReadJSON(args); err != nil {
+			_ = conn.CloseHandler()(websocket.CloseUnsupportedData, err.Error())
+			return
+		}
+
+		cfg, err := config.Get(ctx, i.client)
+		if err != nil {
+			_ = conn.CloseHandler()(websocket.CloseInternalServerErr, err.Error())
+			return
+		}
+
+		dir, err := os.MkdirTemp("", "acorn-image-load-")
+		if err != nil {
+			_ = conn.CloseHandler()(websocket.CloseInternalServerErr, err.Error())
+			return
+		}
+		defer os.RemoveAll(dir)
+
+		index, savedName, err := images.ReadArchive(&binaryReader{conn: conn}, dir, z.Dereference(cfg.ImageLoadMaximumSize))
+		if err != nil {
+			_ = conn.CloseHandler()(websocket.CloseUnsupportedData, err.Error())
+			return
+		}
+
+		progress, err := i.ImageLoad(ctx, ns, index, savedName, *args)
+		if err != nil {
+			_ = conn.CloseHandler()(websocket.CloseInternalServerErr, err.Error())
+			return
+		}
+
+		for update := range progress {
+			p := ImageProgress{
+				Total:    update.Total,
+				Complete: update.Complete,
+			}
+			if update.Error != nil {
+				p.Error = update.Error.Error()
+			}
+			data, err := json.Marshal(p)
+			if err != nil {
+				panic("failed to marshal update: " + err.Error())
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				logrus.Errorf("Error writing load status: %v", err)
+				break
+			}
+		}
+		// the image is read from the extracted archive, so it can only be removed once the image is written
+		for range progress {
+		}
+
+		_ = conn.CloseHandler()(websocket.CloseNormalClosure, "")
+	}), nil
+}
+
+// ImageLoad writes the index to the target registry, or to the internal registry and records it as an image
+func (i *ImageLoad) ImageLoad(ctx context.Context, namespace string, index ggcrv1.ImageIndex, savedName string, args apiv1.ImageLoad) (<-chan ggcrv1.Update, error) {
+	hash, err := index.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		dest       name.Reference
+		opts       []remote.Option
+		recordRepo string
+		record     bool
+	)
+	if args.Target != "" {
+		dest, err = imagesystem.ParseAndEnsureNotInternalRepo(ctx, i.client, namespace, args.Target)
+		if err != nil {
+			return nil, err
+		}
+		opts, err = images.GetAuthenticationRemoteOptionsWithLocalAuth(ctx, dest.Context(), args.Auth, i.client, namespace, i.transportOpt)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		repo, externalRepo, err := imagesystem.GetInternalRepoForNamespace(ctx, i.client, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if externalRepo {
+			recordRepo = repo.String()
+		}
+		dest = repo.Digest(hash.String())
+		opts, err = images.GetAuthenticationRemoteOptions(ctx, i.client, namespace, i.transportOpt)
+		if err != nil {
+			return nil, err
+		}
+		record = true
+	}
+
+	tag := args.Tag
+	if tag == "" {
+		tag = savedName
+	}
+
+	progress := make(chan ggcrv1.Update)
+	// progress gets closed by remote.WriteIndex so this second channel is so that
+	// we can control closing the result channel in case we need to write an error
+	progress2 := make(chan ggcrv1.Update)
+	opts = append(opts, remote.WithProgress(progress))
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		for update := range progress {
+			progress2 <- update
+		}
+	}()
+
+	go func() {
+		defer func() {
+			wg.Wait()
+			close(progress2)
+		}()
+
+		// don't write error to chan because it already gets sent to the progress chan by remote.WriteIndex()
+		if err = remote.WriteIndex(dest, index, opts...); err == nil {
+			if !record {
+				return
+			}
+			if err := recordImage(ctx, i.client, i.clientFactory, hash, namespace, tag, recordRepo); err != nil {
+				progress2 <- ggcrv1.Update{
+					Error: err,
+				}
+			}
+		} else {
+			handleWriteIndexError(err, progress)
+		}
+	}()
+
+	return typed.Every(500*time.Millisecond, progress2), nil
+}
+
+// binaryReader reads the binary messages of the websocket until an empty message
+type binaryReader struct {
+	conn   *websocket.Conn
+	reader io.Reader
+	done   bool
+}
+
+func (b *binaryReader) Read(p []byte) (int, error) {
+	for !b.done {
+		if b.reader != nil {
+			n, err := b.reader.Read(p)
+			if n > 0 || err != io.EOF {
+				return n, err
+			}
+		}
+
+		messageType, data, err := b.conn.ReadMessage()
+		if err != nil {
+			return 0, err
+		}
+		if messageType != websocket.BinaryMessage {
+			continue
+		}
+		if len(data) == 0 {
+			b.done = true
+			break
+		}
+		b.reader = bytes.NewReader(data)
+	}
+	return 0, io.EOF
+}
@@ -0,0 +1,97 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewImagePrune(c client.WithWatch, transport http.RoundTripper, recorder event.Recorder) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImagePrune{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&ImagePruneStrategy{
+			client:    c,
+			remoteOpt: remote.WithTransport(transport),
+			recorder:  recorder,
+		}).Build()
+}
+
+type ImagePruneStrategy struct {
+	client    client.WithWatch
+	remoteOpt remote.Option
+	recorder  event.Recorder
+}
+
+func (s *ImagePruneStrategy) New() types.Object {
+	return &apiv1.ImagePrune{}
+}
+
+// Create prunes the untagged images, or all images, of the project that no app uses
+func (s *ImagePruneStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	prune := obj.(*apiv1.ImagePrune)
+	ns, _ := request.NamespaceFrom(ctx)
+
+	var olderThan time.Duration
+	if prune.OlderThan != "" {
+		var err error
+		olderThan, err = time.ParseDuration(prune.OlderThan)
+		if err != nil || olderThan < 0 {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid duration %q", prune.OlderThan))
+		}
+	}
+
+	opts := images.PruneOptions{
+		Untagged: &olderThan,
+		DryRun:   prune.DryRun,
+	}
+	if prune.All {
+		opts.Tagged = &olderThan
+	}
+
+	remoteOpts, err := images.GetAuthenticationRemoteOptions(ctx, s.client, ns, s.remoteOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := images.Prune(ctx, s.client, ns, opts, remoteOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if !prune.DryRun {
+		images.RecordPruneEvent(ctx, s.recorder, ns, nil, result)
+	}
+
+	resp := &apiv1.ImagePrune{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      prune.Name,
+			Namespace: ns,
+		},
+		All:            prune.All,
+		OlderThan:      prune.OlderThan,
+		DryRun:         prune.DryRun,
+		ReclaimedBytes: result.ReclaimedBytes,
+	}
+	for _, image := range result.Images {
+		resp.Images = append(resp.Images, apiv1.PrunedImage{
+			Name:   image.Name,
+			Digest: image.Digest,
+			Tags:   image.Tags,
+		})
+	}
+
+	return resp, nil
+}
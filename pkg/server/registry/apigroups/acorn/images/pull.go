@@ -165,7 +165,7 @@ func (i *ImagePull) ImagePull(ctx context.Context, namespace, imageName string,
 
 		// don't write error to chan because it already gets sent to the progress chan by remote.WriteIndex()
 		if err = remote.WriteIndex(repo.Digest(hash.Hex), index, opts...); err == nil {
-			if err := i.recordImage(ctx, hash, namespace, imageName, recordRepo); err != nil {
+			if err := recordImage(ctx, i.client, i.clientFactory, hash, namespace, imageName, recordRepo); err != nil {
 				progress2 <- ggcrv1.Update{
 					Error: err,
 				}
@@ -178,7 +178,8 @@ func (i *ImagePull) ImagePull(ctx context.Context, namespace, imageName string,
 	return typed.Every(500*time.Millisecond, progress2), nil
 }
 
-func (i *ImagePull) recordImage(ctx context.Context, hash ggcrv1.Hash, namespace, imageName, recordRepo string) error {
+// recordImage creates the image with the hash, which was written to the internal registry, and tags it with the name
+func recordImage(ctx context.Context, c kclient.Client, clientFactory *client.Factory, hash ggcrv1.Hash, namespace, imageName, recordRepo string) error {
 	img := &v1.ImageInstance{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      hash.Hex,
@@ -187,18 +188,22 @@ func (i *ImagePull) recordImage(ctx context.Context, hash ggcrv1.Hash, namespace
 		Repo:   recordRepo,
 		Digest: hash.String(),
 	}
-	if err := i.client.Create(ctx, img); apierror.IsAlreadyExists(err) {
-		if err := i.client.Get(ctx, router.Key(namespace, hash.Hex), img); err != nil {
+	if err := c.Create(ctx, img); apierror.IsAlreadyExists(err) {
+		if err := c.Get(ctx, router.Key(namespace, hash.Hex), img); err != nil {
 			return err
 		}
 		img.Repo = recordRepo
 		img.Remote = false
-		if err := i.client.Update(ctx, img); err != nil {
+		if err := c.Update(ctx, img); err != nil {
 			return err
 		}
 	} else if err != nil {
 		return err
 	}
 
-	return i.clientFactory.Namespace("", namespace).ImageTag(ctx, hash.Hex, imageName)
+	if imageName == "" {
+		return nil
+	}
+
+	return clientFactory.Namespace("", namespace).ImageTag(ctx, hash.Hex, imageName)
 }
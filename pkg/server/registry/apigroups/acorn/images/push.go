@@ -12,6 +12,7 @@ import (
 	"github.com/acorn-io/mink/pkg/strategy"
 	api "github.com/acorn-io/runtime/pkg/apis/api.acorn.io"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/attestation"
 	"github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/k8schannel"
@@ -163,14 +164,26 @@ func (i *ImagePush) ImagePush(ctx context.Context, image *apiv1.Image, tagName s
 	}
 
 	progress := make(chan ggcrv1.Update)
+	attestationOpts := opts
 	opts = append(opts, remote.WithProgress(progress))
 	go func() {
 		err := remote.WriteIndex(pushTag, remoteImage, opts...)
+		if err == nil {
+			copyAttestations(repo.Digest(image.Digest), pushTag.Context(), attestationOpts, attestationOpts)
+		}
 		handleWriteIndexError(err, progress)
 	}()
 	return image, typed.Every(500*time.Millisecond, progress), nil
 }
 
+// copyAttestations copies the SBOMs and provenance of an image along with it. The image itself was already copied, so
+// a failure is only logged.
+func copyAttestations(src name.Digest, dest name.Repository, srcOpts, destOpts []remote.Option) {
+	if err := attestation.Copy(src, dest, srcOpts, destOpts); err != nil {
+		logrus.Errorf("Failed to copy attestations of %s to %s: %v", src, dest, err)
+	}
+}
+
 func handleWriteIndexError(err error, progress chan ggcrv1.Update) {
 	if err == nil {
 		return
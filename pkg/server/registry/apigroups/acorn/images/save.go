@@ -0,0 +1,132 @@
+package images
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/mink/pkg/strategy"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/k8schannel"
+	"github.com/acorn-io/runtime/pkg/tags"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// archiveChunkSize is the size of the websocket messages image archives are sent in
+const archiveChunkSize = 1 << 20
+
+func NewImageSave(c kclient.WithWatch, transport http.RoundTripper) *ImageSave {
+	return &ImageSave{
+		client:       c,
+		transportOpt: remote.WithTransport(transport),
+	}
+}
+
+type ImageSave struct {
+	*strategy.DestroyAdapter
+	client       kclient.WithWatch
+	transportOpt remote.Option
+}
+
+func (i *ImageSave) NamespaceScoped() bool {
+	return true
+}
+
+func (i *ImageSave) New() runtime.Object {
+	return &apiv1.ImageSave{}
+}
+
+func (i *ImageSave) NewConnectOptions() (runtime.Object, bool, string) {
+	return &apiv1.ImageSave{}, false, ""
+}
+
+func (i *ImageSave) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// Connect sends the image as an OCI image layout tarball in binary messages
+func (i *ImageSave) Connect(ctx context.Context, id string, _ runtime.Object, _ rest.Responder) (http.Handler, error) {
+	imageName := strings.ReplaceAll(id, "+", "/")
+	ns, _ := request.NamespaceFrom(ctx)
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := k8schannel.Upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			logrus.Errorf("Error during handshake for image save: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		k8schannel.AddCloseHandler(conn)
+
+		args := &apiv1.ImageSave{}
+		if err := conn.ReadJSON(args); err != nil {
+			_ = conn.CloseHandler()(websocket.CloseUnsupportedData, err.Error())
+			return
+		}
+
+		index, err := i.ImageIndex(ctx, ns, imageName, args.Auth)
+		if err != nil {
+			_ = conn.CloseHandler()(websocket.CloseInternalServerErr, err.Error())
+			return
+		}
+
+		// Images referenced by ID are saved without a name
+		savedName := imageName
+		if tags.IsLocalReference(imageName) {
+			savedName = ""
+		}
+
+		w := bufio.NewWriterSize(binaryWriter{conn: conn}, archiveChunkSize)
+		if err := images.WriteArchive(w, index, savedName); err != nil {
+			_ = conn.CloseHandler()(websocket.CloseInternalServerErr, err.Error())
+			return
+		}
+		if err := w.Flush(); err != nil {
+			logrus.Errorf("Error writing image archive: %v", err)
+			return
+		}
+
+		_ = conn.CloseHandler()(websocket.CloseNormalClosure, "")
+	}), nil
+}
+
+// ImageIndex returns the index of the local or remote image
+func (i *ImageSave) ImageIndex(ctx context.Context, namespace, imageName string, auth *apiv1.RegistryAuth) (ggcrv1.ImageIndex, error) {
+	ref, err := getImageReference(ctx, i.client, imageName, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.Context().RegistryStr() == images.NoDefaultRegistry {
+		return nil, fmt.Errorf("image %s not found, remote images need a registry name (i.e. docker.io, ghcr.io)", imageName)
+	}
+
+	opts, err := images.GetAuthenticationRemoteOptionsWithLocalAuth(ctx, ref.Context(), auth, i.client, namespace, i.transportOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	return remote.Index(ref, opts...)
+}
+
+type binaryWriter struct {
+	conn *websocket.Conn
+}
+
+func (b binaryWriter) Write(p []byte) (int, error) {
+	if err := b.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,123 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/imagedetails"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewImageSign(c client.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImageSignature{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&ImageSignStrategy{
+			client:    c,
+			remoteOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type ImageSignStrategy struct {
+	client    client.WithWatch
+	remoteOpt remote.Option
+}
+
+func (s *ImageSignStrategy) New() types.Object {
+	return &apiv1.ImageSignature{}
+}
+
+// Create returns the payloads to sign for the image and the images nested in it if the request has no signatures,
+// otherwise it attaches the signatures to the images in the registry.
+func (s *ImageSignStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	sign := obj.(*apiv1.ImageSignature)
+	if sign.Name == "" {
+		ri, ok := request.RequestInfoFrom(ctx)
+		if ok {
+			sign.Name = ri.Name
+		}
+	}
+	ns, _ := request.NamespaceFrom(ctx)
+
+	detailsOpts := []remote.Option{s.remoteOpt}
+	if sign.Auth != nil {
+		ref, err := name.ParseReference(strings.ReplaceAll(sign.Name, "+", "/"))
+		if err == nil {
+			detailsOpts = append(detailsOpts, remote.WithAuthFromKeychain(images.NewSimpleKeychain(ref.Context(), *sign.Auth, nil)))
+		}
+	}
+
+	details, err := imagedetails.GetImageDetails(ctx, s.client, ns, sign.Name, nil, nil, "", false, detailsOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := images.GetImageReference(ctx, s.client, details.Namespace, details.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := images.GetAuthenticationRemoteOptionsWithLocalAuth(ctx, ref.Context(), sign.Auth, s.client, details.Namespace, s.remoteOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	digests, err := cosign.Digests(ref.Context().Digest(details.AppImage.Digest), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &apiv1.ImageSignature{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      strings.ReplaceAll(details.Name, "/", "+"),
+			Namespace: details.Namespace,
+		},
+		Annotations: sign.Annotations,
+	}
+
+	if len(sign.Signatures) == 0 {
+		for _, digest := range digests {
+			payload, err := cosign.NewPayload(digest, sign.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			result.Signatures = append(result.Signatures, apiv1.ImageDigestSignature{
+				Digest:  digest.DigestStr(),
+				Payload: payload,
+			})
+		}
+		return result, nil
+	}
+
+	byDigest := map[string]name.Digest{}
+	for _, digest := range digests {
+		byDigest[digest.DigestStr()] = digest
+	}
+
+	for _, sig := range sign.Signatures {
+		digest, ok := byDigest[sig.Digest]
+		if !ok {
+			return nil, fmt.Errorf("image %s does not contain an image with digest %s", details.Name, sig.Digest)
+		}
+		if err := cosign.AttachSignature(digest, sig.Payload, sig.Signature, opts...); err != nil {
+			return nil, fmt.Errorf("failed to attach signature to %s: %w", digest, err)
+		}
+		result.Signatures = append(result.Signatures, apiv1.ImageDigestSignature{
+			Digest: sig.Digest,
+		})
+	}
+
+	return result, nil
+}
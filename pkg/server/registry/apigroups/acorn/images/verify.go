@@ -0,0 +1,117 @@
+package images
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/imageallowrules"
+	"github.com/acorn-io/runtime/pkg/imagedetails"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ocosign "github.com/sigstore/cosign/v2/pkg/cosign"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewImageVerify(c client.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImageVerification{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&ImageVerifyStrategy{
+			client:    c,
+			remoteOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type ImageVerifyStrategy struct {
+	client    client.WithWatch
+	remoteOpt remote.Option
+}
+
+func (s *ImageVerifyStrategy) New() types.Object {
+	return &apiv1.ImageVerification{}
+}
+
+// Create checks the image like the controller checks the image of an app. Without a key, the image is checked against
+// the ImageAllowRules of the project, otherwise it has to be signed with the key.
+func (s *ImageVerifyStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	verify := obj.(*apiv1.ImageVerification)
+	if verify.Name == "" {
+		ri, ok := request.RequestInfoFrom(ctx)
+		if ok {
+			verify.Name = ri.Name
+		}
+	}
+	ns, _ := request.NamespaceFrom(ctx)
+	imageName := strings.ReplaceAll(verify.Name, "+", "/")
+
+	details, err := imagedetails.GetImageDetails(ctx, s.client, ns, imageName, nil, nil, "", false, s.remoteOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same as the controller: the image as the user referenced it, pinned to the digest it resolved to
+	ref, err := name.ParseReference(imageName, name.WithDefaultRegistry(""), name.WithDefaultTag(""))
+	if err != nil {
+		return nil, err
+	}
+	targetImage := ref.Name()
+
+	if verify.Key == "" {
+		err = imageallowrules.CheckImageAllowed(ctx, s.client, ns, targetImage, details.AppImage.Digest, s.remoteOpt)
+	} else {
+		err = imageallowrules.VerifyImage(ctx, s.client, ns, targetImage, details.AppImage.Digest, []v1.ImageAllowRuleInstance{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "key",
+					Namespace: ns,
+				},
+				Images: []string{strings.TrimSuffix(targetImage, ":")},
+				Signatures: v1.ImageAllowRuleSignatures{
+					Rules: []v1.SignatureRules{
+						{
+							SignedBy: v1.SignedBy{
+								AllOf: []string{verify.Key},
+							},
+							Annotations: verify.Annotations,
+						},
+					},
+				},
+			},
+		}, s.remoteOpt)
+	}
+
+	result := &apiv1.ImageVerification{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      verify.Name,
+			Namespace: ns,
+		},
+		Key:         verify.Key,
+		Annotations: verify.Annotations,
+		Digest:      details.AppImage.Digest,
+		Verified:    err == nil,
+	}
+
+	var (
+		notAllowed *imageallowrules.ErrImageNotAllowed
+		verifyErr  *ocosign.VerificationError
+	)
+	if verify.Key != "" && (errors.As(err, &notAllowed) || errors.As(err, &verifyErr)) {
+		result.Reason = fmt.Sprintf("image <%s> has no signature matching the key and annotations", targetImage)
+	} else if errors.As(err, &notAllowed) || errors.As(err, &verifyErr) {
+		result.Reason = err.Error()
+	} else if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
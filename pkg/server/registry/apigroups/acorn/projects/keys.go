@@ -0,0 +1,136 @@
+package projects
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewKeys(c kclient.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ProjectKeys{}).
+		WithGet(&KeysStrategy{
+			client:    c,
+			remoteOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type KeysStrategy struct {
+	client    kclient.Client
+	remoteOpt remote.Option
+}
+
+func (s *KeysStrategy) Get(ctx context.Context, _, name string) (types.Object, error) {
+	if err := projectExists(ctx, s.client, name); err != nil {
+		return nil, err
+	}
+
+	keys, err := encryption.GetProjectKeys(ctx, s.client, name, s.remoteOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ProjectKeys{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Keys: keys,
+	}, nil
+}
+
+func (s *KeysStrategy) New() types.Object {
+	return &apiv1.ProjectKeys{}
+}
+
+func NewRotateKey(c kclient.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ProjectKeyRotation{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&RotateKeyStrategy{
+			client: c,
+		}).Build()
+}
+
+type RotateKeyStrategy struct {
+	client kclient.Client
+}
+
+func (s *RotateKeyStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	ri, _ := request.RequestInfoFrom(ctx)
+	if ri.Name == "" {
+		return obj, nil
+	}
+
+	if err := projectExists(ctx, s.client, ri.Name); err != nil {
+		return nil, err
+	}
+
+	result, err := encryption.RotateKey(ctx, s.client, ri.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Name = ri.Name
+	return result, nil
+}
+
+func (s *RotateKeyStrategy) New() types.Object {
+	return &apiv1.ProjectKeyRotation{}
+}
+
+func NewRetireKey(c kclient.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ProjectKeyRetirement{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&RetireKeyStrategy{
+			client:    c,
+			remoteOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type RetireKeyStrategy struct {
+	client    kclient.Client
+	remoteOpt remote.Option
+}
+
+func (s *RetireKeyStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	retirement := obj.(*apiv1.ProjectKeyRetirement)
+
+	ri, _ := request.RequestInfoFrom(ctx)
+	if ri.Name == "" {
+		return obj, nil
+	}
+
+	if err := projectExists(ctx, s.client, ri.Name); err != nil {
+		return nil, err
+	}
+
+	if err := encryption.RetireKey(ctx, s.client, ri.Name, retirement.KeyID, s.remoteOpt); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	return &apiv1.ProjectKeyRetirement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ri.Name,
+		},
+		KeyID: retirement.KeyID,
+	}, nil
+}
+
+func (s *RetireKeyStrategy) New() types.Object {
+	return &apiv1.ProjectKeyRetirement{}
+}
+
+func projectExists(ctx context.Context, c kclient.Client, name string) error {
+	return c.Get(ctx, router.Key("", name), &v1.ProjectInstance{})
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	imagename "github.com/google/go-containerregistry/pkg/name"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -30,6 +32,31 @@ func (v *Validator) Validate(_ context.Context, obj runtime.Object) field.ErrorL
 		return append(result, field.Invalid(field.NewPath("spec", "defaultRegion"), project.Spec.DefaultRegion, "default region is not in the supported regions list"))
 	}
 
+	if project.Spec.BuildCache != "" {
+		if _, err := imagename.NewTag(project.Spec.BuildCache); err != nil {
+			return append(result, field.Invalid(field.NewPath("spec", "buildCache"), project.Spec.BuildCache, err.Error()))
+		}
+	}
+
+	if retention := project.Spec.ImageRetention; retention != nil {
+		if err := validateDuration(field.NewPath("spec", "imageRetention", "untagged"), retention.Untagged); err != nil {
+			return append(result, err)
+		}
+		if err := validateDuration(field.NewPath("spec", "imageRetention", "tagged"), retention.Tagged); err != nil {
+			return append(result, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDuration(path *field.Path, value string) *field.Error {
+	if value == "" {
+		return nil
+	}
+	if d, err := time.ParseDuration(value); err != nil || d < 0 {
+		return field.Invalid(path, value, "must be a positive duration, e.g. 24h")
+	}
 	return nil
 }
 
@@ -93,6 +93,45 @@ func TestProjectCreateValidation(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create project with build cache",
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					BuildCache: "ghcr.io/acorn-io/cache",
+				},
+			},
+		},
+		{
+			name:      "Create project with invalid build cache",
+			wantError: true,
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					BuildCache: "ghcr.io/Acorn-IO/cache",
+				},
+			},
+		},
+		{
+			name: "Create project with image retention",
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					ImageRetention: &v1.ImageRetention{
+						Untagged: "24h",
+						Tagged:   "720h",
+					},
+				},
+			},
+		},
+		{
+			name:      "Create project with invalid image retention",
+			wantError: true,
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					ImageRetention: &v1.ImageRetention{
+						Untagged: "1 day",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,33 @@
+package secretimports
+
+import (
+	"context"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type Validator struct{}
+
+func (s *Validator) Validate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	secretImport := obj.(*apiv1.SecretImport)
+	if secretImport.Spec.Project == "" {
+		result = append(result, field.Required(field.NewPath("spec", "project"), "the project the secret is shared from must be specified"))
+	} else if secretImport.Spec.Project == secretImport.Namespace {
+		result = append(result, field.Invalid(field.NewPath("spec", "project"), secretImport.Spec.Project, "a secret can not be imported from its own project"))
+	}
+	if secretImport.Spec.SecretName == "" {
+		result = append(result, field.Required(field.NewPath("spec", "secretName"), "the secret to import must be specified"))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(secretImport.Spec.SecretName) {
+			result = append(result, field.Invalid(field.NewPath("spec", "secretName"), secretImport.Spec.SecretName, msg))
+		}
+	}
+	return
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, obj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, obj)
+}
@@ -1,25 +1,90 @@
 package secrets
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/strategy"
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/registry/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewReveal(c kclient.WithWatch) rest.Storage {
+const SecretRevealEventType = "SecretReveal"
+
+// SecretRevealEventDetails captures additional info about the reveal of a Secret.
+// It never contains any secret values.
+type SecretRevealEventDetails struct {
+	// ResourceVersion is the resourceVersion of the revealed Secret.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Keys are the data keys of the revealed Secret.
+	Keys []string `json:"keys,omitempty"`
+}
+
+func NewReveal(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
 	translated := translation.NewTranslationStrategy(&Translator{
 		c:      c,
 		reveal: true,
 	}, remote.NewRemote(&corev1.Secret{}, c))
 	remoteResource := publicname.NewStrategy(translated)
 	return stores.NewBuilder(c.Scheme(), &apiv1.Secret{}).
-		WithGet(remoteResource).
+		WithGet(&revealRecordingStrategy{
+			Getter:   remoteResource,
+			recorder: recorder,
+		}).
 		WithTableConverter(tables.SecretConverter).
 		Build()
 }
+
+type revealRecordingStrategy struct {
+	strategy.Getter
+	recorder event.Recorder
+}
+
+func (s *revealRecordingStrategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	obj, err := s.Getter.Get(ctx, namespace, name)
+	if err != nil {
+		return obj, err
+	}
+
+	secret := obj.(*apiv1.Secret)
+	details, err := v1.Mapify(SecretRevealEventDetails{
+		ResourceVersion: secret.ResourceVersion,
+		Keys:            secret.Keys,
+	})
+	if err != nil {
+		logrus.Warnf("Failed to generate event details, event recording disabled for request: %v", err)
+		return obj, nil
+	}
+
+	if err := s.recorder.Record(ctx, &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Labels:    event.AuditLabels(),
+		},
+		Type:        SecretRevealEventType,
+		Severity:    v1.EventSeverityInfo,
+		Details:     details,
+		Description: fmt.Sprintf("Secret %s/%s revealed", namespace, name),
+		AppName:     secret.Labels[labels.AcornAppName],
+		Resource:    event.Resource(secret),
+		Observed:    v1.NowMicro(),
+	}); err != nil {
+		logrus.Warnf("Failed to record event: %v", err)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type getterFunc func(ctx context.Context, namespace, name string) (types.Object, error)
+
+func (g getterFunc) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	return g(ctx, namespace, name)
+}
+
+func TestRevealAuditEvent(t *testing.T) {
+	var events []*apiv1.Event
+	s := &revealRecordingStrategy{
+		Getter: getterFunc(func(_ context.Context, namespace, name string) (types.Object, error) {
+			return &apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       namespace,
+					UID:             "secret-uid",
+					ResourceVersion: "42",
+					Labels: map[string]string{
+						labels.AcornAppName: "app",
+					},
+				},
+				Keys: []string{"password"},
+				Data: map[string][]byte{
+					"password": []byte("hunter2"),
+				},
+			}, nil
+		}),
+		recorder: event.RecorderFunc(func(_ context.Context, e *apiv1.Event) error {
+			events = append(events, e)
+			return nil
+		}),
+	}
+
+	obj, err := s.Get(context.Background(), "acorn", "app.creds")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", string(obj.(*apiv1.Secret).Data["password"]))
+
+	require.Len(t, events, 1)
+	e := events[0]
+	assert.Equal(t, SecretRevealEventType, e.Type)
+	assert.Equal(t, v1.EventSeverityInfo, e.Severity)
+	assert.Equal(t, "Secret acorn/app.creds revealed", e.Description)
+	assert.Equal(t, "acorn", e.Namespace)
+	assert.Equal(t, "app", e.AppName)
+	assert.Equal(t, event.AuditLabels(), e.Labels)
+	assert.Equal(t, "secret", e.Resource.Kind)
+	assert.Equal(t, v1.GenericMap{
+		"resourceVersion": "42",
+		"keys":            []any{"password"},
+	}, e.Details)
+	assert.NotContains(t, fmt.Sprint(e), "hunter2", "the event must not contain secret values")
+}
+
+// TestRevealAuditEventNotFound tests that a failed reveal is not audited, since no secret values were returned
+func TestRevealAuditEventNotFound(t *testing.T) {
+	var events []*apiv1.Event
+	s := &revealRecordingStrategy{
+		Getter: getterFunc(func(context.Context, string, string) (types.Object, error) {
+			return nil, fmt.Errorf("not found")
+		}),
+		recorder: event.RecorderFunc(func(_ context.Context, e *apiv1.Event) error {
+			events = append(events, e)
+			return nil
+		}),
+	}
+
+	_, err := s.Get(context.Background(), "acorn", "app.creds")
+	assert.EqualError(t, err, "not found")
+	assert.Empty(t, events)
+}
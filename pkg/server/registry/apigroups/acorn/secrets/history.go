@@ -0,0 +1,268 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/strategy"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	sec "github.com/acorn-io/runtime/pkg/secrets"
+	"github.com/acorn-io/z"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	SecretUpdateEventType   = "SecretUpdate"
+	SecretRollbackEventType = "SecretRollback"
+)
+
+// SecretChangeEventDetails captures additional info about a change to the data of a Secret.
+// It never contains any secret values.
+type SecretChangeEventDetails struct {
+	// ResourceVersion is the resourceVersion of the changed Secret.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// PreviousVersion is the history version the data before the change was recorded as.
+	PreviousVersion int `json:"previousVersion,omitempty"`
+
+	// RollbackVersion is the history version the Secret was rolled back to, if any.
+	RollbackVersion int `json:"rollbackVersion,omitempty"`
+
+	// Keys are the data keys of the Secret after the change.
+	Keys []string `json:"keys,omitempty"`
+}
+
+type historyRecordingStrategy struct {
+	strategy.CompleteStrategy
+	client     kclient.Client
+	translator *Translator
+	recorder   event.Recorder
+}
+
+func newHistoryRecordingStrategy(s strategy.CompleteStrategy, c kclient.Client, recorder event.Recorder) *historyRecordingStrategy {
+	return &historyRecordingStrategy{
+		CompleteStrategy: s,
+		client:           c,
+		translator:       &Translator{c: c},
+		recorder:         recorder,
+	}
+}
+
+func (s *historyRecordingStrategy) Update(ctx context.Context, obj types.Object) (types.Object, error) {
+	old, err := getSecret(ctx, s.client, s.translator, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	// An update without data keeps the existing data, see Translator.FromPublic
+	data := old.Data
+	if secret, ok := obj.(*apiv1.Secret); ok && secret.Data != nil {
+		data = secret.Data
+	}
+	if equality.Semantic.DeepEqual(old.Data, data) {
+		// Update does not change data, nothing to record
+		return s.CompleteStrategy.Update(ctx, obj)
+	}
+
+	// Record the previous version before it is overwritten, so that a change of the data is never lost. If the update
+	// fails afterwards, the history has a version with the current data, which is harmless.
+	previous, err := recordHistory(ctx, s.client, old, sec.HistoryReasonUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record previous version of secret %s: %w", obj.GetName(), err)
+	}
+
+	updated, err := s.CompleteStrategy.Update(ctx, obj)
+	if err != nil {
+		return updated, err
+	}
+
+	current := &corev1.Secret{}
+	if err := s.client.Get(ctx, router.Key(old.Namespace, old.Name), current); err != nil {
+		logrus.Warnf("Failed to get updated secret, event recording disabled for request: %v", err)
+		return updated, nil
+	}
+
+	recordSecretEvent(ctx, s.recorder, current, SecretChangeEventDetails{
+		ResourceVersion: current.ResourceVersion,
+		PreviousVersion: z.Dereference(previous).Version,
+	}, SecretUpdateEventType, fmt.Sprintf("Data updated for Secret %s/%s", obj.GetNamespace(), obj.GetName()))
+
+	return updated, nil
+}
+
+func NewHistory(c kclient.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.SecretHistory{}).
+		WithGet(&HistoryStrategy{
+			client:     c,
+			translator: &Translator{c: c},
+		}).Build()
+}
+
+type HistoryStrategy struct {
+	client     kclient.Client
+	translator *Translator
+}
+
+func (s *HistoryStrategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	secret, err := getSecret(ctx, s.client, s.translator, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := sec.GetHistory(ctx, s.client, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.SecretHistory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Versions: versions,
+	}, nil
+}
+
+func (s *HistoryStrategy) New() types.Object {
+	return &apiv1.SecretHistory{}
+}
+
+func NewRollback(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.SecretRollback{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&RollbackStrategy{
+			client:     c,
+			translator: &Translator{c: c},
+			recorder:   recorder,
+		}).Build()
+}
+
+type RollbackStrategy struct {
+	client     kclient.Client
+	translator *Translator
+	recorder   event.Recorder
+}
+
+func (s *RollbackStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	rollback := obj.(*apiv1.SecretRollback)
+
+	ri, ok := request.RequestInfoFrom(ctx)
+	if !ok || ri.Name == "" || ri.Namespace == "" {
+		return nil, apierrors.NewBadRequest("the name and namespace of the secret to roll back are required")
+	}
+
+	secret, err := getSecret(ctx, s.client, s.translator, ri.Namespace, ri.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := sec.GetHistoryVersionData(ctx, s.client, secret, rollback.Version)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	previous, err := recordHistory(ctx, s.client, secret, sec.HistoryReasonRollback)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record current version of secret %s: %w", ri.Name, err)
+	}
+
+	secret.Data = data
+	if err := s.client.Update(ctx, secret); err != nil {
+		return nil, err
+	}
+
+	recordSecretEvent(ctx, s.recorder, secret, SecretChangeEventDetails{
+		ResourceVersion: secret.ResourceVersion,
+		PreviousVersion: z.Dereference(previous).Version,
+		RollbackVersion: rollback.Version,
+	}, SecretRollbackEventType, fmt.Sprintf("Secret %s/%s rolled back to version %d", ri.Namespace, ri.Name, rollback.Version))
+
+	return &apiv1.SecretRollback{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ri.Name,
+			Namespace: ri.Namespace,
+		},
+		Version: rollback.Version,
+	}, nil
+}
+
+func (s *RollbackStrategy) New() types.Object {
+	return &apiv1.SecretRollback{}
+}
+
+// getSecret returns the underlying secret for the given public name, only secrets that are visible
+// through the secrets API are returned.
+func getSecret(ctx context.Context, c kclient.Client, translator *Translator, namespace, name string) (*corev1.Secret, error) {
+	ns, secretName, err := translator.FromPublicName(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, router.Key(ns, secretName), secret); err != nil {
+		return nil, err
+	}
+	if ignore(secret) {
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+	}
+	return secret, nil
+}
+
+func recordHistory(ctx context.Context, c kclient.Client, secret *corev1.Secret, reason string) (*apiv1.SecretVersion, error) {
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var actor string
+	if user, ok := request.UserFrom(ctx); ok {
+		actor = user.GetName()
+	}
+
+	return sec.RecordHistory(ctx, c, secret, actor, reason, z.Dereference(cfg.SecretHistoryLimit))
+}
+
+func recordSecretEvent(ctx context.Context, recorder event.Recorder, secret *corev1.Secret, details SecretChangeEventDetails, eventType, description string) {
+	details.Keys = make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		details.Keys = append(details.Keys, key)
+	}
+	sort.Strings(details.Keys)
+
+	mapped, err := v1.Mapify(details)
+	if err != nil {
+		logrus.Warnf("Failed to generate event details, event recording disabled for request: %v", err)
+		return
+	}
+
+	if err := recorder.Record(ctx, &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: secret.Namespace,
+		},
+		Type:        eventType,
+		Severity:    v1.EventSeverityInfo,
+		Details:     mapped,
+		Description: description,
+		AppName:     secret.Labels[labels.AcornAppName],
+		Resource:    event.Resource(secret),
+		Observed:    v1.NowMicro(),
+	}); err != nil {
+		logrus.Warnf("Failed to record event: %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// TestRollbackWithoutTarget tests that a rollback without the name and namespace of a secret is rejected
+func TestRollbackWithoutTarget(t *testing.T) {
+	s := &RollbackStrategy{}
+
+	for name, ctx := range map[string]context.Context{
+		"no request info": context.Background(),
+		"no name":         request.WithRequestInfo(context.Background(), &request.RequestInfo{Namespace: "acorn"}),
+		"no namespace":    request.WithRequestInfo(context.Background(), &request.RequestInfo{Name: "app.creds"}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.Create(ctx, &apiv1.SecretRollback{Version: 1})
+			assert.True(t, apierrors.IsBadRequest(err), "expected a bad request, got %v", err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/server/registry/middleware"
 	"github.com/acorn-io/runtime/pkg/tables"
@@ -13,11 +14,12 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStorage(c kclient.WithWatch, middlewares ...middleware.CompleteStrategy) rest.Storage {
+func NewStorage(c kclient.WithWatch, recorder event.Recorder, middlewares ...middleware.CompleteStrategy) rest.Storage {
 	translated := translation.NewTranslationStrategy(&Translator{
 		c: c,
 	}, remote.NewRemote(&corev1.Secret{}, c))
 	remoteResource := publicname.NewStrategy(translated)
+	remoteResource = newHistoryRecordingStrategy(remoteResource, c, recorder)
 	remoteResource = middleware.ForCompleteStrategy(remoteResource, middlewares...)
 	defaultSecret := &defaultSecretGenerateStrategy{
 		strategy: remoteResource,
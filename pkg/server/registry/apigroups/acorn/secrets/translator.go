@@ -9,6 +9,7 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
+	sec "github.com/acorn-io/runtime/pkg/secrets"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	klabels "k8s.io/apimachinery/pkg/labels"
@@ -139,5 +140,5 @@ func (t *Translator) NewPublicList() types.ObjectList {
 }
 
 func ignore(secret *corev1.Secret) bool {
-	return !strings.HasPrefix(string(secret.Type), "secrets.acorn.io/")
+	return !sec.IsAcornSecret(secret)
 }
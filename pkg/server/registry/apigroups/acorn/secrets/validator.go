@@ -2,9 +2,11 @@ package secrets
 
 import (
 	"context"
+	"fmt"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -23,6 +25,9 @@ func (v *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 	return
 }
 
-func (v *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+func (v *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object) (result field.ErrorList) {
+	if project := old.(*apiv1.Secret).Labels[labels.AcornSecretShareProject]; project != "" {
+		return append(result, field.Forbidden(field.NewPath("data"), fmt.Sprintf("secret is shared from project %s and can only be changed there", project)))
+	}
 	return v.Validate(ctx, obj)
 }
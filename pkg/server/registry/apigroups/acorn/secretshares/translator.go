@@ -0,0 +1,17 @@
+package secretshares
+
+import (
+	mtypes "github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+)
+
+type Translator struct{}
+
+func (s *Translator) FromPublic(obj mtypes.Object) mtypes.Object {
+	return (*v1.SecretShareInstance)(obj.(*apiv1.SecretShare))
+}
+
+func (s *Translator) ToPublic(obj mtypes.Object) mtypes.Object {
+	return (*apiv1.SecretShare)(obj.(*v1.SecretShareInstance))
+}
@@ -0,0 +1,54 @@
+package secretshares
+
+import (
+	"context"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/secrets"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Validator struct {
+	Client kclient.Client
+}
+
+func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
+	share := obj.(*apiv1.SecretShare)
+	if share.Spec.SecretName == "" {
+		result = append(result, field.Required(field.NewPath("spec", "secretName"), "the secret to share must be specified"))
+	}
+	if share.Spec.SecretName != "" {
+		source := &corev1.Secret{}
+		if err := s.Client.Get(ctx, router.Key(share.Namespace, share.Spec.SecretName), source); err == nil {
+			if !secrets.IsAcornSecret(source) {
+				result = append(result, field.Invalid(field.NewPath("spec", "secretName"), share.Spec.SecretName, "only acorn secrets can be shared"))
+			}
+		} else if !apierrors.IsNotFound(err) {
+			result = append(result, field.InternalError(field.NewPath("spec", "secretName"), err))
+		}
+	}
+	if share.Spec.TargetName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(share.Spec.TargetName) {
+			result = append(result, field.Invalid(field.NewPath("spec", "targetName"), share.Spec.TargetName, msg))
+		}
+	}
+	if len(share.Spec.Projects) == 0 {
+		result = append(result, field.Required(field.NewPath("spec", "projects"), "at least one project must be specified"))
+	}
+	for i, project := range share.Spec.Projects {
+		if project == share.Namespace {
+			result = append(result, field.Invalid(field.NewPath("spec", "projects").Index(i), project, "a secret can not be shared with its own project"))
+		}
+	}
+	return
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, obj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, obj)
+}
@@ -0,0 +1,75 @@
+package secretshares
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestValidateSecretType(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "acorn", UID: "acorn-uid-1234"}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "acorn"},
+			Type:       v1.SecretTypeBasic,
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "acorn"},
+			Type:       corev1.SecretTypeTLS,
+		},
+	).Build()
+
+	// The encryption key of the project is stored in a secret of its own
+	_, err := nacl.GetOrCreatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	keySecrets := &corev1.SecretList{}
+	require.NoError(t, c.List(ctx, keySecrets))
+	var keySecret *corev1.Secret
+	for i, secret := range keySecrets.Items {
+		if secret.Namespace != "acorn" {
+			keySecret = &keySecrets.Items[i]
+		}
+	}
+	require.NotNil(t, keySecret)
+
+	tests := []struct {
+		name      string
+		namespace string
+		secret    string
+		wantErr   bool
+	}{
+		{name: "acorn secret", namespace: "acorn", secret: "creds"},
+		{name: "missing secret", namespace: "acorn", secret: "missing"},
+		{name: "tls secret", namespace: "acorn", secret: "tls", wantErr: true},
+		{name: "encryption key", namespace: keySecret.Namespace, secret: keySecret.Name, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := (&Validator{Client: c}).Validate(ctx, &apiv1.SecretShare{
+				ObjectMeta: metav1.ObjectMeta{Name: "share", Namespace: tt.namespace},
+				Spec: v1.SecretShareInstanceSpec{
+					SecretName: tt.secret,
+					Projects:   []string{"team-a"},
+				},
+			})
+			if tt.wantErr {
+				require.Len(t, errs, 1)
+				assert.Equal(t, "spec.secretName", errs[0].Field)
+				assert.Equal(t, "only acorn secrets can be shared", errs[0].Detail)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package serviceexports
+
+import (
+	"context"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type Validator struct{}
+
+func (s *Validator) Validate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	export := obj.(*apiv1.ServiceExport)
+	if export.Spec.Service == "" {
+		result = append(result, field.Required(field.NewPath("spec", "service"), "the service to export must be specified"))
+	} else if strings.Contains(export.Spec.Service, "/") {
+		result = append(result, field.Invalid(field.NewPath("spec", "service"), export.Spec.Service, "only services of the project of the export can be exported"))
+	}
+	if len(export.Spec.Projects) == 0 {
+		result = append(result, field.Required(field.NewPath("spec", "projects"), "at least one project must be specified"))
+	}
+	for i, project := range export.Spec.Projects {
+		if project == export.Namespace {
+			result = append(result, field.Invalid(field.NewPath("spec", "projects").Index(i), project, "a service can not be exported to its own project"))
+		}
+	}
+	return
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, obj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, obj)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/acorn-io/baaah/pkg/apply"
 	"github.com/acorn-io/baaah/pkg/typed"
@@ -139,9 +140,11 @@ func forRouters(appInstance *v1.AppInstance) (result []kclient.Object, err error
 			continue
 		}
 
-		for _, router := range router.Routes {
-			if router.TargetServiceName != "" && !serviceNames.Has(router.TargetServiceName) {
-				return nil, fmt.Errorf("router [%s] references unknown service [%s]", routerName, router.TargetServiceName)
+		for _, route := range router.Routes {
+			for _, target := range route.WeightedTargets() {
+				if !serviceNames.Has(target.TargetServiceName) {
+					return nil, fmt.Errorf("router [%s] references unknown service [%s]", routerName, target.TargetServiceName)
+				}
 			}
 		}
 
@@ -268,13 +271,20 @@ func forContainers(appInstance *v1.AppInstance) (result []kclient.Object) {
 
 func forLinkedServices(app *v1.AppInstance) (result []kclient.Object) {
 	for _, link := range app.Spec.Links {
+		linkLabels := []string{
+			labels.AcornPublicName, publicname.ForChild(app, link.Target),
+			labels.AcornLinkName, link.Service,
+		}
+		// Label values can't contain the / of links to services of other projects
+		if project, service, ok := strings.Cut(link.Service, "/"); ok {
+			linkLabels = append(linkLabels[:2], labels.AcornLinkName, service, labels.AcornLinkProject, project)
+		}
+
 		newService := &v1.ServiceInstance{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      link.Target,
 				Namespace: app.Status.Namespace,
-				Labels: labels.Managed(app,
-					labels.AcornPublicName, publicname.ForChild(app, link.Target),
-					labels.AcornLinkName, link.Service),
+				Labels:    labels.Managed(app, linkLabels...),
 				Annotations: map[string]string{
 					labels.AcornAppGeneration: strconv.FormatInt(app.Generation, 10),
 				},
@@ -285,9 +295,7 @@ func forLinkedServices(app *v1.AppInstance) (result []kclient.Object) {
 				PublishMode:  publishMode(app),
 				Publish:      ports2.PortPublishForService(link.Target, app.Spec.Publish),
 				External:     link.Service,
-				Labels: labels.Managed(app,
-					labels.AcornPublicName, publicname.ForChild(app, link.Target),
-					labels.AcornLinkName, link.Service),
+				Labels:       labels.Managed(app, linkLabels...),
 			},
 		}
 		result = append(result, newService)
@@ -13,6 +13,7 @@ import (
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/condition"
 	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/ipfamily"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/ports"
 	"github.com/acorn-io/runtime/pkg/ref"
@@ -22,7 +23,7 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func toContainerLabelsService(service *v1.ServiceInstance) (result []kclient.Object) {
+func toContainerLabelsService(service *v1.ServiceInstance, families []corev1.IPFamily) (result []kclient.Object) {
 	newService := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        service.Name,
@@ -37,11 +38,12 @@ func toContainerLabelsService(service *v1.ServiceInstance) (result []kclient.Obj
 				service.Spec.ContainerLabels),
 		},
 	}
+	ipfamily.Set(newService, families)
 	result = append(result, newService)
 	return
 }
 
-func toContainerService(service *v1.ServiceInstance) (result []kclient.Object) {
+func toContainerService(service *v1.ServiceInstance, families []corev1.IPFamily) (result []kclient.Object) {
 	newService := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        service.Name,
@@ -56,11 +58,12 @@ func toContainerService(service *v1.ServiceInstance) (result []kclient.Object) {
 				service.Spec.AppName, labels.AcornContainerName, service.Spec.Container),
 		},
 	}
+	ipfamily.Set(newService, families)
 	result = append(result, newService)
 	return
 }
 
-func toAddressService(service *v1.ServiceInstance) (result []kclient.Object) {
+func toAddressService(service *v1.ServiceInstance, families []corev1.IPFamily) (result []kclient.Object) {
 	newService := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        service.Name,
@@ -79,6 +82,10 @@ func toAddressService(service *v1.ServiceInstance) (result []kclient.Object) {
 		newService.Spec.Ports = ports.RemoveNonHTTPPorts(newService.Spec.Ports)
 	} else {
 		newService.Spec.Type = corev1.ServiceTypeClusterIP
+		if len(families) > 0 || ipfamily.Of(ipAddr) == corev1.IPv6Protocol {
+			// The service can only have the family of the address it points to
+			ipfamily.Set(newService, []corev1.IPFamily{ipfamily.Of(ipAddr)})
+		}
 
 		endpointsAnnotations := make(map[string]string, len(newService.Annotations)+1)
 		for k, v := range newService.Annotations {
@@ -119,15 +126,19 @@ func toAddressService(service *v1.ServiceInstance) (result []kclient.Object) {
 	return
 }
 
-func toExternalService(ctx context.Context, c kclient.Client, cfg *apiv1.Config, service *v1.ServiceInstance) (result []kclient.Object, missing []string, err error) {
-	return toRefService(ctx, c, cfg, service, service.Spec.AppNamespace, service.Spec.External)
+func toExternalService(ctx context.Context, c kclient.Client, cfg *apiv1.Config, service *v1.ServiceInstance, families []corev1.IPFamily) (result []kclient.Object, missing []string, err error) {
+	project, external, err := ref.ExternalService(ctx, c, service)
+	if err != nil {
+		return nil, nil, err
+	}
+	return toRefService(ctx, c, cfg, service, families, project, external)
 }
 
-func toAliasService(ctx context.Context, c kclient.Client, cfg *apiv1.Config, service *v1.ServiceInstance) (result []kclient.Object, missing []string, err error) {
-	return toRefService(ctx, c, cfg, service, service.Namespace, service.Spec.Alias)
+func toAliasService(ctx context.Context, c kclient.Client, cfg *apiv1.Config, service *v1.ServiceInstance, families []corev1.IPFamily) (result []kclient.Object, missing []string, err error) {
+	return toRefService(ctx, c, cfg, service, families, service.Namespace, service.Spec.Alias)
 }
 
-func toRefService(ctx context.Context, c kclient.Client, cfg *apiv1.Config, service *v1.ServiceInstance, refNamespace, refName string) (result []kclient.Object, missing []string, err error) {
+func toRefService(ctx context.Context, c kclient.Client, cfg *apiv1.Config, service *v1.ServiceInstance, families []corev1.IPFamily, refNamespace, refName string) (result []kclient.Object, missing []string, err error) {
 	var (
 		servicePorts  []corev1.ServicePort
 		targetService = &v1.ServiceInstance{}
@@ -176,6 +187,7 @@ func toRefService(ctx context.Context, c kclient.Client, cfg *apiv1.Config, serv
 			Ports:        servicePorts,
 		},
 	}
+	ipfamily.Set(newService, families)
 	result = append(result, newService)
 	return
 }
@@ -216,14 +228,25 @@ func ToK8sService(req router.Request, service *v1.ServiceInstance) (result []kcl
 		}
 	}()
 
-	var waiting bool
+	families, err := ipfamily.Detect(req.Ctx, req.Client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		waiting bool
+		// notExported is set if the link points to a service of another project that isn't exported to the project
+		notExported error
+	)
 
 	defer func() {
 		if err != nil {
 			return
 		}
 		cond := condition.ForName(service, v1.ServiceInstanceConditionDefined)
-		if waiting {
+		if notExported != nil {
+			cond.Error(notExported)
+		} else if waiting {
 			if service.Spec.Job == "" {
 				cond.Unknown("waiting to be defined")
 			} else {
@@ -235,15 +258,21 @@ func ToK8sService(req router.Request, service *v1.ServiceInstance) (result []kcl
 	}()
 
 	if service.Spec.External != "" {
-		return toExternalService(req.Ctx, req.Client, cfg, service)
+		result, missing, err = toExternalService(req.Ctx, req.Client, cfg, service, families)
+		if apierrors.IsForbidden(err) {
+			// Revoking the export removes the service, so the link stops resolving
+			notExported = err
+			return nil, nil, nil
+		}
+		return result, missing, err
 	} else if service.Spec.Alias != "" {
-		return toAliasService(req.Ctx, req.Client, cfg, service)
+		return toAliasService(req.Ctx, req.Client, cfg, service, families)
 	} else if service.Spec.Address != "" {
-		return toAddressService(service), nil, nil
+		return toAddressService(service, families), nil, nil
 	} else if service.Spec.Container != "" {
-		return toContainerService(service), nil, nil
+		return toContainerService(service, families), nil, nil
 	} else if len(service.Spec.ContainerLabels) > 0 {
-		return toContainerLabelsService(service), nil, nil
+		return toContainerLabelsService(service, families), nil, nil
 	}
 	return
 }
@@ -129,7 +129,12 @@ var (
 
 	Build = [][]string{
 		{"Name", "Name"},
-		{"Image", "Status.AppImage.ID"},
+		{"Image", "{{trunc .Status.AppImage.ID}}"},
+		{"Result", "{{buildResult .}}"},
+		{"Duration", "{{buildDuration .}}"},
+		{"Actor", "Spec.Actor"},
+		{"Revision", "{{buildRevision .}}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
 		{"Message", "Status.BuildError"},
 	}
 	BuildConverter = MustConverter(Build)
@@ -139,6 +144,30 @@ var (
 	}
 	ImageAllowRuleConverter = MustConverter(ImageAllowRule)
 
+	SecretShare = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Secret", "Spec.SecretName"},
+		{"Projects", "{{ arrayNoSpace .Spec.Projects }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	SecretShareConverter = MustConverter(SecretShare)
+
+	SecretImport = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Project", "Spec.Project"},
+		{"Secret", "Spec.SecretName"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	SecretImportConverter = MustConverter(SecretImport)
+
+	ServiceExport = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Service", "Spec.Service"},
+		{"Projects", "{{ arrayNoSpace .Spec.Projects }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	ServiceExportConverter = MustConverter(ServiceExport)
+
 	Project = [][]string{
 		{"Name", "Name"},
 		{"Created", "{{ago .CreationTimestamp}}"},
@@ -0,0 +1,5 @@
+// Package traefik contains the subset of the Traefik (traefik.containo.us) types that Acorn creates to apply the
+// HTTP options of published ports on Ingresses served by Traefik. Fields that Acorn doesn't use are omitted.
+// +k8s:deepcopy-gen=package
+
+package traefik
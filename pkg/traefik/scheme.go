@@ -0,0 +1,24 @@
+package traefik
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const Group = "traefik.containo.us"
+
+var SchemeGroupVersion = schema.GroupVersion{
+	Group:   Group,
+	Version: "v1alpha1",
+}
+
+func AddToScheme(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Middleware{},
+		&MiddlewareList{},
+	)
+
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
@@ -0,0 +1,54 @@
+package traefik
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Middleware changes the requests routed to a service. Traefik requires exactly one of the fields of the spec to be
+// set per Middleware.
+type Middleware struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MiddlewareSpec `json:"spec,omitempty"`
+}
+
+type MiddlewareSpec struct {
+	RedirectScheme   *RedirectScheme   `json:"redirectScheme,omitempty"`
+	ReplacePathRegex *ReplacePathRegex `json:"replacePathRegex,omitempty"`
+	Headers          *Headers          `json:"headers,omitempty"`
+	Buffering        *Buffering        `json:"buffering,omitempty"`
+}
+
+type RedirectScheme struct {
+	Scheme    string `json:"scheme,omitempty"`
+	Permanent bool   `json:"permanent,omitempty"`
+}
+
+type ReplacePathRegex struct {
+	Regex       string `json:"regex,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+type Headers struct {
+	AccessControlAllowOriginList  []string `json:"accessControlAllowOriginList,omitempty"`
+	AccessControlAllowMethods     []string `json:"accessControlAllowMethods,omitempty"`
+	AccessControlAllowHeaders     []string `json:"accessControlAllowHeaders,omitempty"`
+	AccessControlAllowCredentials bool     `json:"accessControlAllowCredentials,omitempty"`
+	AccessControlMaxAge           int64    `json:"accessControlMaxAge,omitempty"`
+	AddVaryHeader                 bool     `json:"addVaryHeader,omitempty"`
+}
+
+type Buffering struct {
+	MaxRequestBodyBytes int64 `json:"maxRequestBodyBytes,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MiddlewareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Middleware `json:"items"`
+}
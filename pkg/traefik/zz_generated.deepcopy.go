@@ -0,0 +1,178 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package traefik
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Buffering) DeepCopyInto(out *Buffering) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Buffering.
+func (in *Buffering) DeepCopy() *Buffering {
+	if in == nil {
+		return nil
+	}
+	out := new(Buffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Headers) DeepCopyInto(out *Headers) {
+	*out = *in
+	if in.AccessControlAllowOriginList != nil {
+		in, out := &in.AccessControlAllowOriginList, &out.AccessControlAllowOriginList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessControlAllowMethods != nil {
+		in, out := &in.AccessControlAllowMethods, &out.AccessControlAllowMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessControlAllowHeaders != nil {
+		in, out := &in.AccessControlAllowHeaders, &out.AccessControlAllowHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Headers.
+func (in *Headers) DeepCopy() *Headers {
+	if in == nil {
+		return nil
+	}
+	out := new(Headers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Middleware) DeepCopyInto(out *Middleware) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Middleware.
+func (in *Middleware) DeepCopy() *Middleware {
+	if in == nil {
+		return nil
+	}
+	out := new(Middleware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Middleware) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MiddlewareList) DeepCopyInto(out *MiddlewareList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Middleware, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MiddlewareList.
+func (in *MiddlewareList) DeepCopy() *MiddlewareList {
+	if in == nil {
+		return nil
+	}
+	out := new(MiddlewareList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MiddlewareList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MiddlewareSpec) DeepCopyInto(out *MiddlewareSpec) {
+	*out = *in
+	if in.RedirectScheme != nil {
+		in, out := &in.RedirectScheme, &out.RedirectScheme
+		*out = new(RedirectScheme)
+		**out = **in
+	}
+	if in.ReplacePathRegex != nil {
+		in, out := &in.ReplacePathRegex, &out.ReplacePathRegex
+		*out = new(ReplacePathRegex)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = new(Headers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Buffering != nil {
+		in, out := &in.Buffering, &out.Buffering
+		*out = new(Buffering)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MiddlewareSpec.
+func (in *MiddlewareSpec) DeepCopy() *MiddlewareSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MiddlewareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedirectScheme) DeepCopyInto(out *RedirectScheme) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectScheme.
+func (in *RedirectScheme) DeepCopy() *RedirectScheme {
+	if in == nil {
+		return nil
+	}
+	out := new(RedirectScheme)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplacePathRegex) DeepCopyInto(out *ReplacePathRegex) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplacePathRegex.
+func (in *ReplacePathRegex) DeepCopy() *ReplacePathRegex {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplacePathRegex)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,181 @@
+package vcs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/filemode"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+// Checkout writes the files of the commit that ref resolves to, in the git repository containing path, to dir,
+// which must be empty. The working tree of the repository is not used, so uncommitted changes are not part of the
+// checkout. It returns the directory and the build file of the checkout that correspond to path and file, and the VCS
+// of the commit. The build file, or directory, must be tracked in the commit. The VCS is always clean since the
+// checkout only contains the files of the commit.
+func Checkout(path, file, ref, dir string) (string, string, v1.VCS, error) {
+	repo, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{
+		DetectDotGit: true,
+	})
+	if err != nil {
+		return "", "", v1.VCS{}, fmt.Errorf("opening the git repository of %s: %w", path, err)
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		return "", "", v1.VCS{}, err
+	}
+
+	rel, err := relativePath(w.Filesystem.Root(), path)
+	if err != nil {
+		return "", "", v1.VCS{}, err
+	}
+	// The build file may be missing from the working tree, so only its directory is resolved
+	relFile, err := relativePath(w.Filesystem.Root(), filepath.Dir(file))
+	if err != nil {
+		return "", "", v1.VCS{}, err
+	}
+	relFile = filepath.Join(relFile, filepath.Base(file))
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", "", v1.VCS{}, err
+	} else if len(entries) > 0 {
+		return "", "", v1.VCS{}, fmt.Errorf("checkout directory %s is not empty", dir)
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return "", "", v1.VCS{}, fmt.Errorf("resolving git ref %s: %w", ref, err)
+	}
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return "", "", v1.VCS{}, err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return "", "", v1.VCS{}, err
+	}
+
+	if err := checkSubmodules(tree, ref); err != nil {
+		return "", "", v1.VCS{}, err
+	}
+
+	var (
+		fileName = filepath.ToSlash(relFile)
+		tracked  bool
+	)
+	err = tree.Files().ForEach(func(f *object.File) error {
+		// The build file can be a directory, such as an Acorndir
+		if f.Name == fileName || strings.HasPrefix(f.Name, fileName+"/") {
+			tracked = true
+		}
+		return writeFile(dir, f)
+	})
+	if err != nil {
+		return "", "", v1.VCS{}, err
+	}
+	if !tracked {
+		return "", "", v1.VCS{}, fmt.Errorf("%s is not tracked in git ref %s", file, ref)
+	}
+
+	result := v1.VCS{
+		Revision: commit.Hash.String(),
+		Clean:    true,
+	}
+
+	remotes, err := repo.Remotes()
+	if err != nil {
+		return "", "", v1.VCS{}, err
+	}
+	for _, remote := range remotes {
+		result.Remotes = append(result.Remotes, remote.Config().URLs...)
+	}
+
+	return filepath.Join(dir, rel), filepath.Join(dir, relFile), result, nil
+}
+
+// relativePath returns the path relative to the root of the repository
+func relativePath(root, path string) (string, error) {
+	root, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	path, err = filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return "", err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is not in the git repository %s", path, root)
+	}
+	return rel, nil
+}
+
+// checkSubmodules returns an error if the tree contains submodules, because their files aren't part of the commit and
+// would be missing from the checkout
+func checkSubmodules(tree *object.Tree, ref string) error {
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+
+	for {
+		name, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if entry.Mode == filemode.Submodule {
+			return fmt.Errorf("git ref %s contains the submodule %s, submodules can not be checked out", ref, name)
+		}
+	}
+}
+
+func writeFile(dir string, f *object.File) error {
+	target := filepath.Join(dir, filepath.FromSlash(f.Name))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	if f.Mode == filemode.Symlink {
+		link, err := f.Contents()
+		if err != nil {
+			return err
+		}
+		return os.Symlink(link, target)
+	}
+
+	perm := os.FileMode(0644)
+	if f.Mode == filemode.Executable {
+		perm = 0755
+	}
+
+	r, err := f.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,143 @@
+package vcs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/filemode"
+	"gopkg.in/src-d/go-git.v4/plumbing/format/index"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+func TestCheckout(t *testing.T) {
+	repoDir := t.TempDir()
+	repo, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "app"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "app", "Acornfile"), []byte("containers: app: image: \"nginx\"\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "app", ".dockerignore"), []byte("tmp\n"), 0644))
+	_, err = w.Add("app")
+	require.NoError(t, err)
+	hash, err := w.Commit("v1", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "acorn",
+			Email: "acorn@acorn.io",
+			When:  time.Now(),
+		},
+	})
+	require.NoError(t, err)
+	_, err = repo.CreateTag("v1.2.3", hash, nil)
+	require.NoError(t, err)
+
+	// Uncommitted changes of the working tree
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "app", "Acornfile"), []byte("containers: app: image: \"busybox\"\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "app", "untracked"), []byte("untracked"), 0644))
+	assert.False(t, VCS(repoDir).Clean)
+
+	checkoutDir := t.TempDir()
+	dir, file, result, err := Checkout(filepath.Join(repoDir, "app"), filepath.Join(repoDir, "app", "Acornfile"), "v1.2.3", checkoutDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(checkoutDir, "app"), dir)
+	assert.Equal(t, filepath.Join(checkoutDir, "app", "Acornfile"), file)
+	assert.Equal(t, hash.String(), result.Revision)
+	assert.True(t, result.Clean)
+	assert.False(t, result.Modified)
+	assert.False(t, result.Untracked)
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "containers: app: image: \"nginx\"\n", string(data))
+	data, err = os.ReadFile(filepath.Join(dir, ".dockerignore"))
+	require.NoError(t, err)
+	assert.Equal(t, "tmp\n", string(data))
+	_, err = os.Stat(filepath.Join(dir, "untracked"))
+	assert.True(t, os.IsNotExist(err))
+
+	// The build file must be tracked in the ref, even if it exists in the working tree
+	_, _, _, err = Checkout(filepath.Join(repoDir, "app"), filepath.Join(repoDir, "app", "untracked"), "v1.2.3", t.TempDir())
+	assert.EqualError(t, err, filepath.Join(repoDir, "app", "untracked")+" is not tracked in git ref v1.2.3")
+
+	// A build file outside of the repository can not be checked out
+	_, _, _, err = Checkout(filepath.Join(repoDir, "app"), filepath.Join(t.TempDir(), "Acornfile"), "v1.2.3", t.TempDir())
+	assert.ErrorContains(t, err, "is not in the git repository")
+
+	// A checkout can not be mixed with other files
+	checkoutDir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(checkoutDir, "extra"), []byte("extra"), 0644))
+	_, _, _, err = Checkout(repoDir, filepath.Join(repoDir, "app", "Acornfile"), "v1.2.3", checkoutDir)
+	assert.EqualError(t, err, "checkout directory "+checkoutDir+" is not empty")
+
+	_, _, _, err = Checkout(repoDir, filepath.Join(repoDir, "app", "Acornfile"), "v9.9.9", t.TempDir())
+	assert.Error(t, err)
+}
+
+// TestCheckoutAcorndir tests that the build file can be a directory
+func TestCheckoutAcorndir(t *testing.T) {
+	repoDir := t.TempDir()
+	repo, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "Acorndir"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "Acorndir", "app.acorn"), []byte("containers: app: image: \"nginx\"\n"), 0644))
+	_, err = w.Add("Acorndir")
+	require.NoError(t, err)
+	_, err = w.Commit("v1", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "acorn",
+			Email: "acorn@acorn.io",
+			When:  time.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	checkoutDir := t.TempDir()
+	_, file, _, err := Checkout(repoDir, filepath.Join(repoDir, "Acorndir"), "HEAD", checkoutDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(checkoutDir, "Acorndir"), file)
+}
+
+// TestCheckoutSubmodule tests that refs with submodules are rejected, since the files of submodules would be missing
+func TestCheckoutSubmodule(t *testing.T) {
+	repoDir := t.TempDir()
+	repo, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "Acornfile"), []byte("containers: app: build: \"lib\"\n"), 0644))
+	_, err = w.Add("Acornfile")
+	require.NoError(t, err)
+
+	// Add a gitlink entry, like git submodule add does
+	idx, err := repo.Storer.Index()
+	require.NoError(t, err)
+	idx.Entries = append(idx.Entries, &index.Entry{
+		Name: "lib",
+		Mode: filemode.Submodule,
+		Hash: plumbing.NewHash("0123456789abcdef0123456789abcdef01234567"),
+	})
+	require.NoError(t, repo.Storer.SetIndex(idx))
+
+	_, err = w.Commit("v1", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "acorn",
+			Email: "acorn@acorn.io",
+			When:  time.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	_, _, _, err = Checkout(repoDir, filepath.Join(repoDir, "Acornfile"), "HEAD", t.TempDir())
+	assert.EqualError(t, err, "git ref HEAD contains the submodule lib, submodules can not be checked out")
+}